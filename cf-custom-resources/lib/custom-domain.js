@@ -12,6 +12,9 @@ let waiter;
 
 let hostedZoneCache = new Map();
 
+// Aliases stored under a key with this suffix belong to global services.
+const globalAliasesKeySuffix = ":global";
+
 /**
  * Upload a CloudFormation response object to S3.
  *
@@ -278,6 +281,8 @@ exports.handler = async function (event, context) {
 // getAllAliases gets all aliases out from a string. For example:
 // {"frontend": ["test.foobar.com", "foobar.com"], "api": ["api.foobar.com"]} will return
 // ["test.foobar.com", "foobar.com", "api.foobar.com"].
+// Aliases of global services, such as {"api:global": ["api.foobar.com"]}, are skipped since their
+// records are managed by the global service stack.
 const getAllAliases = function (aliases) {
   let obj;
   try {
@@ -287,6 +292,9 @@ const getAllAliases = function (aliases) {
   }
   var aliasList = [];
   for (var m in obj) {
+    if (m.endsWith(globalAliasesKeySuffix)) {
+      continue;
+    }
     aliasList.push(...obj[m]);
   }
   return new Set(aliasList);
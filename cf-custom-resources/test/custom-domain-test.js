@@ -242,6 +242,43 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Create skips aliases of global services", () => {
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: "bogus",
+      },
+    });
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(handler.handler)
+      .event({
+        RequestType: "Create",
+        ResourceProperties: {
+          AppName: testAppName,
+          EnvName: testEnvName,
+          DomainName: testDomainName,
+          Aliases: `{"frontend:global": ["api.${testAppName}.${testDomainName}"]}`,
+          Region: "us-east-1",
+          LoadBalancerDNS: testLoadBalancerDNS,
+          LoadBalancerHostedZone: testLBHostedZone,
+          AppDNSRole: testRootDNSRole,
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(changeResourceRecordSetsFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Update success", () => {
     const changeResourceRecordSetsFake = sinon.fake.resolves({
       ChangeInfo: {
@@ -33,6 +33,31 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "aurora.yml",
 		},
+		"aurora serverless v2": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				ClusterName:       "aurora",
+				Engine:            "MySQL",
+				InitialDBName:     "main",
+				Envs:              []string{"test"},
+				ServerlessVersion: "v2",
+				MinCapacity:       0.5,
+				MaxCapacity:       16,
+				RotateSecret:      true,
+			}),
+			outFileName: "aurora-v2.yml",
+		},
+		"provisioned aurora": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				ClusterName:        "aurora",
+				Engine:             "PostgreSQL",
+				InitialDBName:      "main",
+				Envs:               []string{"test"},
+				InstanceClass:      "db.r6g.large",
+				ReaderCount:        2,
+				SnapshotIdentifier: "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:mysnapshot",
+			}),
+			outFileName: "aurora-provisioned.yml",
+		},
 		"ddb": {
 			addonMarshaler: addon.NewDDBTemplate(&addon.DynamoDBProps{
 				StorageProps: &addon.StorageProps{
@@ -73,6 +98,47 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"redis": {
+			addonMarshaler: addon.NewRedisTemplate(addon.RedisProps{
+				Name:         "cache",
+				WorkloadType: "Load Balanced Web Service",
+				Envs:         []string{"test"},
+			}),
+			outFileName: "redis.yml",
+		},
+		"redis for rdws": {
+			addonMarshaler: addon.NewRedisTemplate(addon.RedisProps{
+				Name:         "cache",
+				WorkloadType: "Request-Driven Web Service",
+				Envs:         []string{"test"},
+			}),
+			outFileName: "redis-rdws.yml",
+		},
+		"opensearch": {
+			addonMarshaler: addon.NewOpenSearchTemplate(addon.OpenSearchProps{
+				Name:         "search",
+				WorkloadType: "Backend Service",
+				Envs:         []string{"test"},
+			}),
+			outFileName: "opensearch.yml",
+		},
+		"sqs": {
+			addonMarshaler: addon.NewSQSTemplate(&addon.SQSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "queue",
+				},
+			}),
+			outFileName: "queue.yml",
+		},
+		"fifo sqs": {
+			addonMarshaler: addon.NewSQSTemplate(&addon.SQSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "orders",
+				},
+				FIFO: true,
+			}),
+			outFileName: "fifo-queue.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -79,19 +79,11 @@ func (a *Addons) Template() (string, error) {
 		}
 	}
 
-	mergedTemplate := newCFNTemplate("merged")
-	for _, fname := range templateFiles {
-		out, err := a.ws.ReadAddon(a.wlName, fname)
-		if err != nil {
-			return "", fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
-		}
-		tpl := newCFNTemplate(fname)
-		if err := yaml.Unmarshal(out, tpl); err != nil {
-			return "", fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
-		}
-		if err := mergedTemplate.merge(tpl); err != nil {
-			return "", err
-		}
+	mergedTemplate, err := mergeTemplates(templateFiles, a.wlName, func(fname string) ([]byte, error) {
+		return a.ws.ReadAddon(a.wlName, fname)
+	})
+	if err != nil {
+		return "", err
 	}
 	out, err := yaml.Marshal(mergedTemplate)
 	if err != nil {
@@ -166,6 +158,25 @@ func (a *Addons) validateReservedParameters(params yaml.Node, fname string) erro
 	return nil
 }
 
+// mergeTemplates reads each of the addon template files owned by owner and merges them into a single template.
+func mergeTemplates(fnames []string, owner string, read func(fname string) ([]byte, error)) (*cfnTemplate, error) {
+	mergedTemplate := newCFNTemplate("merged")
+	for _, fname := range fnames {
+		out, err := read(fname)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, owner, err)
+		}
+		tpl := newCFNTemplate(fname)
+		if err := yaml.Unmarshal(out, tpl); err != nil {
+			return nil, fmt.Errorf("unmarshal addon %s under %s: %w", fname, owner, err)
+		}
+		if err := mergedTemplate.merge(tpl); err != nil {
+			return nil, err
+		}
+	}
+	return mergedTemplate, nil
+}
+
 func filterFiles(files []string, matchers ...func(string) bool) []string {
 	var matchedFiles []string
 	for _, f := range files {
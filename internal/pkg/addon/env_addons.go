@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envAddonsOwner = "environments"
+
+	// fmtEnvOutputExportName is the format of the export name of an environment addon's output.
+	// The "App" and "Env" parameters are resolved by CloudFormation in the environment addons stack.
+	fmtEnvOutputExportName = "${App}-${Env}-Addons-%s"
+)
+
+type envWorkspaceReader interface {
+	ReadEnvAddonsDir() ([]string, error)
+	ReadEnvAddon(fileName string) ([]byte, error)
+}
+
+// EnvAddons represents additional resources under the "environments/addons/" directory
+// that are deployed once per environment and can be shared by multiple workloads.
+type EnvAddons struct {
+	ws envWorkspaceReader
+}
+
+// NewEnv creates an EnvAddons object.
+func NewEnv() (*EnvAddons, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("workspace cannot be created: %w", err)
+	}
+	return &EnvAddons{
+		ws: ws,
+	}, nil
+}
+
+// Template merges CloudFormation templates under the "environments/addons/" directory into a single
+// CloudFormation template and returns it. Every output of the template is exported so that workloads
+// in the environment can import it.
+//
+// If the addons directory doesn't exist, it returns the empty string and ErrAddonsNotFound.
+func (e *EnvAddons) Template() (string, error) {
+	fnames, err := e.ws.ReadEnvAddonsDir()
+	if err != nil {
+		return "", &ErrAddonsNotFound{
+			WlName:    envAddonsOwner,
+			ParentErr: err,
+		}
+	}
+	if paramFiles := filterFiles(fnames, paramsMatcher); len(paramFiles) > 0 {
+		return "", fmt.Errorf("defining %s is not supported under %s addons/", english.WordSeries(parameterFileNames, "or"), envAddonsOwner)
+	}
+	templateFiles := filterFiles(fnames, yamlMatcher)
+	if len(templateFiles) == 0 {
+		return "", &ErrAddonsNotFound{
+			WlName: envAddonsOwner,
+		}
+	}
+
+	mergedTemplate, err := mergeTemplates(templateFiles, envAddonsOwner, e.ws.ReadEnvAddon)
+	if err != nil {
+		return "", err
+	}
+	if err := exportOutputs(&mergedTemplate.Outputs); err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(mergedTemplate)
+	if err != nil {
+		return "", fmt.Errorf("marshal merged environment addons template: %w", err)
+	}
+	return string(out), nil
+}
+
+// Outputs returns the outputs of the environment addon with the given name.
+// The name is the file name of the addon under the "environments/addons/" directory without its extension.
+func (e *EnvAddons) Outputs(name string) ([]Output, error) {
+	fnames, err := e.ws.ReadEnvAddonsDir()
+	if err != nil {
+		return nil, &ErrAddonsNotFound{
+			WlName:    envAddonsOwner,
+			ParentErr: err,
+		}
+	}
+	for _, fname := range filterFiles(fnames, yamlMatcher, nonParamsMatcher) {
+		if strings.TrimSuffix(fname, filepath.Ext(fname)) != name {
+			continue
+		}
+		out, err := e.ws.ReadEnvAddon(fname)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, envAddonsOwner, err)
+		}
+		outputs, err := Outputs(string(out))
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of addon %s under %s: %w", fname, envAddonsOwner, err)
+		}
+		return outputs, nil
+	}
+	return nil, fmt.Errorf("addon %s not found under %s addons/", name, envAddonsOwner)
+}
+
+// exportOutputs exports every output under the name that workloads import it with,
+// replacing any export that the output already defines.
+func exportOutputs(outputsNode *yaml.Node) error {
+	if outputsNode.IsZero() {
+		return nil
+	}
+	for _, content := range mappingContents(outputsNode) {
+		if content.valueNode.Kind != yaml.MappingNode {
+			return fmt.Errorf(`output "%s" in cloudformation template is not a map`, content.keyNode.Value)
+		}
+		export := &yaml.Node{
+			Kind: yaml.MappingNode,
+			Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: "Name"},
+				{Kind: yaml.ScalarNode, Tag: "!Sub", Value: fmt.Sprintf(fmtEnvOutputExportName, content.keyNode.Value)},
+			},
+		}
+		if existing, ok := mappingNode(content.valueNode)["Export"]; ok {
+			*existing = *export
+			continue
+		}
+		content.valueNode.Content = append(content.valueNode.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "Export"},
+			export,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testEnvAddonBucket = `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+Resources:
+  SharedBucket:
+    Type: AWS::S3::Bucket
+  SharedBucketAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  SharedBucketName:
+    Value: !Ref SharedBucket
+  SharedBucketAccessPolicy:
+    Value: !Ref SharedBucketAccessPolicy
+    Export:
+      Name: my-policy
+`
+
+func TestEnvAddons_Template(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockenvWorkspaceReader)
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"return ErrAddonsNotFound if the addons directory doesn't exist": {
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return(nil, testErr)
+			},
+			wantedErr: &ErrAddonsNotFound{
+				WlName:    "environments",
+				ParentErr: testErr,
+			},
+		},
+		"return ErrAddonsNotFound if the addons directory does not contain yaml files": {
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return([]string{".gitkeep"}, nil)
+			},
+			wantedErr: &ErrAddonsNotFound{
+				WlName: "environments",
+			},
+		},
+		"return an error if a parameters file is defined": {
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return([]string{"bucket.yml", "addons.parameters.yml"}, nil)
+			},
+			wantedErr: errors.New("defining addons.parameters.yaml or addons.parameters.yml is not supported under environments addons/"),
+		},
+		"return an error if a template cannot be read": {
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return([]string{"bucket.yml"}, nil)
+				m.EXPECT().ReadEnvAddon("bucket.yml").Return(nil, testErr)
+			},
+			wantedErr: errors.New("read addon bucket.yml under environments: some error"),
+		},
+		"exports every output under the name imported by workloads": {
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return([]string{"bucket.yml"}, nil)
+				m.EXPECT().ReadEnvAddon("bucket.yml").Return([]byte(testEnvAddonBucket), nil)
+			},
+			wantedTemplate: `Parameters:
+    App:
+        Type: String
+    Env:
+        Type: String
+Resources:
+    SharedBucket:
+        Type: AWS::S3::Bucket
+    SharedBucketAccessPolicy:
+        Type: AWS::IAM::ManagedPolicy
+Outputs:
+    SharedBucketName:
+        Value: !Ref SharedBucket
+        Export:
+            Name: !Sub ${App}-${Env}-Addons-SharedBucketName
+    SharedBucketAccessPolicy:
+        Value: !Ref SharedBucketAccessPolicy
+        Export:
+            Name: !Sub ${App}-${Env}-Addons-SharedBucketAccessPolicy
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockenvWorkspaceReader(ctrl)
+			tc.setupMocks(ws)
+			addons := &EnvAddons{
+				ws: ws,
+			}
+
+			// WHEN
+			actual, err := addons.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, actual)
+		})
+	}
+}
+
+func TestEnvAddons_Outputs(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m *mocks.MockenvWorkspaceReader)
+
+		wantedOutputs []Output
+		wantedErr     error
+	}{
+		"return an error if the addon does not exist": {
+			inName: "queue",
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return([]string{"bucket.yml"}, nil)
+			},
+			wantedErr: errors.New("addon queue not found under environments addons/"),
+		},
+		"return the outputs of the addon": {
+			inName: "bucket",
+			setupMocks: func(m *mocks.MockenvWorkspaceReader) {
+				m.EXPECT().ReadEnvAddonsDir().Return([]string{"table.yaml", "bucket.yml"}, nil)
+				m.EXPECT().ReadEnvAddon("bucket.yml").Return([]byte(testEnvAddonBucket), nil)
+			},
+			wantedOutputs: []Output{
+				{
+					Name: "SharedBucketName",
+				},
+				{
+					Name:            "SharedBucketAccessPolicy",
+					IsManagedPolicy: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockenvWorkspaceReader(ctrl)
+			tc.setupMocks(ws)
+			addons := &EnvAddons{
+				ws: ws,
+			}
+
+			// WHEN
+			actual, err := addons.Outputs(tc.inName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, actual)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/addon/env_addons.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockenvWorkspaceReader is a mock of envWorkspaceReader interface.
+type MockenvWorkspaceReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvWorkspaceReaderMockRecorder
+}
+
+// MockenvWorkspaceReaderMockRecorder is the mock recorder for MockenvWorkspaceReader.
+type MockenvWorkspaceReaderMockRecorder struct {
+	mock *MockenvWorkspaceReader
+}
+
+// NewMockenvWorkspaceReader creates a new mock instance.
+func NewMockenvWorkspaceReader(ctrl *gomock.Controller) *MockenvWorkspaceReader {
+	mock := &MockenvWorkspaceReader{ctrl: ctrl}
+	mock.recorder = &MockenvWorkspaceReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvWorkspaceReader) EXPECT() *MockenvWorkspaceReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvAddon mocks base method.
+func (m *MockenvWorkspaceReader) ReadEnvAddon(fileName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvAddon", fileName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvAddon indicates an expected call of ReadEnvAddon.
+func (mr *MockenvWorkspaceReaderMockRecorder) ReadEnvAddon(fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvAddon", reflect.TypeOf((*MockenvWorkspaceReader)(nil).ReadEnvAddon), fileName)
+}
+
+// ReadEnvAddonsDir mocks base method.
+func (m *MockenvWorkspaceReader) ReadEnvAddonsDir() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvAddonsDir")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvAddonsDir indicates an expected call of ReadEnvAddonsDir.
+func (mr *MockenvWorkspaceReaderMockRecorder) ReadEnvAddonsDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvAddonsDir", reflect.TypeOf((*MockenvWorkspaceReader)(nil).ReadEnvAddonsDir))
+}
@@ -15,11 +15,14 @@ import (
 )
 
 const (
-	dynamoDbTemplatePath = "addons/ddb/cf.yml"
-	s3TemplatePath       = "addons/s3/cf.yml"
-	rdsTemplatePath      = "addons/aurora/cf.yml"
-	rdsRDWSTemplatePath  = "addons/aurora/rdws/cf.yml"
-	rdsRDWSParamsPath    = "addons/aurora/rdws/addons.parameters.yml"
+	dynamoDbTemplatePath   = "addons/ddb/cf.yml"
+	s3TemplatePath         = "addons/s3/cf.yml"
+	rdsTemplatePath        = "addons/aurora/cf.yml"
+	rdsRDWSTemplatePath    = "addons/aurora/rdws/cf.yml"
+	rdsRDWSParamsPath      = "addons/aurora/rdws/addons.parameters.yml"
+	redisTemplatePath      = "addons/redis/cf.yml"
+	openSearchTemplatePath = "addons/opensearch/cf.yml"
+	sqsTemplatePath        = "addons/sqs/cf.yml"
 )
 
 const (
@@ -28,6 +31,12 @@ const (
 	RDSEngineTypePostgreSQL = "PostgreSQL"
 )
 
+const (
+	// Versions of RDS Aurora Serverless.
+	RDSServerlessV1 = "v1"
+	RDSServerlessV2 = "v2"
+)
+
 var regexpMatchAttribute = regexp.MustCompile(`^(\S+):([sbnSBN])`)
 
 var storageTemplateFunctions = map[string]interface{}{
@@ -71,7 +80,7 @@ func (s *S3Template) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
-// RDSTemplate contains configuration options which fully describe a RDS Aurora Serverless cluster.
+// RDSTemplate contains configuration options which fully describe a RDS Aurora cluster.
 // Implements the encoding.BinaryMarshaler interface.
 type RDSTemplate struct {
 	RDSProps
@@ -92,7 +101,59 @@ func (r *RDSTemplate) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// RedisTemplate contains configuration options which fully describe an ElastiCache Redis replication group.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (r *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisTemplatePath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// OpenSearchTemplate contains configuration options which fully describe an OpenSearch domain.
+// Implements the encoding.BinaryMarshaler interface.
+type OpenSearchTemplate struct {
+	OpenSearchProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (o *OpenSearchTemplate) MarshalBinary() ([]byte, error) {
+	content, err := o.parser.Parse(openSearchTemplatePath, *o, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// SQSTemplate contains configuration options which fully describe an SQS queue.
+// Implements the encoding.BinaryMarshaler interface.
+type SQSTemplate struct {
+	SQSProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (s *SQSTemplate) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(sqsTemplatePath, *s, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 // RDSParams represents the addons.parameters.yml file for a RDS Aurora Serverless cluster.
+// The same parameters are required by any storage addon that a Request-Driven Web Service reaches through its VPC connector.
 type RDSParams struct {
 	parser template.Parser
 }
@@ -106,7 +167,7 @@ func (r *RDSParams) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
-// StorageProps holds basic input properties for addon.NewDDBTemplate() or addon.NewS3Template().
+// StorageProps holds basic input properties for addon.NewDDBTemplate(), addon.NewS3Template() or addon.NewSQSTemplate().
 type StorageProps struct {
 	Name string
 }
@@ -149,10 +210,42 @@ func NewDDBTemplate(input *DynamoDBProps) *DynamoDBTemplate {
 type RDSProps struct {
 	WorkloadType   string   // The type of the workload associated with the RDS addon.
 	ClusterName    string   // The name of the cluster.
-	Engine         string   // The engine type of the RDS Aurora Serverless cluster.
+	Engine         string   // The engine type of the RDS Aurora cluster.
 	InitialDBName  string   // The name of the initial database created inside the cluster.
 	ParameterGroup string   // The parameter group to use for the cluster.
 	Envs           []string // The copilot environments found inside the current app.
+
+	ServerlessVersion  string  // The version of Aurora Serverless, defaults to v1. Ignored if InstanceClass is set.
+	MinCapacity        float64 // The minimum number of ACUs of an Aurora Serverless v2 cluster.
+	MaxCapacity        float64 // The maximum number of ACUs of an Aurora Serverless v2 cluster.
+	InstanceClass      string  // The DB instance class of a provisioned cluster, for example "db.r6g.large".
+	ReaderCount        int     // The number of reader instances of a provisioned or Aurora Serverless v2 cluster.
+	SnapshotIdentifier string  // The DB cluster snapshot to restore the cluster from.
+	RotateSecret       bool    // Whether the master user secret is rotated by a Secrets Manager hosted rotation function.
+}
+
+// IsProvisioned returns true if the cluster runs DB instances of a fixed class.
+func (p RDSProps) IsProvisioned() bool {
+	return p.InstanceClass != ""
+}
+
+// IsServerlessV2 returns true if the cluster runs Aurora Serverless v2 instances.
+func (p RDSProps) IsServerlessV2() bool {
+	return !p.IsProvisioned() && p.ServerlessVersion == RDSServerlessV2
+}
+
+// IsServerlessV1 returns true if the cluster uses the Aurora Serverless v1 engine mode.
+func (p RDSProps) IsServerlessV1() bool {
+	return !p.IsProvisioned() && !p.IsServerlessV2()
+}
+
+// Readers returns the 1-based indexes of the reader instances of the cluster.
+func (p RDSProps) Readers() []int {
+	readers := make([]int, p.ReaderCount)
+	for i := range readers {
+		readers[i] = i + 1
+	}
+	return readers
 }
 
 // NewRDSTemplate creates a new RDS marshaler which can be used to write a RDS CloudFormation template.
@@ -164,6 +257,53 @@ func NewRDSTemplate(input RDSProps) *RDSTemplate {
 	}
 }
 
+// RedisProps holds ElastiCache Redis-specific properties for addon.NewRedisTemplate().
+type RedisProps struct {
+	WorkloadType string   // The type of the workload associated with the Redis addon.
+	Name         string   // The name of the replication group.
+	Envs         []string // The copilot environments found inside the current app.
+}
+
+// NewRedisTemplate creates a new ElastiCache Redis marshaler which can be used to write a Redis CloudFormation template.
+func NewRedisTemplate(input RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: input,
+
+		parser: template.New(),
+	}
+}
+
+// OpenSearchProps holds OpenSearch-specific properties for addon.NewOpenSearchTemplate().
+type OpenSearchProps struct {
+	WorkloadType string   // The type of the workload associated with the OpenSearch addon.
+	Name         string   // The name of the domain.
+	Envs         []string // The copilot environments found inside the current app.
+}
+
+// NewOpenSearchTemplate creates a new OpenSearch marshaler which can be used to write an OpenSearch CloudFormation template.
+func NewOpenSearchTemplate(input OpenSearchProps) *OpenSearchTemplate {
+	return &OpenSearchTemplate{
+		OpenSearchProps: input,
+
+		parser: template.New(),
+	}
+}
+
+// SQSProps contains SQS-specific properties for addon.NewSQSTemplate().
+type SQSProps struct {
+	*StorageProps
+	FIFO bool // True if the queue is a first-in-first-out queue.
+}
+
+// NewSQSTemplate creates a new SQS marshaler which can be used to write CF via addonWriter.
+func NewSQSTemplate(input *SQSProps) *SQSTemplate {
+	return &SQSTemplate{
+		SQSProps: *input,
+
+		parser: template.New(),
+	}
+}
+
 // NewRDSParams creates a new RDS parameters marshaler.
 func NewRDSParams() *RDSParams {
 	return &RDSParams{
@@ -184,6 +184,141 @@ func TestRDSTemplate_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRedisTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, redis *RedisTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *redis, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *redis, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RedisTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestOpenSearchTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, os *OpenSearchTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, os *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				os.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *os, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, os *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				os.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *os, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &OpenSearchTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestSQSTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, sqs *SQSTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, sqs *SQSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				sqs.parser = m
+				m.EXPECT().Parse(sqsTemplatePath, *sqs, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, sqs *SQSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				sqs.parser = m
+				m.EXPECT().Parse(sqsTemplatePath, *sqs, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &SQSTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestRDSParams_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		mockDependencies func(ctrl *gomock.Controller, r *RDSParams)
@@ -494,3 +629,43 @@ func TestBuildLocalSecondaryIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestRDSProps_Mode(t *testing.T) {
+	testCases := map[string]struct {
+		in RDSProps
+
+		wantedServerlessV1 bool
+		wantedServerlessV2 bool
+		wantedProvisioned  bool
+	}{
+		"defaults to serverless v1": {
+			in:                 RDSProps{},
+			wantedServerlessV1: true,
+		},
+		"serverless v2": {
+			in: RDSProps{
+				ServerlessVersion: RDSServerlessV2,
+			},
+			wantedServerlessV2: true,
+		},
+		"provisioned if an instance class is set": {
+			in: RDSProps{
+				ServerlessVersion: RDSServerlessV2,
+				InstanceClass:     "db.r6g.large",
+			},
+			wantedProvisioned: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedServerlessV1, tc.in.IsServerlessV1())
+			require.Equal(t, tc.wantedServerlessV2, tc.in.IsServerlessV2())
+			require.Equal(t, tc.wantedProvisioned, tc.in.IsProvisioned())
+		})
+	}
+}
+
+func TestRDSProps_Readers(t *testing.T) {
+	require.Equal(t, []int{}, RDSProps{}.Readers())
+	require.Equal(t, []int{1, 2, 3}, RDSProps{ReaderCount: 3}.Readers())
+}
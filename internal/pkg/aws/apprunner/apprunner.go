@@ -33,6 +33,7 @@ const (
 )
 
 type api interface {
+	DescribeAutoScalingConfiguration(input *apprunner.DescribeAutoScalingConfigurationInput) (*apprunner.DescribeAutoScalingConfigurationOutput, error)
 	DescribeService(input *apprunner.DescribeServiceInput) (*apprunner.DescribeServiceOutput, error)
 	ListOperations(input *apprunner.ListOperationsInput) (*apprunner.ListOperationsOutput, error)
 	ListServices(input *apprunner.ListServicesInput) (*apprunner.ListServicesOutput, error)
@@ -70,6 +71,14 @@ func (a *AppRunner) DescribeService(svcARN string) (*Service, error) {
 	}
 	sort.SliceStable(envVars, func(i int, j int) bool { return envVars[i].Name < envVars[j].Name })
 
+	var autoScaling *AutoScalingConfiguration
+	if summary := resp.Service.AutoScalingConfigurationSummary; summary != nil && summary.AutoScalingConfigurationArn != nil {
+		autoScaling, err = a.describeAutoScalingConfiguration(aws.StringValue(summary.AutoScalingConfigurationArn))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Service{
 		ServiceARN:           aws.StringValue(resp.Service.ServiceArn),
 		Name:                 aws.StringValue(resp.Service.ServiceName),
@@ -83,6 +92,24 @@ func (a *AppRunner) DescribeService(svcARN string) (*Service, error) {
 		Memory:               *resp.Service.InstanceConfiguration.Memory,
 		ImageID:              *resp.Service.SourceConfiguration.ImageRepository.ImageIdentifier,
 		Port:                 *resp.Service.SourceConfiguration.ImageRepository.ImageConfiguration.Port,
+		AutoScaling:          autoScaling,
+	}, nil
+}
+
+func (a *AppRunner) describeAutoScalingConfiguration(configARN string) (*AutoScalingConfiguration, error) {
+	resp, err := a.client.DescribeAutoScalingConfiguration(&apprunner.DescribeAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(configARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe auto scaling configuration %s: %w", configARN, err)
+	}
+	config := resp.AutoScalingConfiguration
+	return &AutoScalingConfiguration{
+		Name:           aws.StringValue(config.AutoScalingConfigurationName),
+		Revision:       aws.Int64Value(config.AutoScalingConfigurationRevision),
+		MaxConcurrency: aws.Int64Value(config.MaxConcurrency),
+		MinSize:        aws.Int64Value(config.MinSize),
+		MaxSize:        aws.Int64Value(config.MaxSize),
 	}, nil
 }
 
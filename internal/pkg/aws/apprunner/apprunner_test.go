@@ -83,6 +83,103 @@ func TestAppRunner_DescribeService(t *testing.T) {
 				ImageID: "111111111111.dkr.ecr.us-east-1.amazonaws.com/testapp/testsvc:8cdef9a",
 			},
 		},
+		"success with an auto scaling configuration": {
+			serviceArn: "mock-svc-arn",
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(&apprunner.DescribeServiceInput{
+					ServiceArn: aws.String("mock-svc-arn"),
+				}).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{
+						ServiceArn:  aws.String("111111111111.apprunner.us-east-1.amazonaws.com/service/testsvc/test-svc-id"),
+						ServiceId:   aws.String("test-svc-id"),
+						ServiceName: aws.String("testapp-testenv-testsvc"),
+						ServiceUrl:  aws.String("tumkjmvjif.public.us-east-1.apprunner.aws.dev"),
+						Status:      aws.String("RUNNING"),
+						CreatedAt:   &mockTime,
+						UpdatedAt:   &mockTime,
+						AutoScalingConfigurationSummary: &apprunner.AutoScalingConfigurationSummary{
+							AutoScalingConfigurationArn: aws.String("mock-scaling-arn"),
+						},
+						InstanceConfiguration: &apprunner.InstanceConfiguration{
+							Cpu:    aws.String("1024"),
+							Memory: aws.String("2048"),
+						},
+						SourceConfiguration: &apprunner.SourceConfiguration{
+							ImageRepository: &apprunner.ImageRepository{
+								ImageIdentifier: aws.String("111111111111.dkr.ecr.us-east-1.amazonaws.com/testapp/testsvc:8cdef9a"),
+								ImageConfiguration: &apprunner.ImageConfiguration{
+									Port: aws.String("80"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeAutoScalingConfiguration(&apprunner.DescribeAutoScalingConfigurationInput{
+					AutoScalingConfigurationArn: aws.String("mock-scaling-arn"),
+				}).Return(&apprunner.DescribeAutoScalingConfigurationOutput{
+					AutoScalingConfiguration: &apprunner.AutoScalingConfiguration{
+						AutoScalingConfigurationName:     aws.String("DefaultConfiguration"),
+						AutoScalingConfigurationRevision: aws.Int64(1),
+						MaxConcurrency:                   aws.Int64(100),
+						MinSize:                          aws.Int64(1),
+						MaxSize:                          aws.Int64(25),
+					},
+				}, nil)
+			},
+			wantSvc: Service{
+				ServiceARN:  "111111111111.apprunner.us-east-1.amazonaws.com/service/testsvc/test-svc-id",
+				Name:        "testapp-testenv-testsvc",
+				ID:          "test-svc-id",
+				Status:      "RUNNING",
+				ServiceURL:  "tumkjmvjif.public.us-east-1.apprunner.aws.dev",
+				DateCreated: mockTime,
+				DateUpdated: mockTime,
+				CPU:         "1024",
+				Memory:      "2048",
+				Port:        "80",
+				ImageID:     "111111111111.dkr.ecr.us-east-1.amazonaws.com/testapp/testsvc:8cdef9a",
+				AutoScaling: &AutoScalingConfiguration{
+					Name:           "DefaultConfiguration",
+					Revision:       1,
+					MaxConcurrency: 100,
+					MinSize:        1,
+					MaxSize:        25,
+				},
+			},
+		},
+		"error if fail to describe the auto scaling configuration": {
+			serviceArn: "mock-svc-arn",
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(gomock.Any()).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{
+						ServiceArn:  aws.String("111111111111.apprunner.us-east-1.amazonaws.com/service/testsvc/test-svc-id"),
+						ServiceId:   aws.String("test-svc-id"),
+						ServiceName: aws.String("testapp-testenv-testsvc"),
+						ServiceUrl:  aws.String("tumkjmvjif.public.us-east-1.apprunner.aws.dev"),
+						Status:      aws.String("RUNNING"),
+						CreatedAt:   &mockTime,
+						UpdatedAt:   &mockTime,
+						AutoScalingConfigurationSummary: &apprunner.AutoScalingConfigurationSummary{
+							AutoScalingConfigurationArn: aws.String("mock-scaling-arn"),
+						},
+						InstanceConfiguration: &apprunner.InstanceConfiguration{
+							Cpu:    aws.String("1024"),
+							Memory: aws.String("2048"),
+						},
+						SourceConfiguration: &apprunner.SourceConfiguration{
+							ImageRepository: &apprunner.ImageRepository{
+								ImageIdentifier: aws.String("111111111111.dkr.ecr.us-east-1.amazonaws.com/testapp/testsvc:8cdef9a"),
+								ImageConfiguration: &apprunner.ImageConfiguration{
+									Port: aws.String("80"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeAutoScalingConfiguration(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("describe auto scaling configuration mock-scaling-arn: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
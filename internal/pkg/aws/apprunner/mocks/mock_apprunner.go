@@ -34,6 +34,21 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// DescribeAutoScalingConfiguration mocks base method.
+func (m *Mockapi) DescribeAutoScalingConfiguration(input *apprunner.DescribeAutoScalingConfigurationInput) (*apprunner.DescribeAutoScalingConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutoScalingConfiguration", input)
+	ret0, _ := ret[0].(*apprunner.DescribeAutoScalingConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAutoScalingConfiguration indicates an expected call of DescribeAutoScalingConfiguration.
+func (mr *MockapiMockRecorder) DescribeAutoScalingConfiguration(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutoScalingConfiguration", reflect.TypeOf((*Mockapi)(nil).DescribeAutoScalingConfiguration), input)
+}
+
 // DescribeService mocks base method.
 func (m *Mockapi) DescribeService(input *apprunner.DescribeServiceInput) (*apprunner.DescribeServiceOutput, error) {
 	m.ctrl.T.Helper()
@@ -20,6 +20,16 @@ type Service struct {
 	ImageID              string
 	Port                 string
 	EnvironmentVariables []*EnvironmentVariable
+	AutoScaling          *AutoScalingConfiguration
+}
+
+// AutoScalingConfiguration wraps up the AppRunner AutoScalingConfiguration associated with a service.
+type AutoScalingConfiguration struct {
+	Name           string
+	Revision       int64
+	MaxConcurrency int64
+	MinSize        int64
+	MaxSize        int64
 }
 
 type EnvironmentVariable struct {
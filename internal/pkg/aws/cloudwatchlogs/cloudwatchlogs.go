@@ -18,6 +18,12 @@ import (
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+
+	// followOverlap is how far back filtered log events are retrieved again while following logs,
+	// so that events ingested after newer ones are not skipped.
+	followOverlap = 30 * time.Second
+	// defaultQueryTimeout is how long to wait for the results of a query if no timeout is specified.
+	defaultQueryTimeout = 5 * time.Minute
 )
 
 var (
@@ -28,6 +34,10 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error)
+	StopQuery(input *cloudwatchlogs.StopQueryInput) (*cloudwatchlogs.StopQueryOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -41,6 +51,8 @@ type LogEventsOutput struct {
 	Events []*Event
 	// Timestamp for the last event
 	StreamLastEventTime map[string]int64
+	// IDs of the recently retrieved filtered events mapped to their timestamps.
+	SeenEventIDs map[string]int64
 }
 
 // LogEventsOpts wraps the parameters to call LogEvents.
@@ -50,7 +62,19 @@ type LogEventsOpts struct {
 	Limit               *int64
 	StartTime           *int64
 	EndTime             *int64
+	FilterPattern       string // If empty, retrieve all log events.
 	StreamLastEventTime map[string]int64
+	SeenEventIDs        map[string]int64 // Filtered events that were already retrieved are skipped.
+}
+
+// QueryOpts wraps the parameters to call Query.
+type QueryOpts struct {
+	LogGroup  string
+	Query     string
+	Limit     *int64
+	StartTime int64         // Unix timestamp in milliseconds.
+	EndTime   int64         // Unix timestamp in milliseconds.
+	Timeout   time.Duration // If zero, wait up to 5 minutes for the results.
 }
 
 // New returns a CloudWatchLogs configured against the input session.
@@ -89,6 +113,9 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]st
 
 // LogEvents returns an array of Cloudwatch Logs events.
 func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
+	if opts.FilterPattern != "" {
+		return c.filteredLogEvents(opts)
+	}
 	var events []*Event
 	in := initGetLogEventsInput(opts)
 	logStreams, err := c.logStreams(opts.LogGroup, opts.LogStreams...)
@@ -140,6 +167,133 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// filteredLogEvents returns the Cloudwatch Logs events that match the filter pattern.
+func (c *CloudWatchLogs) filteredLogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
+	logStreams, err := c.logStreams(opts.LogGroup, opts.LogStreams...)
+	if err != nil {
+		return nil, err
+	}
+	if len(logStreams) == 0 {
+		return &LogEventsOutput{
+			StreamLastEventTime: opts.StreamLastEventTime,
+			SeenEventIDs:        opts.SeenEventIDs,
+		}, nil
+	}
+	streamLastEventTime := make(map[string]int64)
+	var lastEventTime int64
+	for k, v := range opts.StreamLastEventTime {
+		streamLastEventTime[k] = v
+		if v > lastEventTime {
+			lastEventTime = v
+		}
+	}
+	in := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:  aws.String(opts.LogGroup),
+		FilterPattern: aws.String(opts.FilterPattern),
+		StartTime:     opts.StartTime,
+		EndTime:       opts.EndTime,
+	}
+	if len(opts.LogStreams) != 0 {
+		in.LogStreamNames = aws.StringSlice(logStreams)
+	}
+	seenEventIDs := make(map[string]int64)
+	if lastEventTime != 0 {
+		// Events can be ingested after newer ones, so look back before the last event that was returned
+		// and skip the events that were already returned instead of starting right after it.
+		startTime := lastEventTime - followOverlap.Milliseconds()
+		if startTime < aws.Int64Value(opts.StartTime) {
+			startTime = aws.Int64Value(opts.StartTime)
+		}
+		in.StartTime = aws.Int64(startTime)
+		for id, timestamp := range opts.SeenEventIDs {
+			if timestamp >= startTime {
+				seenEventIDs[id] = timestamp
+			}
+		}
+	}
+	var events []*Event
+	for {
+		resp, err := c.client.FilterLogEvents(in)
+		if err != nil {
+			return nil, fmt.Errorf("filter log events of log group %s: %w", opts.LogGroup, err)
+		}
+		for _, event := range resp.Events {
+			id := aws.StringValue(event.EventId)
+			if _, ok := seenEventIDs[id]; ok {
+				continue
+			}
+			log := &Event{
+				LogStreamName: aws.StringValue(event.LogStreamName),
+				IngestionTime: aws.Int64Value(event.IngestionTime),
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+			}
+			events = append(events, log)
+			seenEventIDs[id] = log.Timestamp
+			if log.Timestamp > streamLastEventTime[log.LogStreamName] {
+				streamLastEventTime[log.LogStreamName] = log.Timestamp
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	if limit := int(aws.Int64Value(opts.Limit)); limit != 0 {
+		events = truncateEvents(limit, events)
+	}
+	return &LogEventsOutput{
+		Events:              events,
+		StreamLastEventTime: streamLastEventTime,
+		SeenEventIDs:        seenEventIDs,
+	}, nil
+}
+
+// Query runs a CloudWatch Logs Insights query against a log group and waits for its results.
+func (c *CloudWatchLogs) Query(opts QueryOpts) (*QueryResults, error) {
+	resp, err := c.client.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(opts.LogGroup),
+		QueryString:  aws.String(opts.Query),
+		StartTime:    aws.Int64(opts.StartTime / 1000),
+		EndTime:      aws.Int64(opts.EndTime / 1000),
+		Limit:        opts.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start query on log group %s: %w", opts.LogGroup, err)
+	}
+	queryID := aws.StringValue(resp.QueryId)
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultQueryTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		out, err := c.client.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: aws.String(queryID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+		}
+		switch status := aws.StringValue(out.Status); status {
+		case cloudwatchlogs.QueryStatusComplete:
+			return newQueryResults(out.Results), nil
+		case cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning:
+			if time.Now().After(deadline) {
+				if _, err := c.client.StopQuery(&cloudwatchlogs.StopQueryInput{
+					QueryId: aws.String(queryID),
+				}); err != nil {
+					return nil, fmt.Errorf("stop query %s: %w", queryID, err)
+				}
+				return nil, fmt.Errorf("query %s did not complete within %s", queryID, timeout)
+			}
+			time.Sleep(SleepDuration)
+		default:
+			return nil, fmt.Errorf("query %s ended with status %s", queryID, status)
+		}
+	}
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
@@ -24,10 +25,13 @@ func TestLogEvents(t *testing.T) {
 		endTime                  *int64
 		limit                    *int64
 		lastEventTime            map[string]int64
+		seenEventIDs             map[string]int64
+		filterPattern            string
 		mockcloudwatchlogsClient func(m *mocks.Mockapi)
 
 		wantLogEvents     []*Event
 		wantLastEventTime map[string]int64
+		wantSeenEventIDs  map[string]int64
 		wantErr           error
 	}{
 		"should get log stream name and return log events": {
@@ -237,6 +241,178 @@ func TestLogEvents(t *testing.T) {
 			wantLogEvents: nil,
 			wantErr:       fmt.Errorf("get log events of %s/%s: %w", "mockLogGroup", "mockLogStream", mockError),
 		},
+		"should filter log events across pages": {
+			logGroupName:  "mockLogGroup",
+			logStream:     []string{"copilot/mockLogGroup/good"},
+			filterPattern: "ERROR",
+			limit:         aws.Int64(2),
+			lastEventTime: map[string]int64{
+				"copilot/mockLogGroup/goodLogStream1": 5,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+						},
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/badLogStream1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice([]string{"copilot/mockLogGroup/goodLogStream1"}),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(0),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("1"),
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+							Message:       aws.String("ERROR first"),
+							Timestamp:     aws.Int64(6),
+						},
+						{
+							EventId:       aws.String("2"),
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+							Message:       aws.String("ERROR second"),
+							Timestamp:     aws.Int64(7),
+						},
+					},
+					NextToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice([]string{"copilot/mockLogGroup/goodLogStream1"}),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(0),
+					NextToken:      aws.String("mockToken"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("3"),
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+							Message:       aws.String("ERROR third"),
+							Timestamp:     aws.Int64(8),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "copilot/mockLogGroup/goodLogStream1",
+					Message:       "ERROR second",
+					Timestamp:     7,
+				},
+				{
+					LogStreamName: "copilot/mockLogGroup/goodLogStream1",
+					Message:       "ERROR third",
+					Timestamp:     8,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/mockLogGroup/goodLogStream1": 8,
+			},
+			wantSeenEventIDs: map[string]int64{
+				"1": 6,
+				"2": 7,
+				"3": 8,
+			},
+		},
+		"should retrieve filtered log events ingested late while following": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "ERROR",
+			startTime:     aws.Int64(10000),
+			lastEventTime: map[string]int64{
+				"copilot/mockLogGroup/goodLogStream1": 60000,
+				"copilot/mockLogGroup/goodLogStream2": 20000,
+			},
+			seenEventIDs: map[string]int64{
+				"old":      20000,
+				"previous": 60000,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+						},
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream2"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					FilterPattern: aws.String("ERROR"),
+					StartTime:     aws.Int64(30000),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("late"),
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream2"),
+							Message:       aws.String("ERROR late"),
+							Timestamp:     aws.Int64(50000),
+						},
+						{
+							EventId:       aws.String("previous"),
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+							Message:       aws.String("ERROR previous"),
+							Timestamp:     aws.Int64(60000),
+						},
+						{
+							EventId:       aws.String("new"),
+							LogStreamName: aws.String("copilot/mockLogGroup/goodLogStream1"),
+							Message:       aws.String("ERROR new"),
+							Timestamp:     aws.Int64(61000),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "copilot/mockLogGroup/goodLogStream2",
+					Message:       "ERROR late",
+					Timestamp:     50000,
+				},
+				{
+					LogStreamName: "copilot/mockLogGroup/goodLogStream1",
+					Message:       "ERROR new",
+					Timestamp:     61000,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/mockLogGroup/goodLogStream1": 61000,
+				"copilot/mockLogGroup/goodLogStream2": 50000,
+			},
+			wantSeenEventIDs: map[string]int64{
+				"late":     50000,
+				"previous": 60000,
+				"new":      61000,
+			},
+		},
+		"returns error if fail to filter log events": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "ERROR",
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					FilterPattern: aws.String("ERROR"),
+				}).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("filter log events of log group mockLogGroup: %w", mockError),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -258,6 +434,8 @@ func TestLogEvents(t *testing.T) {
 				LogStreams:          tc.logStream,
 				StartTime:           tc.startTime,
 				StreamLastEventTime: tc.lastEventTime,
+				SeenEventIDs:        tc.seenEventIDs,
+				FilterPattern:       tc.filterPattern,
 			})
 
 			if gotErr != nil {
@@ -265,6 +443,148 @@ func TestLogEvents(t *testing.T) {
 			} else {
 				require.ElementsMatch(t, tc.wantLogEvents, gotLogEventsOutput.Events)
 				require.Equal(t, tc.wantLastEventTime, gotLogEventsOutput.StreamLastEventTime)
+				require.Equal(t, tc.wantSeenEventIDs, gotLogEventsOutput.SeenEventIDs)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		timeout                  time.Duration
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantResults *QueryResults
+		wantErr     error
+	}{
+		"returns error if fail to start query": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("start query on log group mockLogGroup: %w", mockError),
+		},
+		"returns error if fail to get query results": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("get results of query mockQueryID: %w", mockError),
+		},
+		"returns error if the query fails": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusFailed),
+				}, nil)
+			},
+
+			wantErr: fmt.Errorf("query mockQueryID ended with status Failed"),
+		},
+		"returns error if fail to stop a query that timed out": {
+			timeout: time.Nanosecond,
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusRunning),
+				}, nil)
+				m.EXPECT().StopQuery(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("stop query mockQueryID: %w", mockError),
+		},
+		"stops the query if it does not complete within the timeout": {
+			timeout: time.Nanosecond,
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusScheduled),
+				}, nil)
+				m.EXPECT().StopQuery(&cloudwatchlogs.StopQueryInput{
+					QueryId: aws.String("mockQueryID"),
+				}).Return(&cloudwatchlogs.StopQueryOutput{}, nil)
+			},
+
+			wantErr: fmt.Errorf("query mockQueryID did not complete within 1ns"),
+		},
+		"success": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(&cloudwatchlogs.StartQueryInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					QueryString:  aws.String("stats count(*) by @logStream"),
+					StartTime:    aws.Int64(1000),
+					EndTime:      aws.Int64(2000),
+					Limit:        aws.Int64(10),
+				}).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String("mockQueryID"),
+				}, nil)
+				m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+					QueryId: aws.String("mockQueryID"),
+				}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusComplete),
+					Results: [][]*cloudwatchlogs.ResultField{
+						{
+							{Field: aws.String("@logStream"), Value: aws.String("copilot/web/1")},
+							{Field: aws.String("count(*)"), Value: aws.String("10")},
+							{Field: aws.String("@ptr"), Value: aws.String("abc")},
+						},
+						{
+							{Field: aws.String("@logStream"), Value: aws.String("copilot/nginx/1")},
+							{Field: aws.String("count(*)"), Value: aws.String("2")},
+						},
+					},
+				}, nil)
+			},
+
+			wantResults: &QueryResults{
+				Fields: []string{"@logStream", "count(*)"},
+				Rows: []map[string]string{
+					{"@logStream": "copilot/web/1", "count(*)": "10"},
+					{"@logStream": "copilot/nginx/1", "count(*)": "2"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			got, err := service.Query(QueryOpts{
+				LogGroup:  "mockLogGroup",
+				Query:     "stats count(*) by @logStream",
+				Limit:     aws.Int64(10),
+				StartTime: 1000000,
+				EndTime:   2000000,
+				Timeout:   tc.timeout,
+			})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantResults, got)
 			}
 		})
 	}
@@ -36,13 +36,18 @@ func (l *Event) JSONString() (string, error) {
 
 // HumanString returns the stringified LogEvent struct with human readable format.
 func (l *Event) HumanString() string {
+	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.ColoredMessage())
+}
+
+// ColoredMessage returns the message of the log event with fatal and warning codes highlighted.
+func (l *Event) ColoredMessage() string {
 	for _, code := range fatalCodes {
 		l.Message = colorCodeMessage(l.Message, code, color.Red)
 	}
 	for _, code := range warningCodes {
 		l.Message = colorCodeMessage(l.Message, code, color.Yellow)
 	}
-	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.Message)
+	return l.Message
 }
 
 func (l *Event) shortLogStreamName() string {
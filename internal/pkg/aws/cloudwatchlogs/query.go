@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+const (
+	// queryPointerField is the field that CloudWatch Logs Insights adds to every result to identify the log event.
+	queryPointerField = "@ptr"
+
+	queryResultsMinCellWidth     = 10
+	queryResultsCellPaddingWidth = 2
+)
+
+// QueryResults represents the results of a CloudWatch Logs Insights query.
+type QueryResults struct {
+	Fields []string            // Field names in the order they first appear in the results.
+	Rows   []map[string]string // Each row maps a field name to its value.
+}
+
+func newQueryResults(results [][]*cloudwatchlogs.ResultField) *QueryResults {
+	out := &QueryResults{
+		Fields: []string{},
+		Rows:   []map[string]string{},
+	}
+	seen := make(map[string]bool)
+	for _, result := range results {
+		row := make(map[string]string)
+		for _, field := range result {
+			name := aws.StringValue(field.Field)
+			if name == queryPointerField {
+				continue
+			}
+			if !seen[name] {
+				seen[name] = true
+				out.Fields = append(out.Fields, name)
+			}
+			row[name] = aws.StringValue(field.Value)
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out
+}
+
+// JSONString returns the stringified query results with json format, one result per line.
+func (r *QueryResults) JSONString() (string, error) {
+	var sb strings.Builder
+	for _, row := range r.Rows {
+		b, err := json.Marshal(row)
+		if err != nil {
+			return "", fmt.Errorf("marshal a query result: %w", err)
+		}
+		sb.WriteString(fmt.Sprintf("%s\n", b))
+	}
+	return sb.String(), nil
+}
+
+// HumanString returns the stringified query results as a table.
+func (r *QueryResults) HumanString() string {
+	if len(r.Fields) == 0 {
+		return "No results found.\n"
+	}
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, queryResultsMinCellWidth, 4, queryResultsCellPaddingWidth, ' ', 0)
+	fmt.Fprintf(writer, "%s\n", strings.Join(r.Fields, "\t"))
+	separators := make([]string, len(r.Fields))
+	for i, field := range r.Fields {
+		separators[i] = strings.Repeat("-", len(field))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(separators, "\t"))
+	for _, row := range r.Rows {
+		values := make([]string, len(r.Fields))
+		for i, field := range r.Fields {
+			values[i] = strings.Join(strings.Fields(row[field]), " ")
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(values, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResults_String(t *testing.T) {
+	testCases := map[string]struct {
+		results *QueryResults
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no results": {
+			results: &QueryResults{},
+
+			wantedHumanString: "No results found.\n",
+			wantedJSONString:  "",
+		},
+		"renders results": {
+			results: &QueryResults{
+				Fields: []string{"@timestamp", "@message"},
+				Rows: []map[string]string{
+					{"@timestamp": "2022-03-01 10:00:00.000", "@message": "GET /healthcheck 200\n"},
+					{"@message": "ERROR could not connect"},
+				},
+			},
+
+			wantedHumanString: `@timestamp               @message
+----------               --------
+2022-03-01 10:00:00.000  GET /healthcheck 200
+                         ERROR could not connect
+`,
+			wantedJSONString: `{"@message":"GET /healthcheck 200\n","@timestamp":"2022-03-01 10:00:00.000"}
+{"@message":"ERROR could not connect"}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.results.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSONString, json)
+			require.Equal(t, tc.wantedHumanString, tc.results.HumanString())
+		})
+	}
+}
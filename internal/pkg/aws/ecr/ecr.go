@@ -6,8 +6,11 @@ package ecr
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +27,14 @@ const (
 	urlFmtStringForCN = "%s.dkr.ecr.%s.amazonaws.com.cn/%s"
 	arnResourcePrefix = "repository/"
 	batchDeleteLimit  = 100
+
+	layerUploadPartSize = 10 * 1024 * 1024 // Size in bytes of each part when uploading a layer.
+)
+
+// Media types of the image manifests that can be copied between repositories.
+const (
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
 )
 
 type api interface {
@@ -31,17 +42,36 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	BatchGetImage(*ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error)
+	BatchCheckLayerAvailability(*ecr.BatchCheckLayerAvailabilityInput) (*ecr.BatchCheckLayerAvailabilityOutput, error)
+	GetDownloadUrlForLayer(*ecr.GetDownloadUrlForLayerInput) (*ecr.GetDownloadUrlForLayerOutput, error)
+	InitiateLayerUpload(*ecr.InitiateLayerUploadInput) (*ecr.InitiateLayerUploadOutput, error)
+	UploadLayerPart(*ecr.UploadLayerPartInput) (*ecr.UploadLayerPartOutput, error)
+	CompleteLayerUpload(*ecr.CompleteLayerUploadInput) (*ecr.CompleteLayerUploadOutput, error)
+	PutImage(*ecr.PutImageInput) (*ecr.PutImageOutput, error)
+}
+
+type httpClient interface {
+	Get(url string) (*http.Response, error)
 }
 
 // ECR wraps an AWS ECR client.
 type ECR struct {
 	client api
+
+	// regionalClient returns a client with the same credentials in another region.
+	regionalClient func(region string) api
+	httpClient     httpClient
 }
 
 // New returns a ECR configured against the input session.
 func New(s *session.Session) ECR {
 	return ECR{
 		client: ecr.New(s),
+		regionalClient: func(region string) api {
+			return ecr.New(s, aws.NewConfig().WithRegion(region))
+		},
+		httpClient: http.DefaultClient,
 	}
 }
 
@@ -175,6 +205,251 @@ func (c ECR) ClearRepository(repoName string) error {
 	return err
 }
 
+// CopyImage copies the image referenced by srcImageURI, a repository URI followed by either a tag or a digest,
+// into the repository repoName of this registry and applies the tags to the copied image.
+// The source repository can belong to any region or account that the credentials have access to.
+// Layers and the image manifest are copied through the ECR APIs so that the digest of the image is preserved.
+// CopyImage returns the digest of the copied image.
+func (c ECR) CopyImage(srcImageURI, repoName string, tags ...string) (string, error) {
+	src, err := ParseImageURI(srcImageURI)
+	if err != nil {
+		return "", err
+	}
+	srcClient := c.regionalClient(src.Region)
+	digest := src.Digest
+	if digest == "" {
+		if digest, err = imageDigest(srcClient, src.AccountID, src.RepoName, src.Tag); err != nil {
+			return "", err
+		}
+	}
+	manifest, mediaType, err := imageManifest(srcClient, src.AccountID, src.RepoName, digest)
+	if err != nil {
+		return "", err
+	}
+	layers, err := layerDigests(manifest)
+	if err != nil {
+		return "", fmt.Errorf("parse manifest of image %s: %w", srcImageURI, err)
+	}
+	missing, err := c.missingLayers(repoName, layers)
+	if err != nil {
+		return "", err
+	}
+	for _, layer := range missing {
+		if err := c.copyLayer(srcClient, src, repoName, layer); err != nil {
+			return "", err
+		}
+	}
+	if len(tags) == 0 {
+		tags = []string{""}
+	}
+	for _, tag := range tags {
+		in := &ecr.PutImageInput{
+			RepositoryName:         aws.String(repoName),
+			ImageManifest:          aws.String(manifest),
+			ImageManifestMediaType: aws.String(mediaType),
+			ImageDigest:            aws.String(digest),
+		}
+		if tag != "" {
+			in.ImageTag = aws.String(tag)
+		}
+		if _, err := c.client.PutImage(in); err != nil && !isAWSErrCode(err, ecr.ErrCodeImageAlreadyExistsException) {
+			return "", fmt.Errorf("ecr repo %s put image %s: %w", repoName, digest, err)
+		}
+	}
+	return digest, nil
+}
+
+func (c ECR) missingLayers(repoName string, digests []string) ([]string, error) {
+	resp, err := c.client.BatchCheckLayerAvailability(&ecr.BatchCheckLayerAvailabilityInput{
+		RepositoryName: aws.String(repoName),
+		LayerDigests:   aws.StringSlice(digests),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ecr repo %s check layer availability: %w", repoName, err)
+	}
+	available := make(map[string]bool)
+	for _, layer := range resp.Layers {
+		if aws.StringValue(layer.LayerAvailability) == ecr.LayerAvailabilityAvailable {
+			available[aws.StringValue(layer.LayerDigest)] = true
+		}
+	}
+	var missing []string
+	for _, digest := range digests {
+		if !available[digest] {
+			missing = append(missing, digest)
+		}
+	}
+	return missing, nil
+}
+
+func (c ECR) copyLayer(srcClient api, src ImageURI, repoName, digest string) error {
+	download, err := srcClient.GetDownloadUrlForLayer(&ecr.GetDownloadUrlForLayerInput{
+		RegistryId:     registryID(src.AccountID),
+		RepositoryName: aws.String(src.RepoName),
+		LayerDigest:    aws.String(digest),
+	})
+	if err != nil {
+		return fmt.Errorf("ecr repo %s get download url for layer %s: %w", src.RepoName, digest, err)
+	}
+	resp, err := c.httpClient.Get(aws.StringValue(download.DownloadUrl))
+	if err != nil {
+		return fmt.Errorf("download layer %s: %w", digest, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download layer %s: unexpected status %s", digest, resp.Status)
+	}
+
+	upload, err := c.client.InitiateLayerUpload(&ecr.InitiateLayerUploadInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		return fmt.Errorf("ecr repo %s initiate layer upload: %w", repoName, err)
+	}
+	buf := make([]byte, layerUploadPartSize)
+	var firstByte int64
+	for {
+		n, err := io.ReadFull(resp.Body, buf)
+		if n > 0 {
+			if _, err := c.client.UploadLayerPart(&ecr.UploadLayerPartInput{
+				RepositoryName: aws.String(repoName),
+				UploadId:       upload.UploadId,
+				PartFirstByte:  aws.Int64(firstByte),
+				PartLastByte:   aws.Int64(firstByte + int64(n) - 1),
+				LayerPartBlob:  buf[:n],
+			}); err != nil {
+				return fmt.Errorf("ecr repo %s upload part of layer %s: %w", repoName, digest, err)
+			}
+			firstByte += int64(n)
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read layer %s: %w", digest, err)
+		}
+	}
+	if _, err := c.client.CompleteLayerUpload(&ecr.CompleteLayerUploadInput{
+		RepositoryName: aws.String(repoName),
+		UploadId:       upload.UploadId,
+		LayerDigests:   aws.StringSlice([]string{digest}),
+	}); err != nil && !isAWSErrCode(err, ecr.ErrCodeLayerAlreadyExistsException) {
+		return fmt.Errorf("ecr repo %s complete upload of layer %s: %w", repoName, digest, err)
+	}
+	return nil
+}
+
+func imageDigest(client api, accountID, repoName, tag string) (string, error) {
+	resp, err := client.DescribeImages(&ecr.DescribeImagesInput{
+		RegistryId:     registryID(accountID),
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("ecr repo %s describe image with tag %s: %w", repoName, tag, err)
+	}
+	if len(resp.ImageDetails) == 0 {
+		return "", fmt.Errorf("image with tag %s not found in ecr repo %s", tag, repoName)
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
+func imageManifest(client api, accountID, repoName, digest string) (manifest, mediaType string, err error) {
+	resp, err := client.BatchGetImage(&ecr.BatchGetImageInput{
+		RegistryId:         registryID(accountID),
+		RepositoryName:     aws.String(repoName),
+		AcceptedMediaTypes: aws.StringSlice([]string{mediaTypeDockerManifest, mediaTypeOCIManifest}),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("ecr repo %s get image %s: %w", repoName, digest, err)
+	}
+	if len(resp.Images) == 0 {
+		return "", "", fmt.Errorf("image %s not found in ecr repo %s", digest, repoName)
+	}
+	img := resp.Images[0]
+	return aws.StringValue(img.ImageManifest), aws.StringValue(img.ImageManifestMediaType), nil
+}
+
+// layerDigests returns the digests of the config and layer blobs referenced by a single-platform image manifest.
+func layerDigests(manifest string) ([]string, error) {
+	type descriptor struct {
+		Digest string `json:"digest"`
+	}
+	var m struct {
+		Config    *descriptor  `json:"config"`
+		Layers    []descriptor `json:"layers"`
+		Manifests []descriptor `json:"manifests"`
+	}
+	if err := json.Unmarshal([]byte(manifest), &m); err != nil {
+		return nil, err
+	}
+	if len(m.Manifests) != 0 {
+		return nil, errors.New("copying multi-platform images is not supported")
+	}
+	if m.Config == nil {
+		return nil, errors.New("manifest does not reference an image config")
+	}
+	digests := []string{m.Config.Digest}
+	for _, layer := range m.Layers {
+		digests = append(digests, layer.Digest)
+	}
+	return digests, nil
+}
+
+func registryID(accountID string) *string {
+	if accountID == "" {
+		return nil
+	}
+	return aws.String(accountID)
+}
+
+// ImageURI holds the components of an ECR image URI such as
+// 123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1.
+type ImageURI struct {
+	AccountID string
+	Region    string
+	RepoName  string
+	Tag       string // Tag is empty if the image is referred to by digest.
+	Digest    string // Digest is empty if the image is referred to by tag.
+}
+
+// ParseImageURI parses an ECR image URI that refers to the image either by tag or by digest.
+// If neither is present, the image is referred to by the "latest" tag.
+func ParseImageURI(uri string) (ImageURI, error) {
+	parts := strings.SplitN(uri, "/", 2)
+	if len(parts) != 2 {
+		return ImageURI{}, fmt.Errorf("parse image uri %s: missing repository name", uri)
+	}
+	// The registry is of the form <account>.dkr.ecr.<region>.amazonaws.com[.cn].
+	registry := strings.Split(parts[0], ".")
+	if len(registry) < 5 || registry[1] != "dkr" || registry[2] != "ecr" {
+		return ImageURI{}, fmt.Errorf("parse image uri %s: %s is not an ECR registry", uri, parts[0])
+	}
+	img := ImageURI{
+		AccountID: registry[0],
+		Region:    registry[3],
+		RepoName:  parts[1],
+	}
+	if i := strings.Index(img.RepoName, "@"); i != -1 {
+		img.RepoName, img.Digest = img.RepoName[:i], img.RepoName[i+1:]
+		return img, nil
+	}
+	img.Tag = "latest"
+	if i := strings.LastIndex(img.RepoName, ":"); i != -1 {
+		img.RepoName, img.Tag = img.RepoName[:i], img.RepoName[i+1:]
+	}
+	return img, nil
+}
+
 // URIFromARN converts an ECR Repo ARN to a Repository URI
 func URIFromARN(repositoryARN string) (string, error) {
 	repoARN, err := arn.Parse(repositoryARN)
@@ -195,12 +470,13 @@ func URIFromARN(repositoryARN string) (string, error) {
 }
 
 func isRepoNotFoundErr(err error) bool {
-	aerr, ok := err.(awserr.Error)
-	if !ok {
+	return isAWSErrCode(err, "RepositoryNotFoundException")
+}
+
+func isAWSErrCode(err error, code string) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
 		return false
 	}
-	if aerr.Code() == "RepositoryNotFoundException" {
-		return true
-	}
-	return false
+	return aerr.Code() == code
 }
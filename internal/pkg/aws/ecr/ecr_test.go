@@ -7,6 +7,8 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -63,7 +65,7 @@ func TestAuth(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotUsername, gotPassword, gotErr := client.Auth()
@@ -130,7 +132,7 @@ func TestRepositoryURI(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotURI, gotErr := client.RepositoryURI(mockRepoName)
@@ -253,7 +255,7 @@ func TestListImages(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotImages, gotError := client.ListImages(mockRepoName)
@@ -362,7 +364,7 @@ func TestDeleteImages(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			got := client.DeleteImages(tc.images, mockRepoName)
@@ -441,7 +443,7 @@ func TestClearRepository(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotError := client.ClearRepository(mockRepoName)
@@ -450,3 +452,237 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestParseImageURI(t *testing.T) {
+	testCases := map[string]struct {
+		inURI string
+
+		wantedImage ImageURI
+		wantedError error
+	}{
+		"image referred to by tag": {
+			inURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1",
+			wantedImage: ImageURI{
+				AccountID: "123456789012",
+				Region:    "us-west-2",
+				RepoName:  "phonetool/frontend",
+				Tag:       "v1",
+			},
+		},
+		"image referred to by digest": {
+			inURI: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/phonetool/frontend@sha256:abc",
+			wantedImage: ImageURI{
+				AccountID: "123456789012",
+				Region:    "cn-north-1",
+				RepoName:  "phonetool/frontend",
+				Digest:    "sha256:abc",
+			},
+		},
+		"image without a reference defaults to latest": {
+			inURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+			wantedImage: ImageURI{
+				AccountID: "123456789012",
+				Region:    "us-west-2",
+				RepoName:  "phonetool/frontend",
+				Tag:       "latest",
+			},
+		},
+		"error if the image is not in ECR": {
+			inURI:       "public.docker.io/nginx:latest",
+			wantedError: errors.New("parse image uri public.docker.io/nginx:latest: public.docker.io is not an ECR registry"),
+		},
+		"error if there is no repository": {
+			inURI:       "nginx",
+			wantedError: errors.New("parse image uri nginx: missing repository name"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			img, err := ParseImageURI(tc.inURI)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedImage, img)
+			}
+		})
+	}
+}
+
+func TestCopyImage(t *testing.T) {
+	const (
+		mockSrcImage = "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool/frontend:v1"
+		mockRepoName = "phonetool/frontend"
+		mockDigest   = "sha256:abc"
+		mockManifest = `{"config":{"digest":"sha256:config"},"layers":[{"digest":"sha256:layer1"},{"digest":"sha256:layer2"}]}`
+	)
+	mockError := errors.New("some error")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("layer2 content"))
+	}))
+	defer server.Close()
+
+	mockGetManifest := func(m *mocks.Mockapi, manifest string) {
+		m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+			RegistryId:     aws.String("123456789012"),
+			RepositoryName: aws.String(mockRepoName),
+			ImageIds:       []*ecr.ImageIdentifier{{ImageTag: aws.String("v1")}},
+		}).Return(&ecr.DescribeImagesOutput{
+			ImageDetails: []*ecr.ImageDetail{{ImageDigest: aws.String(mockDigest)}},
+		}, nil)
+		m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+			Images: []*ecr.Image{
+				{
+					ImageManifest:          aws.String(manifest),
+					ImageManifestMediaType: aws.String(mediaTypeDockerManifest),
+				},
+			},
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inTags        []string
+		mockSrcClient func(m *mocks.Mockapi)
+		mockDstClient func(m *mocks.Mockapi)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"error if the source image cannot be found": {
+			mockSrcClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			mockDstClient: func(m *mocks.Mockapi) {},
+			wantedError:   errors.New("image with tag v1 not found in ecr repo phonetool/frontend"),
+		},
+		"error if the source image is a multi-platform image": {
+			mockSrcClient: func(m *mocks.Mockapi) {
+				mockGetManifest(m, `{"manifests":[{"digest":"sha256:amd64"}]}`)
+			},
+			mockDstClient: func(m *mocks.Mockapi) {},
+			wantedError:   fmt.Errorf("parse manifest of image %s: copying multi-platform images is not supported", mockSrcImage),
+		},
+		"error if fail to check layer availability": {
+			mockSrcClient: func(m *mocks.Mockapi) {
+				mockGetManifest(m, mockManifest)
+			},
+			mockDstClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: errors.New("ecr repo phonetool/frontend check layer availability: some error"),
+		},
+		"error if fail to put image": {
+			mockSrcClient: func(m *mocks.Mockapi) {
+				mockGetManifest(m, mockManifest)
+			},
+			mockDstClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(&ecr.BatchCheckLayerAvailabilityOutput{
+					Layers: []*ecr.Layer{
+						{LayerDigest: aws.String("sha256:config"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+						{LayerDigest: aws.String("sha256:layer1"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+						{LayerDigest: aws.String("sha256:layer2"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+					},
+				}, nil)
+				m.EXPECT().PutImage(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: errors.New("ecr repo phonetool/frontend put image sha256:abc: some error"),
+		},
+		"copies missing layers and tags the image": {
+			inTags: []string{"v1"},
+			mockSrcClient: func(m *mocks.Mockapi) {
+				mockGetManifest(m, mockManifest)
+				m.EXPECT().GetDownloadUrlForLayer(&ecr.GetDownloadUrlForLayerInput{
+					RegistryId:     aws.String("123456789012"),
+					RepositoryName: aws.String(mockRepoName),
+					LayerDigest:    aws.String("sha256:layer2"),
+				}).Return(&ecr.GetDownloadUrlForLayerOutput{
+					DownloadUrl: aws.String(server.URL),
+				}, nil)
+			},
+			mockDstClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchCheckLayerAvailability(&ecr.BatchCheckLayerAvailabilityInput{
+					RepositoryName: aws.String(mockRepoName),
+					LayerDigests:   aws.StringSlice([]string{"sha256:config", "sha256:layer1", "sha256:layer2"}),
+				}).Return(&ecr.BatchCheckLayerAvailabilityOutput{
+					Layers: []*ecr.Layer{
+						{LayerDigest: aws.String("sha256:config"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+						{LayerDigest: aws.String("sha256:layer1"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+						{LayerDigest: aws.String("sha256:layer2"), LayerAvailability: aws.String(ecr.LayerAvailabilityUnavailable)},
+					},
+				}, nil)
+				m.EXPECT().InitiateLayerUpload(gomock.Any()).Return(&ecr.InitiateLayerUploadOutput{
+					UploadId: aws.String("upload-1"),
+				}, nil)
+				m.EXPECT().UploadLayerPart(&ecr.UploadLayerPartInput{
+					RepositoryName: aws.String(mockRepoName),
+					UploadId:       aws.String("upload-1"),
+					PartFirstByte:  aws.Int64(0),
+					PartLastByte:   aws.Int64(13),
+					LayerPartBlob:  []byte("layer2 content"),
+				}).Return(&ecr.UploadLayerPartOutput{}, nil)
+				m.EXPECT().CompleteLayerUpload(&ecr.CompleteLayerUploadInput{
+					RepositoryName: aws.String(mockRepoName),
+					UploadId:       aws.String("upload-1"),
+					LayerDigests:   aws.StringSlice([]string{"sha256:layer2"}),
+				}).Return(&ecr.CompleteLayerUploadOutput{}, nil)
+				m.EXPECT().PutImage(&ecr.PutImageInput{
+					RepositoryName:         aws.String(mockRepoName),
+					ImageManifest:          aws.String(mockManifest),
+					ImageManifestMediaType: aws.String(mediaTypeDockerManifest),
+					ImageDigest:            aws.String(mockDigest),
+					ImageTag:               aws.String("v1"),
+				}).Return(&ecr.PutImageOutput{}, nil)
+			},
+			wantedDigest: mockDigest,
+		},
+		"succeeds if the image already exists": {
+			mockSrcClient: func(m *mocks.Mockapi) {
+				mockGetManifest(m, mockManifest)
+			},
+			mockDstClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(&ecr.BatchCheckLayerAvailabilityOutput{
+					Layers: []*ecr.Layer{
+						{LayerDigest: aws.String("sha256:config"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+						{LayerDigest: aws.String("sha256:layer1"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+						{LayerDigest: aws.String("sha256:layer2"), LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)},
+					},
+				}, nil)
+				m.EXPECT().PutImage(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeImageAlreadyExistsException, "exists", nil))
+			},
+			wantedDigest: mockDigest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSrc := mocks.NewMockapi(ctrl)
+			mockDst := mocks.NewMockapi(ctrl)
+			tc.mockSrcClient(mockSrc)
+			tc.mockDstClient(mockDst)
+			client := ECR{
+				client: mockDst,
+				regionalClient: func(region string) api {
+					require.Equal(t, "us-east-1", region)
+					return mockSrc
+				},
+				httpClient: server.Client(),
+			}
+
+			// WHEN
+			digest, err := client.CopyImage(mockSrcImage, mockRepoName, tc.inTags...)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDigest, digest)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -40,6 +41,7 @@ type api interface {
 
 type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
+	StartSessionWithOutput(ssmSession *ecs.Session, w io.Writer) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -69,6 +71,8 @@ type ExecuteCommandInput struct {
 	Command   string
 	Task      string
 	Container string
+	// Output is where the output of the command is written to. If nil, the command runs in the terminal interactively.
+	Output io.Writer
 }
 
 // New returns a Service configured against the input session.
@@ -399,7 +403,12 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 		return &ErrExecuteCommand{err: err}
 	}
 	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
+	if in.Output != nil {
+		err = e.newSessStarter().StartSessionWithOutput(execCmdresp.Session, in.Output)
+	} else {
+		err = e.newSessStarter().StartSession(execCmdresp.Session)
+	}
+	if err != nil {
 		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
 	}
 	return err
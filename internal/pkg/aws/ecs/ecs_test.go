@@ -4,8 +4,10 @@
 package ecs
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -1164,6 +1166,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
+		output          io.Writer
 		mockAPI         func(m *mocks.Mockapi)
 		mockSessStarter func(m *mocks.MockssmSessionStarter)
 		wantedError     error
@@ -1202,6 +1205,17 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				m.EXPECT().StartSession(mockSess).Return(nil)
 			},
 		},
+		"success with output": {
+			output: &bytes.Buffer{},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithOutput(mockSess, &bytes.Buffer{}).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1226,6 +1240,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				Command:   "mockCommand",
 				Container: "mockContainer",
 				Task:      "mockTask",
+				Output:    tc.output,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
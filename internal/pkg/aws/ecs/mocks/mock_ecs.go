@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	io "io"
 	reflect "reflect"
 
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
@@ -219,3 +220,17 @@ func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSession)
 }
+
+// StartSessionWithOutput mocks base method.
+func (m *MockssmSessionStarter) StartSessionWithOutput(ssmSession *ecs.Session, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSessionWithOutput", ssmSession, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSessionWithOutput indicates an expected call of StartSessionWithOutput.
+func (mr *MockssmSessionStarterMockRecorder) StartSessionWithOutput(ssmSession, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSessionWithOutput", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSessionWithOutput), ssmSession, w)
+}
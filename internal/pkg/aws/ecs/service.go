@@ -35,6 +35,12 @@ type Deployment struct {
 	Status         string    `json:"status"`
 }
 
+// ServiceEvent contains information of an ECS service event.
+type ServiceEvent struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Message   string    `json:"message"`
+}
+
 // ServiceStatus contains the status info of a service.
 type ServiceStatus struct {
 	DesiredCount     int64        `json:"desiredCount"`
@@ -70,6 +76,21 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// RecentEvents returns at most n of the latest events of the service, from newest to oldest.
+func (s *Service) RecentEvents(n int) []ServiceEvent {
+	var events []ServiceEvent
+	for _, event := range s.Events {
+		if len(events) == n {
+			break
+		}
+		events = append(events, ServiceEvent{
+			CreatedAt: aws.TimeValue(event.CreatedAt),
+			Message:   aws.StringValue(event.Message),
+		})
+	}
+	return events
+}
+
 // TargetGroups returns the ARNs of target groups attached to the service.
 func (s *Service) TargetGroups() []string {
 	var targetGroupARNs []string
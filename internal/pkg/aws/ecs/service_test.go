@@ -30,6 +30,40 @@ func TestService_TargetGroups(t *testing.T) {
 	})
 }
 
+func TestService_RecentEvents(t *testing.T) {
+	t.Run("should return at most n events", func(t *testing.T) {
+		mockTime := time.Unix(1494505750, 0)
+		s := Service{
+			Events: []*ecs.ServiceEvent{
+				{
+					CreatedAt: aws.Time(mockTime),
+					Message:   aws.String("(service my-svc) has reached a steady state."),
+				},
+				{
+					CreatedAt: aws.Time(mockTime.Add(-time.Minute)),
+					Message:   aws.String("(service my-svc) registered 1 targets in (target-group my-tg)"),
+				},
+				{
+					CreatedAt: aws.Time(mockTime.Add(-2 * time.Minute)),
+					Message:   aws.String("(service my-svc) has started 1 tasks: (task 8c38184a)."),
+				},
+			},
+		}
+		got := s.RecentEvents(2)
+		expected := []ServiceEvent{
+			{
+				CreatedAt: mockTime,
+				Message:   "(service my-svc) has reached a steady state.",
+			},
+			{
+				CreatedAt: mockTime.Add(-time.Minute),
+				Message:   "(service my-svc) registered 1 targets in (target-group my-tg)",
+			},
+		}
+		require.Equal(t, expected, got)
+	})
+}
+
 func TestService_ServiceStatus(t *testing.T) {
 	t.Run("should include active and primary deployments in status", func(t *testing.T) {
 		inService := Service{
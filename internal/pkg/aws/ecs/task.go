@@ -98,6 +98,30 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 	}, nil
 }
 
+// SSMTarget returns the Session Manager target of a container in the task,
+// formatted as "ecs:<cluster name>_<task ID>_<container runtime ID>".
+func (t *Task) SSMTarget(container string) (string, error) {
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	clusterARN, err := arn.Parse(aws.StringValue(t.ClusterArn))
+	if err != nil {
+		return "", fmt.Errorf("parse ECS cluster ARN: %w", err)
+	}
+	cluster := strings.TrimPrefix(clusterARN.Resource, "cluster/")
+	for _, c := range t.Containers {
+		if aws.StringValue(c.Name) != container {
+			continue
+		}
+		if c.RuntimeId == nil {
+			return "", fmt.Errorf("container %s in task %s does not have a runtime ID", container, taskID)
+		}
+		return fmt.Sprintf("ecs:%s_%s_%s", cluster, taskID, aws.StringValue(c.RuntimeId)), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", container, taskID)
+}
+
 // ENI returns the network interface ID of the running task.
 // Every Fargate task is provided with an ENI by default (https://docs.aws.amazon.com/AmazonECS/latest/userguide/fargate-task-networking.html).
 func (t *Task) ENI() (string, error) {
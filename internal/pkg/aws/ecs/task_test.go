@@ -306,6 +306,69 @@ func TestTask_PrivateIP(t *testing.T) {
 	}
 }
 
+func TestTask_SSMTarget(t *testing.T) {
+	testCases := map[string]struct {
+		taskARN    string
+		clusterARN string
+		containers []*ecs.Container
+
+		wantedTarget string
+		wantedErr    error
+	}{
+		"invalid task ARN": {
+			taskARN: "mockTask",
+
+			wantedErr: errors.New("parse ECS task ARN: arn: invalid prefix"),
+		},
+		"container not found": {
+			taskARN:    "arn:aws:ecs:us-west-2:123456789:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d",
+			clusterARN: "arn:aws:ecs:us-west-2:123456789:cluster/my-cluster",
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("nginx"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2531612879"),
+				},
+			},
+
+			wantedErr: errors.New("container frontend not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"success": {
+			taskARN:    "arn:aws:ecs:us-west-2:123456789:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d",
+			clusterARN: "arn:aws:ecs:us-west-2:123456789:cluster/my-cluster",
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("nginx"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2531612879"),
+				},
+				{
+					Name:      aws.String("frontend"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-3681223315"),
+				},
+			},
+
+			wantedTarget: "ecs:my-cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-3681223315",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				TaskArn:    aws.String(tc.taskARN),
+				ClusterArn: aws.String(tc.clusterARN),
+				Containers: tc.containers,
+			}
+
+			out, err := task.SSMTarget("frontend")
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTarget, out)
+			}
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
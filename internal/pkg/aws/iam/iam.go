@@ -67,8 +67,9 @@ func (c *IAM) DeleteRole(roleNameOrARN string) error {
 	roleName := roleNameOrARN
 	if parsed, err := arn.Parse(roleNameOrARN); err == nil {
 		// The parameter is an ARN instead!
-		// Sample ARN format: arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole
-		roleName = strings.TrimPrefix(parsed.Resource, "role/")
+		// Sample ARN formats: arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole
+		// or, for a role under a custom path, arn:aws:iam::1111:role/copilot/phonetool-test-CFNExecutionRole
+		roleName = parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
 	}
 
 	if err := c.deleteRolePolicies(roleName); err != nil {
@@ -178,6 +178,21 @@ func TestIAM_DeleteRole(t *testing.T) {
 				return m
 			},
 		},
+		"uses the role name without its path if the input is an ARN of a role under a custom path": {
+			inRoleNameOrARN: "arn:aws:iam::1111:role/copilot/phonetool-test-CFNExecutionRole",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().
+					ListRolePolicies(&iam.ListRolePoliciesInput{
+						RoleName: aws.String("phonetool-test-CFNExecutionRole"),
+					}).
+					Return(&iam.ListRolePoliciesOutput{}, nil)
+				m.EXPECT().DeleteRole(&iam.DeleteRoleInput{
+					RoleName: aws.String("phonetool-test-CFNExecutionRole"),
+				}).Return(nil, nil)
+				return m
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -5,7 +5,9 @@
 package secretsmanager
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -18,6 +20,10 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	PutSecretValue(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
+	TagResource(*secretsmanager.TagResourceInput) (*secretsmanager.TagResourceOutput, error)
+	RotateSecret(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error)
+	DescribeSecret(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -69,6 +75,127 @@ func (s *SecretsManager) CreateSecret(secretName, secretString string) (string,
 	return aws.StringValue(resp.ARN), nil
 }
 
+// PutSecretInput contains fields needed to create or update a secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool
+	Tags      map[string]string
+}
+
+// RotationConfig holds the configuration to rotate a secret with a Lambda function.
+type RotationConfig struct {
+	LambdaARN string
+	AfterDays int64
+}
+
+// PutSecretOutput holds the ARN of the secret that was created or updated.
+type PutSecretOutput struct {
+	ARN         string
+	Overwritten bool // True if the value of an existing secret was overwritten.
+}
+
+// PutSecret tries to create the secret, and overwrites its value if the secret exists and that `Overwrite` is true.
+// ErrSecretAlreadyExists is returned if the secret exists and `Overwrite` is false.
+func (s *SecretsManager) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	out, err := s.createSecretWithTags(in)
+	if err == nil {
+		return out, nil
+	}
+	var errSecretExists *ErrSecretAlreadyExists
+	if !errors.As(err, &errSecretExists) || !in.Overwrite {
+		return nil, err
+	}
+	return s.overwriteSecret(in)
+}
+
+// ConfigureRotation rotates the secret with the Lambda function every `AfterDays` days.
+// The caller needs permission to invoke the rotation function in addition to "secretsmanager:RotateSecret".
+func (s *SecretsManager) ConfigureRotation(secretName string, conf RotationConfig) error {
+	if _, err := s.secretsManager.RotateSecret(&secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(secretName),
+		RotationLambdaARN: aws.String(conf.LambdaARN),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(conf.AfterDays),
+		},
+	}); err != nil {
+		return fmt.Errorf("configure rotation for secret %s: %w", secretName, err)
+	}
+	return nil
+}
+
+func (s *SecretsManager) createSecretWithTags(in PutSecretInput) (*PutSecretOutput, error) {
+	resp, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+		Tags:         convertTags(in.Tags),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceExistsException {
+			return nil, &ErrSecretAlreadyExists{
+				secretName: in.Name,
+				parentErr:  err,
+			}
+		}
+		return nil, fmt.Errorf("create secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN: aws.StringValue(resp.ARN),
+	}, nil
+}
+
+func (s *SecretsManager) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	resp, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update secret %s: %w", in.Name, err)
+	}
+	if _, err := s.secretsManager.TagResource(&secretsmanager.TagResourceInput{
+		SecretId: aws.String(in.Name),
+		Tags:     convertTags(in.Tags),
+	}); err != nil {
+		return nil, fmt.Errorf("add tags to secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN:         aws.StringValue(resp.ARN),
+		Overwritten: true,
+	}, nil
+}
+
+// SecretMetadata holds the metadata of a secret without its value.
+type SecretMetadata struct {
+	Name            string
+	ARN             string
+	LastChangedDate time.Time
+	Rotation        *RotationConfig // Nil if rotation is not enabled.
+}
+
+// DescribeSecret returns the metadata of a secret.
+func (s *SecretsManager) DescribeSecret(secretName string) (*SecretMetadata, error) {
+	resp, err := s.secretsManager.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe secret %s: %w", secretName, err)
+	}
+	metadata := &SecretMetadata{
+		Name:            aws.StringValue(resp.Name),
+		ARN:             aws.StringValue(resp.ARN),
+		LastChangedDate: aws.TimeValue(resp.LastChangedDate),
+	}
+	if aws.BoolValue(resp.RotationEnabled) {
+		metadata.Rotation = &RotationConfig{
+			LambdaARN: aws.StringValue(resp.RotationLambdaARN),
+		}
+		if resp.RotationRules != nil {
+			metadata.Rotation.AfterDays = aws.Int64Value(resp.RotationRules.AutomaticallyAfterDays)
+		}
+	}
+	return metadata, nil
+}
+
 // DeleteSecret force removes the secret from SecretsManager.
 func (s *SecretsManager) DeleteSecret(secretName string) error {
 	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
@@ -82,6 +209,23 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	return nil
 }
 
+func convertTags(inTags map[string]string) []*secretsmanager.Tag {
+	keys := make([]string, 0, len(inTags))
+	for key := range inTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var tags []*secretsmanager.Tag
+	for _, key := range keys {
+		tags = append(tags, &secretsmanager.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(inTags[key]),
+		})
+	}
+	return tags
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -105,3 +106,256 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_PutSecret(t *testing.T) {
+	const (
+		mockName  = "copilot/my-app/test/secrets/db"
+		mockValue = `{"username":"admin","password":"hunter2"}`
+		mockARN   = "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/my-app/test/secrets/db-AbCdEf"
+	)
+	mockTags := map[string]string{
+		"copilot-environment": "test",
+		"copilot-application": "my-app",
+	}
+	mockSDKTags := []*secretsmanager.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("my-app"),
+		},
+		{
+			Key:   aws.String("copilot-environment"),
+			Value: aws.String("test"),
+		},
+	}
+	mockCreateSecretInput := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(mockName),
+		SecretString: aws.String(mockValue),
+		Tags:         mockSDKTags,
+	}
+	mockErrExists := awserr.New(secretsmanager.ErrCodeResourceExistsException, "", nil)
+	mockErr := errors.New("some error")
+
+	testCases := map[string]struct {
+		inOverwrite bool
+		setupMocks  func(m *mocks.Mockapi)
+
+		wantedOut   *PutSecretOutput
+		wantedError error
+	}{
+		"create a secret with tags": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(&secretsmanager.CreateSecretOutput{
+					ARN: aws.String(mockARN),
+				}, nil)
+			},
+			wantedOut: &PutSecretOutput{
+				ARN: mockARN,
+			},
+		},
+		"wrap error if fail to create the secret": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockErr)
+			},
+			wantedError: errors.New("create secret copilot/my-app/test/secrets/db: some error"),
+		},
+		"return ErrSecretAlreadyExists if the secret exists and overwrite is false": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockErrExists)
+			},
+			wantedError: errors.New("secret copilot/my-app/test/secrets/db already exists"),
+		},
+		"overwrite the value and the tags of an existing secret": {
+			inOverwrite: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockErrExists),
+					m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+						SecretId:     aws.String(mockName),
+						SecretString: aws.String(mockValue),
+					}).Return(&secretsmanager.PutSecretValueOutput{
+						ARN: aws.String(mockARN),
+					}, nil),
+					m.EXPECT().TagResource(&secretsmanager.TagResourceInput{
+						SecretId: aws.String(mockName),
+						Tags:     mockSDKTags,
+					}).Return(&secretsmanager.TagResourceOutput{}, nil),
+				)
+			},
+			wantedOut: &PutSecretOutput{
+				ARN:         mockARN,
+				Overwritten: true,
+			},
+		},
+		"wrap error if fail to overwrite the secret": {
+			inOverwrite: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockErrExists)
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedError: errors.New("update secret copilot/my-app/test/secrets/db: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			out, err := sm.PutSecret(PutSecretInput{
+				Name:      mockName,
+				Value:     mockValue,
+				Overwrite: tc.inOverwrite,
+				Tags:      mockTags,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, out)
+			}
+		})
+	}
+}
+
+func TestSecretsManager_ConfigureRotation(t *testing.T) {
+	const mockName = "copilot/my-app/test/secrets/db"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"wrap error if fail to configure rotation": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().RotateSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("configure rotation for secret copilot/my-app/test/secrets/db: some error"),
+		},
+		"rotate the secret with the lambda function": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().RotateSecret(&secretsmanager.RotateSecretInput{
+					SecretId:          aws.String(mockName),
+					RotationLambdaARN: aws.String("arn:aws:lambda:us-west-2:123456789012:function:rotate"),
+					RotationRules: &secretsmanager.RotationRulesType{
+						AutomaticallyAfterDays: aws.Int64(30),
+					},
+				}).Return(&secretsmanager.RotateSecretOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			err := sm.ConfigureRotation(mockName, RotationConfig{
+				LambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+				AfterDays: 30,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretsManager_DescribeSecret(t *testing.T) {
+	mockTime := time.Date(2022, 3, 14, 15, 9, 26, 0, time.UTC)
+	testCases := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedMetadata *SecretMetadata
+		wantedError    error
+	}{
+		"return error if fail to describe the secret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe secret copilot/app/env/secrets/db-password: some error"),
+		},
+		"return the metadata of a secret without rotation": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(&secretsmanager.DescribeSecretInput{
+					SecretId: aws.String("copilot/app/env/secrets/db-password"),
+				}).Return(&secretsmanager.DescribeSecretOutput{
+					Name:            aws.String("copilot/app/env/secrets/db-password"),
+					ARN:             aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/app/env/secrets/db-password-abcdef"),
+					LastChangedDate: aws.Time(mockTime),
+				}, nil)
+			},
+			wantedMetadata: &SecretMetadata{
+				Name:            "copilot/app/env/secrets/db-password",
+				ARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/app/env/secrets/db-password-abcdef",
+				LastChangedDate: mockTime,
+			},
+		},
+		"return the metadata of a rotated secret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(&secretsmanager.DescribeSecretOutput{
+					Name:              aws.String("copilot/app/env/secrets/db-password"),
+					ARN:               aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/app/env/secrets/db-password-abcdef"),
+					LastChangedDate:   aws.Time(mockTime),
+					RotationEnabled:   aws.Bool(true),
+					RotationLambdaARN: aws.String("arn:aws:lambda:us-west-2:123456789012:function:rotate"),
+					RotationRules: &secretsmanager.RotationRulesType{
+						AutomaticallyAfterDays: aws.Int64(7),
+					},
+				}, nil)
+			},
+			wantedMetadata: &SecretMetadata{
+				Name:            "copilot/app/env/secrets/db-password",
+				ARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/app/env/secrets/db-password-abcdef",
+				LastChangedDate: mockTime,
+				Rotation: &RotationConfig{
+					LambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+					AfterDays: 7,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.callMock(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			got, err := sm.DescribeSecret("copilot/app/env/secrets/db-password")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedMetadata, got)
+			}
+		})
+	}
+}
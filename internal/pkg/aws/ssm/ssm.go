@@ -8,29 +8,81 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocumentName             = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteHostDocumentName = "AWS-StartPortForwardingSessionToRemoteHost"
 )
 
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
+type portForwardingSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, request *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() portForwardingSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() portForwardingSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
+	}
+}
+
+// PortForwardingSessionInput holds the fields needed to forward a local port through a Session Manager target.
+type PortForwardingSessionInput struct {
+	Target     string
+	LocalPort  int
+	RemotePort int
+	RemoteHost string // If empty, the port is forwarded to the target itself.
+}
+
+// StartPortForwardingSession forwards the local port to the remote port on the target, or on a remote host
+// reachable from the target, until the session is terminated.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) error {
+	request := &ssm.StartSessionInput{
+		DocumentName: aws.String(portForwardingDocumentName),
+		Parameters: map[string][]*string{
+			"portNumber":      aws.StringSlice([]string{strconv.Itoa(in.RemotePort)}),
+			"localPortNumber": aws.StringSlice([]string{strconv.Itoa(in.LocalPort)}),
+		},
+		Target: aws.String(in.Target),
+	}
+	if in.RemoteHost != "" {
+		request.DocumentName = aws.String(portForwardingToRemoteHostDocumentName)
+		request.Parameters["host"] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	resp, err := s.client.StartSession(request)
+	if err != nil {
+		return fmt.Errorf("start session with target %s: %w", in.Target, err)
 	}
+	if err := s.newSessStarter().StartPortForwardingSession(resp, request); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", aws.StringValue(resp.SessionId), err)
+	}
+	return nil
 }
 
 // PutSecretInput contains fields needed to create or update a secret.
@@ -115,6 +167,57 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// SecretMetadata holds the metadata of a secret without its value.
+type SecretMetadata struct {
+	Name             string
+	ARN              string
+	Version          int64
+	LastModifiedDate time.Time
+}
+
+// DescribeSecret returns the metadata of a secret. The value of the secret is not decrypted.
+func (s *SSM) DescribeSecret(name string) (*SecretMetadata, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return &SecretMetadata{
+		Name:             aws.StringValue(out.Parameter.Name),
+		ARN:              aws.StringValue(out.Parameter.ARN),
+		Version:          aws.Int64Value(out.Parameter.Version),
+		LastModifiedDate: aws.TimeValue(out.Parameter.LastModifiedDate),
+	}, nil
+}
+
+// GetParameter returns the decrypted value of a parameter.
+func (s *SSM) GetParameter(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// DeleteSecret deletes a secret. It is a no-op if the secret does not exist.
+func (s *SSM) DeleteSecret(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ssm.ErrCodeParameterNotFound {
+			return nil
+		}
+		return fmt.Errorf("delete parameter %s: %w", name, err)
+	}
+	return nil
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
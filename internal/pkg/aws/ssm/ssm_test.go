@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,285 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_GetParameter(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"return error if fail to get the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameter /org/copilot/policies: some error"),
+		},
+		"return the decrypted value of the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/org/copilot/policies"),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String("/org/copilot/policies"),
+						Value: aws.String("rules: []"),
+					},
+				}, nil)
+			},
+			wantedValue: "rules: []",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			client := SSM{
+				client: mockSSMClient,
+			}
+
+			// WHEN
+			got, err := client.GetParameter("/org/copilot/policies")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DescribeSecret(t *testing.T) {
+	mockTime := time.Date(2022, 3, 14, 15, 9, 26, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedMetadata *SecretMetadata
+		wantedError    error
+	}{
+		"return error if fail to get the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameter /copilot/app/env/secrets/db-password: some error"),
+		},
+		"return the metadata of the parameter without decrypting it": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/copilot/app/env/secrets/db-password"),
+					WithDecryption: aws.Bool(false),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:             aws.String("/copilot/app/env/secrets/db-password"),
+						ARN:              aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/copilot/app/env/secrets/db-password"),
+						Version:          aws.Int64(3),
+						LastModifiedDate: aws.Time(mockTime),
+					},
+				}, nil)
+			},
+			wantedMetadata: &SecretMetadata{
+				Name:             "/copilot/app/env/secrets/db-password",
+				ARN:              "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/app/env/secrets/db-password",
+				Version:          3,
+				LastModifiedDate: mockTime,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			client := SSM{
+				client: mockSSMClient,
+			}
+
+			// WHEN
+			got, err := client.DescribeSecret("/copilot/app/env/secrets/db-password")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedMetadata, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"return error if fail to delete the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("delete parameter /copilot/app/env/secrets/db-password: some error"),
+		},
+		"no-op if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String("/copilot/app/env/secrets/db-password"),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			client := SSM{
+				client: mockSSMClient,
+			}
+
+			// WHEN
+			err := client.DeleteSecret("/copilot/app/env/secrets/db-password")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	mockResp := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessionID"),
+	}
+	testCases := map[string]struct {
+		in              PortForwardingSessionInput
+		mockClient      func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockportForwardingSessionStarter)
+
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			in: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  8080,
+				RemotePort: 80,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {},
+
+			wantedError: errors.New("start session with target ecs:cluster_task_runtime: some error"),
+		},
+		"return error if fail to start the plugin": {
+			in: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  8080,
+				RemotePort: 80,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(mockResp, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockResp, gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("start session mockSessionID using ssm plugin: some error"),
+		},
+		"forwards to the target": {
+			in: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  8080,
+				RemotePort: 80,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSession"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"80"}),
+						"localPortNumber": aws.StringSlice([]string{"8080"}),
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(mockResp, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockResp, gomock.Any()).Return(nil)
+			},
+		},
+		"forwards to a remote host": {
+			in: PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  5432,
+				RemotePort: 5432,
+				RemoteHost: "db.cluster-abc.us-west-2.rds.amazonaws.com",
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"5432"}),
+						"localPortNumber": aws.StringSlice([]string{"5432"}),
+						"host":            aws.StringSlice([]string{"db.cluster-abc.us-west-2.rds.amazonaws.com"}),
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(mockResp, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockResp, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockportForwardingSessionStarter(ctrl)
+			tc.mockClient(mockSSMClient)
+			tc.mockSessStarter(mockSessStarter)
+			client := SSM{
+				client: mockSSMClient,
+				newSessStarter: func() portForwardingSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			// WHEN
+			err := client.StartPortForwardingSession(tc.in)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
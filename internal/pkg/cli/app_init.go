@@ -6,6 +6,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -15,9 +17,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/policy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -37,9 +42,13 @@ const (
 )
 
 type initAppVars struct {
-	name         string
-	domainName   string
-	resourceTags map[string]string
+	name                string
+	domainName          string
+	permissionsBoundary string
+	rolePath            string
+	templateOverrides   string
+	policiesParameter   string
+	resourceTags        map[string]string
 }
 
 type initAppOpts struct {
@@ -49,6 +58,7 @@ type initAppOpts struct {
 	store                applicationStore
 	route53              domainHostedZoneGetter
 	domainInfoGetter     domainInfoGetter
+	parameters           parameterGetter
 	ws                   wsAppManager
 	cfn                  appDeployer
 	prompt               prompter
@@ -75,6 +85,7 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 		store:            config.NewSSMStore(identity, ssm.New(sess), aws.StringValue(sess.Config.Region)),
 		route53:          route53.New(sess),
 		domainInfoGetter: route53.NewRoute53Domains(sess),
+		parameters:       awsssm.New(sess),
 		ws:               ws,
 		cfn:              cloudformation.New(sess),
 		prompt:           prompt.New(),
@@ -105,6 +116,30 @@ func (o *initAppOpts) Validate() error {
 		}
 		o.cachedHostedZoneID = id
 	}
+	if o.permissionsBoundary != "" {
+		if err := validatePermissionsBoundary(o.permissionsBoundary); err != nil {
+			return fmt.Errorf("permissions boundary %s is invalid: %w", o.permissionsBoundary, err)
+		}
+	}
+	if o.rolePath != "" {
+		if err := validateRolePath(o.rolePath); err != nil {
+			return fmt.Errorf("role path %s is invalid: %w", o.rolePath, err)
+		}
+	}
+	if o.templateOverrides != "" {
+		dir, err := o.templateOverridesDir()
+		if err != nil {
+			return err
+		}
+		if _, err := template.New().WithPartialOverrides(os.DirFS(dir)); err != nil {
+			return fmt.Errorf("template overrides %s are invalid: %w", o.templateOverrides, err)
+		}
+	}
+	if o.policiesParameter != "" {
+		if err := o.validatePolicies(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -192,12 +227,14 @@ func (o *initAppOpts) Execute() error {
 		}
 	}
 	err = o.cfn.DeployApp(&deploy.CreateAppInput{
-		Name:               o.name,
-		AccountID:          caller.Account,
-		DomainName:         o.domainName,
-		DomainHostedZoneID: hostedZoneID,
-		AdditionalTags:     o.resourceTags,
-		Version:            deploy.LatestAppTemplateVersion,
+		Name:                o.name,
+		AccountID:           caller.Account,
+		DomainName:          o.domainName,
+		DomainHostedZoneID:  hostedZoneID,
+		AdditionalTags:      o.resourceTags,
+		Version:             deploy.LatestAppTemplateVersion,
+		PermissionsBoundary: o.permissionsBoundary,
+		RolePath:            o.rolePath,
 	})
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.name)))
@@ -206,11 +243,15 @@ func (o *initAppOpts) Execute() error {
 	o.prog.Stop(log.Ssuccessf(fmtAppInitComplete, color.HighlightUserInput(o.name)))
 
 	if err := o.store.CreateApplication(&config.Application{
-		AccountID:          caller.Account,
-		Name:               o.name,
-		Domain:             o.domainName,
-		DomainHostedZoneID: hostedZoneID,
-		Tags:               o.resourceTags,
+		AccountID:           caller.Account,
+		Name:                o.name,
+		Domain:              o.domainName,
+		DomainHostedZoneID:  hostedZoneID,
+		Tags:                o.resourceTags,
+		PermissionsBoundary: o.permissionsBoundary,
+		RolePath:            o.rolePath,
+		TemplateOverrides:   o.templateOverrides,
+		PoliciesParameter:   o.policiesParameter,
 	}); err != nil {
 		return err
 	}
@@ -234,6 +275,49 @@ func (o *initAppOpts) validateAppName(name string) error {
 	if o.domainName != "" && app.Domain != o.domainName {
 		return fmt.Errorf("application named %s already exists with a different domain name %s", name, app.Domain)
 	}
+	if o.permissionsBoundary != "" && app.PermissionsBoundary != o.permissionsBoundary {
+		return fmt.Errorf("application named %s already exists with a different permissions boundary %q", name, app.PermissionsBoundary)
+	}
+	if o.rolePath != "" && app.RolePath != o.rolePath {
+		return fmt.Errorf("application named %s already exists with a different role path %q", name, app.RolePath)
+	}
+	if o.templateOverrides != "" && app.TemplateOverrides != o.templateOverrides {
+		return fmt.Errorf("application named %s already exists with a different template overrides directory %q", name, app.TemplateOverrides)
+	}
+	if o.policiesParameter != "" && app.PoliciesParameter != o.policiesParameter {
+		return fmt.Errorf("application named %s already exists with a different policies parameter %q", name, app.PoliciesParameter)
+	}
+	return nil
+}
+
+// templateOverridesDir returns the path of the template overrides directory.
+// Like at deploy time, a relative directory is resolved against the workspace root.
+func (o *initAppOpts) templateOverridesDir() (string, error) {
+	if filepath.IsAbs(o.templateOverrides) {
+		return o.templateOverrides, nil
+	}
+	root, err := o.ws.Path()
+	if err != nil {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return "", fmt.Errorf("get workspace path: %w", err)
+		}
+		// The workspace will be created in the current directory.
+		if root, err = os.Getwd(); err != nil {
+			return "", fmt.Errorf("get working directory: %w", err)
+		}
+	}
+	return filepath.Join(root, o.templateOverrides), nil
+}
+
+func (o *initAppOpts) validatePolicies() error {
+	rules, err := o.parameters.GetParameter(o.policiesParameter)
+	if err != nil {
+		return fmt.Errorf("read policies: %w", err)
+	}
+	if _, err := policy.Parse([]byte(rules)); err != nil {
+		return fmt.Errorf("policies in parameter %s are invalid: %w", o.policiesParameter, err)
+	}
 	return nil
 }
 
@@ -319,7 +403,15 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose IAM roles are bounded by an existing policy.
+  /code $ copilot app init --permissions-boundary MyBoundary
+  Create a new application whose IAM roles are created under a custom path.
+  /code $ copilot app init --permissions-boundary MyBoundary --role-path /copilot/
+  Create a new application whose services and jobs render the CloudFormation partials under "templates/".
+  /code $ copilot app init --template-overrides templates
+  Create a new application whose services and jobs are checked against the policy rules in an SSM parameter.
+  /code $ copilot app init --policies-parameter /org/copilot/policies`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -334,5 +426,9 @@ An application is a collection of containerized services that operate together.`
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.permissionsBoundary, permissionsBoundaryFlag, "", permissionsBoundaryFlagDescription)
+	cmd.Flags().StringVar(&vars.rolePath, rolePathFlag, "", rolePathFlagDescription)
+	cmd.Flags().StringVar(&vars.templateOverrides, templateOverridesFlag, "", templateOverridesFlagDescription)
+	cmd.Flags().StringVar(&vars.policiesParameter, policiesParameterFlag, "", policiesParameterFlagDescription)
 	return cmd
 }
@@ -6,6 +6,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
@@ -23,12 +26,21 @@ type initAppMocks struct {
 	mockRoute53Svc       *mocks.MockdomainHostedZoneGetter
 	mockStore            *mocks.Mockstore
 	mockDomainInfoGetter *mocks.MockdomainInfoGetter
+	mockParameters       *mocks.MockparameterGetter
+	mockWs               *mocks.MockwsAppManager
 }
 
 func TestInitAppOpts_Validate(t *testing.T) {
+	wsRoot := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(wsRoot, "templates"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(wsRoot, "templates", "partials.yml"), []byte("version: "+template.WorkloadPartialsVersion), 0644))
 	testCases := map[string]struct {
-		inAppName    string
-		inDomainName string
+		inAppName             string
+		inDomainName          string
+		inPermissionsBoundary string
+		inRolePath            string
+		inTemplateOverrides   string
+		inPoliciesParameter   string
 
 		mock func(m *initAppMocks)
 
@@ -63,6 +75,133 @@ func TestInitAppOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("application named metrics already exists with a different domain name domain.com"),
 		},
+		"errors if application with different permissions boundary already exists": {
+			inAppName:             "metrics",
+			inPermissionsBoundary: "MyBoundary",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name: "metrics",
+				}, nil)
+			},
+
+			wantedError: errors.New(`application named metrics already exists with a different permissions boundary ""`),
+		},
+		"invalid permissions boundary": {
+			inPermissionsBoundary: "arn:aws:iam::123456789012:policy/MyBoundary",
+			mock:                  func(m *initAppMocks) {},
+
+			wantedError: fmt.Errorf("permissions boundary arn:aws:iam::123456789012:policy/MyBoundary is invalid: %w", errPolicyNameInvalid),
+		},
+		"valid permissions boundary": {
+			inPermissionsBoundary: "boundaries/MyBoundary",
+			mock:                  func(m *initAppMocks) {},
+		},
+		"errors if application with different role path already exists": {
+			inAppName:  "metrics",
+			inRolePath: "/copilot/",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name:     "metrics",
+					RolePath: "/teams/",
+				}, nil)
+			},
+
+			wantedError: errors.New(`application named metrics already exists with a different role path "/teams/"`),
+		},
+		"invalid role path": {
+			inRolePath: "copilot",
+			mock:       func(m *initAppMocks) {},
+
+			wantedError: fmt.Errorf("role path copilot is invalid: %w", errRolePathInvalid),
+		},
+		"valid role path": {
+			inRolePath: "/copilot/",
+			mock:       func(m *initAppMocks) {},
+		},
+		"errors if application with different template overrides already exists": {
+			inAppName:           "metrics",
+			inTemplateOverrides: "templates",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name:              "metrics",
+					TemplateOverrides: "partials",
+				}, nil)
+			},
+
+			wantedError: errors.New(`application named metrics already exists with a different template overrides directory "partials"`),
+		},
+		"errors if the workspace path can't be read": {
+			inTemplateOverrides: "templates",
+			mock: func(m *initAppMocks) {
+				m.mockWs.EXPECT().Path().Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("get workspace path: some error"),
+		},
+		"invalid template overrides": {
+			inTemplateOverrides: "does-not-exist",
+			mock: func(m *initAppMocks) {
+				m.mockWs.EXPECT().Path().Return(wsRoot, nil)
+			},
+
+			wantedError: errors.New("template overrides does-not-exist are invalid: read partials.yml: open partials.yml: no such file or directory"),
+		},
+		"resolves template overrides against the workspace root": {
+			inTemplateOverrides: "templates",
+			mock: func(m *initAppMocks) {
+				m.mockWs.EXPECT().Path().Return(wsRoot, nil)
+			},
+		},
+		"resolves template overrides against the current directory if there is no workspace yet": {
+			inTemplateOverrides: "templates",
+			mock: func(m *initAppMocks) {
+				m.mockWs.EXPECT().Path().Return("", &workspace.ErrWorkspaceNotFound{})
+			},
+
+			wantedError: errors.New("template overrides templates are invalid: read partials.yml: open partials.yml: no such file or directory"),
+		},
+		"valid absolute template overrides": {
+			inTemplateOverrides: filepath.Join(wsRoot, "templates"),
+			mock:                func(m *initAppMocks) {},
+		},
+		"errors if application with different policies parameter already exists": {
+			inAppName:           "metrics",
+			inPoliciesParameter: "/org/copilot/policies",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name: "metrics",
+				}, nil)
+			},
+
+			wantedError: errors.New(`application named metrics already exists with a different policies parameter ""`),
+		},
+		"errors if the policies parameter can't be read": {
+			inPoliciesParameter: "/org/copilot/policies",
+			mock: func(m *initAppMocks) {
+				m.mockParameters.EXPECT().GetParameter("/org/copilot/policies").Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("read policies: some error"),
+		},
+		"errors if the policies in the parameter are invalid": {
+			inPoliciesParameter: "/org/copilot/policies",
+			mock: func(m *initAppMocks) {
+				m.mockParameters.EXPECT().GetParameter("/org/copilot/policies").Return("rules: [{name: max-memory}]", nil)
+			},
+
+			wantedError: errors.New(`policies in parameter /org/copilot/policies are invalid: validate rule 0: "target" of rule max-memory must be one of "manifest" or "template"`),
+		},
+		"valid policies parameter": {
+			inPoliciesParameter: "/org/copilot/policies",
+			mock: func(m *initAppMocks) {
+				m.mockParameters.EXPECT().GetParameter("/org/copilot/policies").Return(`rules:
+  - name: max-memory
+    target: manifest
+    path: memory
+    max: 8192
+`, nil)
+			},
+		},
 		"skip checking if domain name is not set": {
 			inAppName:    "metrics",
 			inDomainName: "",
@@ -133,16 +272,24 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				mockStore:            mocks.NewMockstore(ctrl),
 				mockRoute53Svc:       mocks.NewMockdomainHostedZoneGetter(ctrl),
 				mockDomainInfoGetter: mocks.NewMockdomainInfoGetter(ctrl),
+				mockParameters:       mocks.NewMockparameterGetter(ctrl),
+				mockWs:               mocks.NewMockwsAppManager(ctrl),
 			}
 			tc.mock(m)
 
 			opts := &initAppOpts{
 				route53:          m.mockRoute53Svc,
 				domainInfoGetter: m.mockDomainInfoGetter,
+				parameters:       m.mockParameters,
+				ws:               m.mockWs,
 				store:            m.mockStore,
 				initAppVars: initAppVars{
-					name:       tc.inAppName,
-					domainName: tc.inDomainName,
+					name:                tc.inAppName,
+					domainName:          tc.inDomainName,
+					permissionsBoundary: tc.inPermissionsBoundary,
+					rolePath:            tc.inRolePath,
+					templateOverrides:   tc.inTemplateOverrides,
+					policiesParameter:   tc.inPoliciesParameter,
 				},
 			}
 
@@ -291,8 +438,11 @@ func TestInitAppOpts_Execute(t *testing.T) {
 	mockError := fmt.Errorf("error")
 
 	testCases := map[string]struct {
-		inDomainName         string
-		inDomainHostedZoneID string
+		inDomainName          string
+		inDomainHostedZoneID  string
+		inPermissionsBoundary string
+		inRolePath            string
+		inTemplateOverrides   string
 
 		expectedError error
 		mocking       func(t *testing.T,
@@ -301,8 +451,11 @@ func TestInitAppOpts_Execute(t *testing.T) {
 			mockProgress *mocks.Mockprogress)
 	}{
 		"with a successful call to add app": {
-			inDomainName:         "amazon.com",
-			inDomainHostedZoneID: "mockID",
+			inDomainName:          "amazon.com",
+			inDomainHostedZoneID:  "mockID",
+			inPermissionsBoundary: "MyBoundary",
+			inRolePath:            "/copilot/",
+			inTemplateOverrides:   "templates",
 
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
 				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
@@ -323,6 +476,9 @@ func TestInitAppOpts_Execute(t *testing.T) {
 						Tags: map[string]string{
 							"owner": "boss",
 						},
+						PermissionsBoundary: "MyBoundary",
+						RolePath:            "/copilot/",
+						TemplateOverrides:   "templates",
 					})
 				mockWorkspace.
 					EXPECT().
@@ -337,7 +493,9 @@ func TestInitAppOpts_Execute(t *testing.T) {
 						AdditionalTags: map[string]string{
 							"owner": "boss",
 						},
-						Version: deploy.LatestAppTemplateVersion,
+						Version:             deploy.LatestAppTemplateVersion,
+						PermissionsBoundary: "MyBoundary",
+						RolePath:            "/copilot/",
 					}).Return(nil)
 				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
 			},
@@ -422,6 +580,9 @@ func TestInitAppOpts_Execute(t *testing.T) {
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
+					permissionsBoundary: tc.inPermissionsBoundary,
+					rolePath:            tc.inRolePath,
+					templateOverrides:   tc.inTemplateOverrides,
 				},
 				store:              mockstore,
 				identity:           mockIdentityService,
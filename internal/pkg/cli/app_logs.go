@@ -0,0 +1,325 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appLogsAppNamePrompt     = "Which application would you like to show logs for?"
+	appLogsEnvNamePrompt     = "Which environment would you like to show logs for?"
+	appLogsEnvNameHelpPrompt = "The logs of the services and jobs deployed in the environment will be shown."
+)
+
+type appLogsVars struct {
+	appName          string
+	envNames         []string
+	allEnvs          bool
+	workloads        []string
+	shouldOutputJSON bool
+	follow           bool
+	limit            int
+	humanStartTime   string
+	humanEndTime     string
+	since            time.Duration
+	filter           string
+}
+
+type appLogsOpts struct {
+	appLogsVars
+
+	// internal states
+	startTime    *int64
+	endTime      *int64
+	envWorkloads map[string][]string // Workloads to show logs from in each environment.
+
+	w           io.Writer
+	configStore store
+	deployStore deployedWorkloadsLister
+	sel         appEnvSelector
+	logsSvc     logEventsWriter
+	initLogsSvc func() error // Overridden in tests.
+}
+
+func newAppLogOpts(vars appLogsVars) (*appLogsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app logs"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &appLogsOpts{
+		appLogsVars: vars,
+		w:           log.OutputWriter,
+		configStore: configStore,
+		deployStore: deployStore,
+		sel:         selector.NewSelect(prompt.New(), configStore),
+	}
+	opts.initLogsSvc = func() error {
+		var configs []*logging.NewServiceLogsConfig
+		for _, envName := range opts.envNames {
+			env, err := configStore.GetEnvironment(opts.appName, envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", envName, err)
+			}
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			for _, name := range opts.envWorkloads[envName] {
+				workload, err := configStore.GetWorkload(opts.appName, name)
+				if err != nil {
+					return fmt.Errorf("get workload %s: %w", name, err)
+				}
+				configs = append(configs, &logging.NewServiceLogsConfig{
+					App:         opts.appName,
+					Env:         envName,
+					Svc:         name,
+					Sess:        sess,
+					WkldType:    workload.Type,
+					ConfigStore: configStore,
+				})
+			}
+		}
+		var err error
+		opts.logsSvc, err = logging.NewAggregatedClient(configs)
+		return err
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *appLogsOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.configStore.GetApplication(o.appName); err != nil {
+			return err
+		}
+		for _, envName := range o.envNames {
+			if _, err := o.configStore.GetEnvironment(o.appName, envName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.allEnvs && len(o.envNames) != 0 {
+		return errors.New("only one of --env or --all-envs may be used")
+	}
+
+	if o.since != 0 && o.humanStartTime != "" {
+		return errors.New("only one of --since or --start-time may be used")
+	}
+
+	if o.humanEndTime != "" && o.follow {
+		return errors.New("only one of --follow or --end-time may be used")
+	}
+
+	if o.since != 0 {
+		if o.since < 0 {
+			return fmt.Errorf("--since must be greater than 0")
+		}
+		// round up to the nearest second
+		o.startTime = parseSince(o.since)
+	}
+
+	if o.humanStartTime != "" {
+		startTime, err := parseRFC3339(o.humanStartTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--start-time" flag: %w`, o.humanStartTime, err)
+		}
+		o.startTime = aws.Int64(startTime)
+	}
+
+	if o.humanEndTime != "" {
+		endTime, err := parseRFC3339(o.humanEndTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--end-time" flag: %w`, o.humanEndTime, err)
+		}
+		o.endTime = aws.Int64(endTime)
+	}
+
+	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
+		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
+	}
+
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *appLogsOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askEnvs(); err != nil {
+		return err
+	}
+	return o.validateWorkloads()
+}
+
+// Execute outputs the interleaved logs of the workloads.
+func (o *appLogsOpts) Execute() error {
+	if err := o.initLogsSvc(); err != nil {
+		return err
+	}
+	eventsWriter := logging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = logging.WriteJSONLogs
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
+		Follow:    o.follow,
+		Limit:     limit,
+		EndTime:   o.endTime,
+		StartTime: o.startTime,
+		Filter:    o.filter,
+		OnEvents:  eventsWriter,
+	})
+	if err != nil {
+		return fmt.Errorf("write log events for application %s in %s: %w", o.appName, o.envsString(), err)
+	}
+	return nil
+}
+
+func (o *appLogsOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(appLogsAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *appLogsOpts) askEnvs() error {
+	if len(o.envNames) != 0 {
+		return nil
+	}
+	if o.allEnvs {
+		envs, err := o.configStore.ListEnvironments(o.appName)
+		if err != nil {
+			return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+		}
+		if len(envs) == 0 {
+			return fmt.Errorf("no environments found in application %s", o.appName)
+		}
+		for _, env := range envs {
+			o.envNames = append(o.envNames, env.Name)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(appLogsEnvNamePrompt, appLogsEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envNames = []string{env}
+	return nil
+}
+
+func (o *appLogsOpts) validateWorkloads() error {
+	o.envWorkloads = make(map[string][]string)
+	found := make(map[string]bool)
+	for _, envName := range o.envNames {
+		svcs, err := o.deployStore.ListDeployedServices(o.appName, envName)
+		if err != nil {
+			return fmt.Errorf("list deployed services in environment %s: %w", envName, err)
+		}
+		jobs, err := o.deployStore.ListDeployedJobs(o.appName, envName)
+		if err != nil {
+			return fmt.Errorf("list deployed jobs in environment %s: %w", envName, err)
+		}
+		deployed := append(svcs, jobs...)
+		if len(o.workloads) == 0 {
+			if len(deployed) != 0 {
+				o.envWorkloads[envName] = deployed
+			}
+			continue
+		}
+		for _, name := range o.workloads {
+			if contains(name, deployed) {
+				o.envWorkloads[envName] = append(o.envWorkloads[envName], name)
+				found[name] = true
+			}
+		}
+	}
+	if len(o.workloads) == 0 && len(o.envWorkloads) == 0 {
+		return fmt.Errorf("no services or jobs are deployed in %s", o.envsString())
+	}
+	for _, name := range o.workloads {
+		if !found[name] {
+			return fmt.Errorf("workload %s is not deployed in %s", name, o.envsString())
+		}
+	}
+	return nil
+}
+
+func (o *appLogsOpts) envsString() string {
+	return fmt.Sprintf("%s %s", english.PluralWord(len(o.envNames), "environment", "environments"), strings.Join(o.envNames, ", "))
+}
+
+// buildAppLogsCmd builds the command for displaying the logs of multiple workloads in an application.
+func buildAppLogsCmd() *cobra.Command {
+	vars := appLogsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays the interleaved logs of the services and jobs in one or more environments.",
+
+		Example: `
+  Displays logs of all the workloads deployed in environment "test".
+  /code $ copilot app logs -e test
+  Displays logs of the "frontend", "api" and "worker" services in the last hour.
+  /code $ copilot app logs --workloads frontend,api,worker --since 1h
+  Displays error logs in real time.
+  /code $ copilot app logs --follow --filter ERROR
+  Displays logs of the "api" service in environments "prod-iad" and "prod-pdx".
+  /code $ copilot app logs --workloads api -e prod-iad,prod-pdx
+  Displays logs of all the workloads in all environments.
+  /code $ copilot app logs --all-envs`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppLogOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, logEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.allEnvs, allEnvsFlag, false, logAllEnvsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloads, workloadsFlag, nil, logWorkloadsFlagDescription)
+	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
+	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
+	cmd.Flags().StringVar(&vars.filter, logFilterFlag, "", logFilterFlagDescription)
+	return cmd
+}
@@ -0,0 +1,353 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appLogsMocks struct {
+	configStore *mocks.Mockstore
+	deployStore *mocks.MockdeployedWorkloadsLister
+	sel         *mocks.MockappEnvSelector
+}
+
+func TestAppLogs_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp       string
+		inputEnvs      []string
+		inputAllEnvs   bool
+		inputFollow    bool
+		inputLimit     int
+		inputEndTime   string
+		inputStartTime string
+		inputSince     time.Duration
+
+		setupMocks func(m appLogsMocks)
+
+		wantedError error
+	}{
+		"with no flag set": {
+			setupMocks: func(m appLogsMocks) {},
+		},
+		"returns error if fail to get environment": {
+			inputApp:  "phonetool",
+			inputEnvs: []string{"test", "prod"},
+			setupMocks: func(m appLogsMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.configStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.configStore.EXPECT().GetEnvironment("phonetool", "prod").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns error if env and all-envs flags are set together": {
+			inputEnvs:    []string{"test"},
+			inputAllEnvs: true,
+			setupMocks:   func(m appLogsMocks) {},
+
+			wantedError: errors.New("only one of --env or --all-envs may be used"),
+		},
+		"returns error if since and startTime flags are set together": {
+			inputSince:     time.Minute,
+			inputStartTime: "1970-01-01T01:01:01+00:00",
+			setupMocks:     func(m appLogsMocks) {},
+
+			wantedError: errors.New("only one of --since or --start-time may be used"),
+		},
+		"returns error if follow and endTime flags are set together": {
+			inputFollow:  true,
+			inputEndTime: "1971-01-01T01:01:01+00:00",
+			setupMocks:   func(m appLogsMocks) {},
+
+			wantedError: errors.New("only one of --follow or --end-time may be used"),
+		},
+		"returns error if limit value is above limit": {
+			inputLimit: 10001,
+			setupMocks: func(m appLogsMocks) {},
+
+			wantedError: errors.New("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := appLogsMocks{
+				configStore: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &appLogsOpts{
+				appLogsVars: appLogsVars{
+					appName:        tc.inputApp,
+					envNames:       tc.inputEnvs,
+					allEnvs:        tc.inputAllEnvs,
+					follow:         tc.inputFollow,
+					limit:          tc.inputLimit,
+					humanStartTime: tc.inputStartTime,
+					humanEndTime:   tc.inputEndTime,
+					since:          tc.inputSince,
+				},
+				configStore: m.configStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppLogs_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp       string
+		inputEnvs      []string
+		inputAllEnvs   bool
+		inputWorkloads []string
+
+		setupMocks func(m appLogsMocks)
+
+		wantedApp          string
+		wantedEnvs         []string
+		wantedEnvWorkloads map[string][]string
+		wantedError        error
+	}{
+		"returns error if fail to select application": {
+			setupMocks: func(m appLogsMocks) {
+				m.sel.EXPECT().Application(appLogsAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"returns error if fail to select environment": {
+			inputApp: "phonetool",
+			setupMocks: func(m appLogsMocks) {
+				m.sel.EXPECT().Environment(appLogsEnvNamePrompt, appLogsEnvNameHelpPrompt, "phonetool").Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select environment: some error"),
+		},
+		"returns error if fail to list environments": {
+			inputApp:     "phonetool",
+			inputAllEnvs: true,
+			setupMocks: func(m appLogsMocks) {
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("list environments in application phonetool: some error"),
+		},
+		"returns error if the application has no environments": {
+			inputApp:     "phonetool",
+			inputAllEnvs: true,
+			setupMocks: func(m appLogsMocks) {
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+
+			wantedError: fmt.Errorf("no environments found in application phonetool"),
+		},
+		"returns error if fail to list deployed jobs": {
+			inputApp:  "phonetool",
+			inputEnvs: []string{"test"},
+			setupMocks: func(m appLogsMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("list deployed jobs in environment test: some error"),
+		},
+		"returns error if nothing is deployed": {
+			inputApp:  "phonetool",
+			inputEnvs: []string{"test"},
+			setupMocks: func(m appLogsMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return(nil, nil)
+			},
+
+			wantedError: fmt.Errorf("no services or jobs are deployed in environment test"),
+		},
+		"returns error if a workload is not deployed in any of the environments": {
+			inputApp:       "phonetool",
+			inputEnvs:      []string{"test", "prod"},
+			inputWorkloads: []string{"frontend", "api"},
+			setupMocks: func(m appLogsMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "prod").Return(nil, nil)
+			},
+
+			wantedError: fmt.Errorf("workload api is not deployed in environments test, prod"),
+		},
+		"defaults to all deployed workloads": {
+			setupMocks: func(m appLogsMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("phonetool", nil)
+				m.sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("test", nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return([]string{"report"}, nil)
+			},
+
+			wantedApp:  "phonetool",
+			wantedEnvs: []string{"test"},
+			wantedEnvWorkloads: map[string][]string{
+				"test": {"frontend", "api", "report"},
+			},
+		},
+		"success with selected workloads": {
+			inputApp:       "phonetool",
+			inputEnvs:      []string{"test"},
+			inputWorkloads: []string{"api", "report"},
+			setupMocks: func(m appLogsMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return([]string{"report"}, nil)
+			},
+
+			wantedApp:  "phonetool",
+			wantedEnvs: []string{"test"},
+			wantedEnvWorkloads: map[string][]string{
+				"test": {"api", "report"},
+			},
+		},
+		"success with the workloads deployed in each of all the environments": {
+			inputApp:       "phonetool",
+			inputAllEnvs:   true,
+			inputWorkloads: []string{"api"},
+			setupMocks: func(m appLogsMocks) {
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "staging"},
+					{Name: "prod"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "staging").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "staging").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "prod").Return(nil, nil)
+			},
+
+			wantedApp:  "phonetool",
+			wantedEnvs: []string{"test", "staging", "prod"},
+			wantedEnvWorkloads: map[string][]string{
+				"test": {"api"},
+				"prod": {"api"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := appLogsMocks{
+				configStore: mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedWorkloadsLister(ctrl),
+				sel:         mocks.NewMockappEnvSelector(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &appLogsOpts{
+				appLogsVars: appLogsVars{
+					appName:   tc.inputApp,
+					envNames:  tc.inputEnvs,
+					allEnvs:   tc.inputAllEnvs,
+					workloads: tc.inputWorkloads,
+				},
+				configStore: m.configStore,
+				deployStore: m.deployStore,
+				sel:         m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedEnvs, opts.envNames)
+				require.Equal(t, tc.wantedEnvWorkloads, opts.envWorkloads)
+			}
+		})
+	}
+}
+
+func TestAppLogs_Execute(t *testing.T) {
+	mockStartTime := int64(123456789)
+	testCases := map[string]struct {
+		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
+
+		wantedError error
+	}{
+		"success": {
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, &mockStartTime, param.StartTime)
+					require.Equal(t, "ERROR", param.Filter)
+					require.True(t, param.Follow)
+				}).Return(nil)
+				return m
+			},
+		},
+		"returns error if fail to write log events": {
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+
+			wantedError: fmt.Errorf("write log events for application phonetool in environments test, prod: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := &appLogsOpts{
+				appLogsVars: appLogsVars{
+					appName:  "phonetool",
+					envNames: []string{"test", "prod"},
+					follow:   true,
+					filter:   "ERROR",
+				},
+				startTime:   &mockStartTime,
+				initLogsSvc: func() error { return nil },
+				logsSvc:     tc.mocklogsSvc(ctrl),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
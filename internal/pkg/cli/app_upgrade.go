@@ -165,11 +165,13 @@ func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion
 	}
 	// Upgrade app CloudFormation resources.
 	if err := o.upgrader.UpgradeApplication(&deploy.CreateAppInput{
-		Name:               o.name,
-		AccountID:          caller.Account,
-		DomainName:         app.Domain,
-		DomainHostedZoneID: app.DomainHostedZoneID,
-		Version:            toVersion,
+		Name:                o.name,
+		AccountID:           caller.Account,
+		DomainName:          app.Domain,
+		DomainHostedZoneID:  app.DomainHostedZoneID,
+		Version:             toVersion,
+		PermissionsBoundary: app.PermissionsBoundary,
+		RolePath:            app.RolePath,
 	}); err != nil {
 		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
 	}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -31,6 +32,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -39,6 +41,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/policy"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -47,6 +50,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -59,7 +63,13 @@ var (
 	aliasUsedWithoutDomainFriendlyText = fmt.Sprintf("To use %s, your application must be associated with a domain: %s.\n",
 		color.HighlightCode("http.alias"),
 		color.HighlightCode("copilot app init --domain example.com"))
+	globalUsedWithoutDomainFriendlyText = fmt.Sprintf("To use %s, your application must be associated with a domain: %s.\n",
+		color.HighlightCode("global"),
+		color.HighlightCode("copilot app init --domain example.com"))
 	fmtErrTopicSubscriptionNotAllowed = "SNS topic %s does not exist in environment %s"
+	fmtErrServiceConnectNotEnabled    = `"network.connect" is specified but environment %s was not initialized with "--service-connect"`
+	fmtErrEC2CapacityNotFound         = `"capacity_providers" places tasks on "EC2" but environment %s was not initialized with "--ec2-instance-type"`
+	fmtErrEventBusNotFound            = `"publish.event_bus" or "subscribe.events" is specified but environment %s does not have an event bus, run "copilot env upgrade --app %s --name %s" first`
 	resourceNameFormat                = "%s-%s-%s-%s" // Format for copilot resource names of form app-env-svc-name
 )
 
@@ -72,6 +82,10 @@ type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error)
 }
 
+type imageCopier interface {
+	CopyImage(srcImageURI, repoName string, tags ...string) (digest string, err error)
+}
+
 type uploader interface {
 	Upload(bucket, key string, data io.Reader) (string, error)
 	ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error)
@@ -112,6 +126,15 @@ type serviceDeployer interface {
 	DeployService(out progress.FileWriter, conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
 }
 
+type globalServiceDeployer interface {
+	DeployGlobalService(out progress.FileWriter, in *deploy.CreateGlobalServiceInput) error
+	DeleteGlobalService(app, env, svc string) error
+}
+
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
 type serviceForceUpdater interface {
 	ForceUpdateService(app, env, svc string) error
 	LastUpdatedAt(app, env, svc string) (time.Time, error)
@@ -122,10 +145,22 @@ type spinner interface {
 	Stop(label string)
 }
 
+type policyEvaluator interface {
+	Evaluate(in policy.Input) ([]policy.Violation, error)
+}
+
 type fileReader interface {
 	ReadFile(string) ([]byte, error)
 }
 
+type policiesReader interface {
+	ReadPolicies() ([]byte, error)
+}
+
+type parameterGetter interface {
+	GetParameter(name string) (string, error)
+}
+
 type workloadDeployer struct {
 	name          string
 	app           *config.Application
@@ -140,9 +175,12 @@ type workloadDeployer struct {
 	s3Client           uploader
 	templater          templater
 	imageBuilderPusher imageBuilderPusher
+	imageCopier        imageCopier
 	deployer           serviceDeployer
 	endpointGetter     endpointGetter
+	envOutputsGetter   envOutputsGetter
 	spinner            spinner
+	policies           policyEvaluator // Optional. Rules that the workload is checked against before it's deployed.
 
 	// cached varibles
 	defaultSess              *session.Session
@@ -195,10 +233,10 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		return nil, fmt.Errorf("initiate addons service: %w", err)
 	}
 	repoName := fmt.Sprintf("%s/%s", in.App.Name, in.Name)
-	imageBuilderPusher := repository.NewWithURI(
-		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[in.Name])
+	registry := ecr.New(defaultSessEnvRegion)
+	imageBuilderPusher := repository.NewWithURI(registry, repoName, resources.RepositoryURLs[in.Name])
 	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
-	endpointGetter, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         in.App.Name,
 		Env:         in.Env.Name,
 		ConfigStore: store,
@@ -206,6 +244,10 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("initiate env describer: %w", err)
 	}
+	policies, err := readPolicies(in.App, awsssm.New(defaultSession), ws)
+	if err != nil {
+		return nil, err
+	}
 	return &workloadDeployer{
 		name:               in.Name,
 		app:                in.App,
@@ -217,9 +259,12 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		s3Client:           s3.New(envSession),
 		templater:          addonsSvc,
 		imageBuilderPusher: imageBuilderPusher,
+		imageCopier:        registry,
 		deployer:           cloudformation.New(envSession),
-		endpointGetter:     endpointGetter,
+		endpointGetter:     envDescriber,
+		envOutputsGetter:   envDescriber,
 		spinner:            termprogress.NewSpinner(log.DiagnosticWriter),
+		policies:           policies,
 
 		defaultSess:              defaultSession,
 		defaultSessWithEnvRegion: defaultSessEnvRegion,
@@ -255,6 +300,7 @@ type lbSvcDeployer struct {
 	appVersionGetter       versionGetter
 	publicCIDRBlocksGetter publicCIDRBlocksGetter
 	lbMft                  *manifest.LoadBalancedWebService
+	globalSvcDeployer      globalServiceDeployer
 }
 
 // NewLBDeployer is the constructor for lbSvcDeployer.
@@ -289,6 +335,7 @@ func NewLBDeployer(in *WorkloadDeployerInput) (*lbSvcDeployer, error) {
 		appVersionGetter:       versionGetter,
 		publicCIDRBlocksGetter: envDescriber,
 		lbMft:                  lbMft,
+		globalSvcDeployer:      cloudformation.New(svcDeployer.defaultSess),
 	}, nil
 }
 
@@ -445,6 +492,34 @@ func (d *workloadDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
 	}, nil
 }
 
+// PromoteArtifactsInput is the input of PromoteArtifacts.
+type PromoteArtifactsInput struct {
+	SourceImage string // URI of the image deployed in the environment to promote from.
+}
+
+// PromoteArtifacts copies the container image deployed in another environment into the repository of the
+// target environment's region without rebuilding it, and uploads the remaining deployment artifacts.
+func (d *workloadDeployer) PromoteArtifacts(in *PromoteArtifactsInput) (*UploadArtifactsOutput, error) {
+	imageDigest, err := d.copyContainerImage(in.SourceImage)
+	if err != nil {
+		return nil, err
+	}
+	s3Artifacts, err := d.uploadArtifactsToS3(&uploadArtifactsToS3Input{
+		fs:        d.fs,
+		uploader:  d.s3Client,
+		templater: d.templater,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadArtifactsOutput{
+		ImageDigest: imageDigest,
+		EnvFileARN:  s3Artifacts.envFileARN,
+		AddonsURL:   s3Artifacts.addonsURL,
+	}, nil
+}
+
 // GenerateCloudFormationTemplateInput is the input of GenerateCloudFormationTemplate.
 type GenerateCloudFormationTemplateInput struct {
 	StackRuntimeConfiguration
@@ -456,6 +531,41 @@ type GenerateCloudFormationTemplateOutput struct {
 	Parameters string
 }
 
+// deployGlobalService routes the global alias of the service to its load balancer in the environment.
+// If the environment no longer serves the global alias, it removes the environment's record instead.
+func (d *lbSvcDeployer) deployGlobalService() error {
+	global := d.lbMft.Global
+	if !d.app.RequiresDNSDelegation() {
+		return nil
+	}
+	if !global.IncludesEnv(d.env.Name) {
+		if err := d.globalSvcDeployer.DeleteGlobalService(d.app.Name, d.env.Name, d.name); err != nil {
+			return fmt.Errorf("delete global alias record of service %s in environment %s: %w", d.name, d.env.Name, err)
+		}
+		return nil
+	}
+	outputs, err := d.envOutputsGetter.Outputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", d.env.Name, err)
+	}
+	if err := d.globalSvcDeployer.DeployGlobalService(os.Stderr, &deploy.CreateGlobalServiceInput{
+		App:                      d.app.Name,
+		Env:                      d.env.Name,
+		Name:                     d.name,
+		AppDomain:                d.app.Domain,
+		Alias:                    aws.StringValue(global.Alias),
+		Routing:                  global.RoutingPolicy(),
+		IsPrimary:                global.PrimaryEnv() == d.env.Name,
+		Region:                   d.env.Region,
+		LoadBalancerDNSName:      outputs[stack.EnvOutputPublicLoadBalancerDNS],
+		LoadBalancerHostedZoneID: outputs[stack.EnvOutputPublicLoadBalancerZone],
+		AdditionalTags:           d.app.Tags,
+	}); err != nil {
+		return fmt.Errorf("deploy global alias %s for service %s: %w", aws.StringValue(global.Alias), d.name, err)
+	}
+	return nil
+}
+
 // GenerateCloudFormationTemplate genrates a CloudFormation template and parameters for a workload.
 func (d *lbSvcDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormationTemplateInput) (
 	*GenerateCloudFormationTemplateOutput, error) {
@@ -475,6 +585,9 @@ func (d *lbSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommend
 	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
 		return nil, err
 	}
+	if err := d.deployGlobalService(); err != nil {
+		return nil, err
+	}
 	return nil, nil
 }
 
@@ -607,6 +720,9 @@ func (d *jobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender
 	if err != nil {
 		return nil, err
 	}
+	if err := d.checkStackPolicies(stackConfigOutput.conf); err != nil {
+		return nil, err
+	}
 	if err := d.deployer.DeployService(os.Stderr, stackConfigOutput.conf, d.resources.S3Bucket, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN)); err != nil {
 		return nil, fmt.Errorf("deploy job: %w", err)
 	}
@@ -619,6 +735,9 @@ func (d *workloadDeployer) generateCloudFormationTemplate(conf stackSerializer)
 	if err != nil {
 		return nil, fmt.Errorf("generate stack template: %w", err)
 	}
+	if err := d.checkPolicies(tpl); err != nil {
+		return nil, err
+	}
 	params, err := conf.SerializedParameters()
 	if err != nil {
 		return nil, fmt.Errorf("generate stack template parameters: %w", err)
@@ -630,6 +749,9 @@ func (d *workloadDeployer) generateCloudFormationTemplate(conf stackSerializer)
 }
 
 func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
+	if err := d.checkStackPolicies(stackConfigOutput.conf); err != nil {
+		return err
+	}
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
 	}
@@ -665,6 +787,90 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	return nil
 }
 
+// readPolicies returns the organization's policy rules stored in the application's policies parameter,
+// along with the additional rules in the workspace. It returns nil if there aren't any rules.
+func readPolicies(app *config.Application, parameters parameterGetter, ws policiesReader) (policyEvaluator, error) {
+	var policies *policy.Policies
+	if app.PoliciesParameter != "" {
+		rules, err := parameters.GetParameter(app.PoliciesParameter)
+		if err != nil {
+			return nil, fmt.Errorf("read policies of application %s: %w", app.Name, err)
+		}
+		policies, err = policy.Parse([]byte(rules))
+		if err != nil {
+			return nil, fmt.Errorf("parse policies in parameter %s: %w", app.PoliciesParameter, err)
+		}
+	}
+	dat, err := ws.ReadPolicies()
+	if err != nil {
+		var errNotExist *workspace.ErrFileNotExists
+		if !errors.As(err, &errNotExist) {
+			return nil, fmt.Errorf("read workspace policies: %w", err)
+		}
+	}
+	if dat != nil {
+		wsPolicies, err := policy.Parse(dat)
+		if err != nil {
+			return nil, fmt.Errorf("parse workspace policies: %w", err)
+		}
+		if policies == nil {
+			policies = wsPolicies
+		} else if err := policies.Merge(wsPolicies); err != nil {
+			return nil, fmt.Errorf("add workspace policies: %w", err)
+		}
+	}
+	if policies == nil {
+		return nil, nil
+	}
+	return policies, nil
+}
+
+// checkStackPolicies renders the stack's template and checks the workload against the policy rules.
+func (d *workloadDeployer) checkStackPolicies(conf templater) error {
+	if d.policies == nil {
+		return nil
+	}
+	tpl, err := conf.Template()
+	if err != nil {
+		return fmt.Errorf("generate stack template: %w", err)
+	}
+	return d.checkPolicies(tpl)
+}
+
+// checkPolicies evaluates the policy rules against the workload's manifest and rendered template.
+// It logs the rules with a "warn" severity that fail, and returns an error if any rule with a "block" severity fails.
+func (d *workloadDeployer) checkPolicies(tpl string) error {
+	if d.policies == nil {
+		return nil
+	}
+	mft, err := yaml.Marshal(d.mft)
+	if err != nil {
+		return fmt.Errorf("marshal manifest of %s: %w", d.name, err)
+	}
+	violations, err := d.policies.Evaluate(policy.Input{
+		Environment: d.env.Name,
+		Manifest:    mft,
+		Template:    []byte(tpl),
+	})
+	if err != nil {
+		return fmt.Errorf("evaluate policies for %s: %w", d.name, err)
+	}
+	var blocking []policy.Violation
+	for _, violation := range violations {
+		if violation.Severity == policy.SeverityWarn {
+			log.Warningf("Policy rule %s: %s\n", violation.Rule, violation.Message)
+			continue
+		}
+		blocking = append(blocking, violation)
+	}
+	if len(blocking) != 0 {
+		return &policy.ErrViolations{
+			Violations: blocking,
+		}
+	}
+	return nil
+}
+
 type forceDeployInput struct {
 	spinner    spinner
 	svcUpdater serviceForceUpdater
@@ -720,6 +926,29 @@ func (d *workloadDeployer) uploadContainerImage(imgBuilderPusher imageBuilderPus
 	return aws.String(digest), nil
 }
 
+func (d *workloadDeployer) copyContainerImage(srcImage string) (*string, error) {
+	required, err := manifest.DockerfileBuildRequired(d.mft)
+	if err != nil {
+		return nil, err
+	}
+	if !required {
+		return nil, fmt.Errorf("service %s does not build its container image from a Dockerfile, there is no image to promote", d.name)
+	}
+	src, err := ecr.ParseImageURI(srcImage)
+	if err != nil {
+		return nil, err
+	}
+	var tags []string
+	if src.Tag != "" {
+		tags = append(tags, src.Tag)
+	}
+	digest, err := d.imageCopier.CopyImage(srcImage, fmt.Sprintf("%s/%s", d.app.Name, d.name), tags...)
+	if err != nil {
+		return nil, fmt.Errorf("copy image %s to region %s: %w", srcImage, d.env.Region, err)
+	}
+	return aws.String(digest), nil
+}
+
 type uploadArtifactsToS3Input struct {
 	fs        fileReader
 	uploader  uploader
@@ -812,12 +1041,21 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	if err != nil {
 		return nil, fmt.Errorf("get service discovery endpoint: %w", err)
 	}
+	partialOverrides, err := d.partialOverrides()
+	if err != nil {
+		return nil, err
+	}
+	encryptLogs := d.env.Telemetry != nil && d.env.Telemetry.EncryptLogs
 	if in.ImageDigest == nil {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        in.AddonsURL,
 			EnvFileARN:               in.EnvFileARN,
 			AdditionalTags:           in.Tags,
+			PermissionsBoundary:      d.app.PermissionsBoundary,
+			RolePath:                 d.app.RolePath,
+			PartialOverrides:         partialOverrides,
 			ServiceDiscoveryEndpoint: endpoint,
+			EncryptLogs:              encryptLogs,
 			AccountID:                d.env.AccountID,
 			Region:                   d.env.Region,
 		}, nil
@@ -831,12 +1069,36 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 			ImageTag: d.imageTag,
 			Digest:   aws.StringValue(in.ImageDigest),
 		},
+		PermissionsBoundary:      d.app.PermissionsBoundary,
+		RolePath:                 d.app.RolePath,
+		PartialOverrides:         partialOverrides,
 		ServiceDiscoveryEndpoint: endpoint,
+		EncryptLogs:              encryptLogs,
 		AccountID:                d.env.AccountID,
 		Region:                   d.env.Region,
 	}, nil
 }
 
+// partialOverrides returns the application's directory of template partial overrides, or nil if there is none.
+// It errors if the application has overrides but the directory is missing from the workspace.
+func (d *workloadDeployer) partialOverrides() (fs.FS, error) {
+	if d.app.TemplateOverrides == "" {
+		return nil, nil
+	}
+	dir := d.app.TemplateOverrides
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(d.workspacePath, dir)
+	}
+	overrides := os.DirFS(dir)
+	if _, err := fs.Stat(overrides, "."); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("template overrides directory %s of application %s is not in this workspace", d.app.TemplateOverrides, d.app.Name)
+		}
+		return nil, fmt.Errorf("stat template overrides directory %s: %w", dir, err)
+	}
+	return overrides, nil
+}
+
 type svcStackConfigurationOutput struct {
 	conf       cloudformation.StackConfiguration
 	svcUpdater serviceForceUpdater
@@ -847,7 +1109,16 @@ func (d *lbSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcS
 	if err != nil {
 		return nil, err
 	}
-	if err := validateLBWSRuntime(d.app, d.env.Name, d.lbMft, d.appVersionGetter); err != nil {
+	if err := validateLBWSRuntime(d.app, d.env, d.lbMft, d.appVersionGetter); err != nil {
+		return nil, err
+	}
+	if err := validateServiceConnect(d.lbMft.Network.Connect, d.env); err != nil {
+		return nil, err
+	}
+	if err := validateEC2Capacity(d.lbMft.Capacity, d.env); err != nil {
+		return nil, err
+	}
+	if err := validateEventBus(aws.BoolValue(d.lbMft.PublishConfig.EventBus), d.app.Name, d.env.Name, d.envOutputsGetter); err != nil {
 		return nil, err
 	}
 	var opts []stack.LoadBalancedWebServiceOption
@@ -863,6 +1134,7 @@ func (d *lbSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcS
 			Name:                d.app.Name,
 			DNSName:             d.app.Domain,
 			AccountPrincipalARN: in.RootUserARN,
+			RolePath:            d.app.RolePath,
 		}))
 		if !d.lbMft.RoutingRule.Disabled() {
 			opts = append(opts, stack.WithHTTPS())
@@ -885,6 +1157,15 @@ func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 	if err != nil {
 		return nil, err
 	}
+	if err := validateServiceConnect(d.backendMft.Network.Connect, d.env); err != nil {
+		return nil, err
+	}
+	if err := validateEC2Capacity(d.backendMft.Capacity, d.env); err != nil {
+		return nil, err
+	}
+	if err := validateEventBus(aws.BoolValue(d.backendMft.PublishConfig.EventBus), d.app.Name, d.env.Name, d.envOutputsGetter); err != nil {
+		return nil, err
+	}
 	conf, err := stack.NewBackendService(d.backendMft, d.env.Name, d.app.Name, *rc)
 	if err != nil {
 		return nil, fmt.Errorf("create stack configuration: %w", err)
@@ -912,10 +1193,14 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 		log.Errorf(aliasUsedWithoutDomainFriendlyText)
 		return nil, errors.New("alias specified when application is not associated with a domain")
 	}
+	if err := validateEventBus(aws.BoolValue(d.rdwsMft.PublishConfig.EventBus), d.app.Name, d.env.Name, d.envOutputsGetter); err != nil {
+		return nil, err
+	}
 	appInfo := deploy.AppInformation{
 		Name:                d.app.Name,
 		DNSName:             d.app.Domain,
 		AccountPrincipalARN: in.RootUserARN,
+		RolePath:            d.app.RolePath,
 	}
 	if d.rdwsMft.Alias == nil {
 		conf, err := stack.NewRequestDrivenWebService(d.rdwsMft, d.env.Name, appInfo, *rc)
@@ -982,6 +1267,16 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 	if err = validateTopicsExist(subs, topicARNs, d.app.Name, d.env.Name); err != nil {
 		return nil, err
 	}
+	if err = validateServiceConnect(d.wsMft.Network.Connect, d.env); err != nil {
+		return nil, err
+	}
+	if err = validateEC2Capacity(d.wsMft.Capacity, d.env); err != nil {
+		return nil, err
+	}
+	usesEventBus := aws.BoolValue(d.wsMft.PublishConfig.EventBus) || len(d.wsMft.Subscribe.Events) != 0
+	if err = validateEventBus(usesEventBus, d.app.Name, d.env.Name, d.envOutputsGetter); err != nil {
+		return nil, err
+	}
 	conf, err := stack.NewWorkerService(d.wsMft, d.env.Name, d.app.Name, *rc)
 	if err != nil {
 		return nil, fmt.Errorf("create stack configuration: %w", err)
@@ -1006,6 +1301,9 @@ func (d *jobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*jobSta
 	if err != nil {
 		return nil, err
 	}
+	if err := validateEventBus(aws.BoolValue(d.jobMft.PublishConfig.EventBus), d.app.Name, d.env.Name, d.envOutputsGetter); err != nil {
+		return nil, err
+	}
 	conf, err := stack.NewScheduledJob(d.jobMft, d.env.Name, d.app.Name, *rc)
 	if err != nil {
 		return nil, fmt.Errorf("create stack configuration: %w", err)
@@ -1071,6 +1369,38 @@ func validateTopicsExist(subscriptions []manifest.TopicSubscription, topicARNs [
 	return nil
 }
 
+func validateServiceConnect(connect manifest.ServiceConnectBoolOrArgs, env *config.Environment) error {
+	if !connect.Enabled() || env.ServiceConnect {
+		return nil
+	}
+	return fmt.Errorf(fmtErrServiceConnectNotEnabled, env.Name)
+}
+
+// validateEC2Capacity returns an error if the service places tasks on the EC2 capacity provider
+// but the environment does not have an Auto Scaling group for them.
+func validateEC2Capacity(capacity manifest.CapacityProviders, env *config.Environment) error {
+	if !capacity.UsesProvider(manifest.CapacityProviderEC2) || env.EC2Capacity != nil {
+		return nil
+	}
+	return fmt.Errorf(fmtErrEC2CapacityNotFound, env.Name)
+}
+
+// validateEventBus returns an error if the workload uses the environment's event bus
+// but the environment stack was deployed before the event bus was added to it.
+func validateEventBus(usesEventBus bool, appName, envName string, getter envOutputsGetter) error {
+	if !usesEventBus {
+		return nil
+	}
+	outputs, err := getter.Outputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", envName, err)
+	}
+	if _, ok := outputs[stack.EnvOutputEventBusName]; !ok {
+		return fmt.Errorf(fmtErrEventBusNotFound, envName, appName, envName)
+	}
+	return nil
+}
+
 func contains(s string, items []string) bool {
 	for _, item := range items {
 		if s == item {
@@ -1140,7 +1470,7 @@ func validateAppVersion(appName string, appVersionGetter versionGetter) error {
 	return nil
 }
 
-func validateLBWSRuntime(app *config.Application, envName string, mft *manifest.LoadBalancedWebService, appVersionGetter versionGetter) error {
+func validateLBWSRuntime(app *config.Application, env *config.Environment, mft *manifest.LoadBalancedWebService, appVersionGetter versionGetter) error {
 	if app.Domain == "" && mft.HasAliases() {
 		log.Errorf(aliasUsedWithoutDomainFriendlyText)
 		return errors.New("alias specified when application is not associated with a domain")
@@ -1153,10 +1483,46 @@ func validateLBWSRuntime(app *config.Application, envName string, mft *manifest.
 		}
 	}
 
-	if err := validateLBSvcAlias(mft.RoutingRule.Alias, app, envName); err != nil {
+	if err := validateLBSvcAlias(mft.RoutingRule.Alias, app, env.Name); err != nil {
 		return err
 	}
-	return validateLBSvcAlias(mft.NLBConfig.Aliases, app, envName)
+	if err := validateLBSvcAlias(mft.NLBConfig.Aliases, app, env.Name); err != nil {
+		return err
+	}
+	return validateGlobalSvcAlias(mft.Global, app, env)
+}
+
+func validateGlobalSvcAlias(global manifest.GlobalServiceConfig, app *config.Application, env *config.Environment) error {
+	if global.IsEmpty() {
+		return nil
+	}
+	if app.Domain == "" {
+		log.Errorf(globalUsedWithoutDomainFriendlyText)
+		return errors.New("global alias specified when application is not associated with a domain")
+	}
+	// Route 53 health checks can only monitor CloudWatch alarms in their own account.
+	if global.IncludesEnv(env.Name) && env.AccountID != app.AccountID {
+		return fmt.Errorf("environment %s serving global alias must be in the account %s of application %s", env.Name, app.AccountID, app.Name)
+	}
+	// The records of a global alias are not owned by any environment, so it must be within either the app or root hosted zone.
+	alias := aws.StringValue(global.Alias)
+	appZone := fmt.Sprintf("%s.%s", app.Name, app.Domain)
+	for _, zone := range []string{appZone, app.Domain} {
+		re, err := regexp.Compile(fmt.Sprintf(`^([^\.]+\.)?%s$`, regexp.QuoteMeta(zone)))
+		if err != nil {
+			return err
+		}
+		if re.MatchString(alias) {
+			return nil
+		}
+	}
+	log.Errorf(`%s must match one of the following patterns:
+- %s,
+- <name>.%s,
+- %s,
+- <name>.%s
+`, color.HighlightCode("global.alias"), appZone, appZone, app.Domain, app.Domain)
+	return fmt.Errorf(`global alias "%s" is not supported in hosted zones managed by Copilot`, alias)
 }
 
 func validateLBSvcAlias(aliases manifest.Alias, app *config.Application, envName string) error {
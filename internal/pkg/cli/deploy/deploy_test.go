@@ -6,6 +6,7 @@ package deploy
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -23,14 +24,17 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/policy"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 )
 
 type deployMocks struct {
 	mockImageBuilderPusher     *mocks.MockimageBuilderPusher
+	mockImageCopier            *mocks.MockimageCopier
 	mockEndpointGetter         *mocks.MockendpointGetter
 	mockSpinner                *mocks.Mockspinner
 	mockPublicCIDRBlocksGetter *mocks.MockpublicCIDRBlocksGetter
@@ -41,6 +45,8 @@ type deployMocks struct {
 	mockUploader               *mocks.Mockuploader
 	mockVersionGetter          *mocks.MockversionGetter
 	mockFileReader             *mocks.MockfileReader
+	mockGlobalSvcDeployer      *mocks.MockglobalServiceDeployer
+	mockEnvOutputsGetter       *mocks.MockenvOutputsGetter
 }
 
 type mockWorkloadMft struct {
@@ -253,6 +259,105 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 	}
 }
 
+func TestWorkloadDeployer_PromoteArtifacts(t *testing.T) {
+	const (
+		mockName     = "mockWkld"
+		mockAppName  = "press"
+		mockS3Bucket = "mockBucket"
+		mockSrcImage = "123456789012.dkr.ecr.us-east-1.amazonaws.com/press/mockWkld:v1"
+	)
+	mockError := errors.New("some error")
+	tests := map[string]struct {
+		inBuildRequired bool
+		inSrcImage      string
+
+		mock func(m *deployMocks)
+
+		wantImageDigest *string
+		wantErr         error
+	}{
+		"error if the service does not build its image": {
+			inSrcImage: mockSrcImage,
+			mock:       func(m *deployMocks) {},
+			wantErr:    errors.New("service mockWkld does not build its container image from a Dockerfile, there is no image to promote"),
+		},
+		"error if the source image is not in ECR": {
+			inBuildRequired: true,
+			inSrcImage:      "nginx",
+			mock:            func(m *deployMocks) {},
+			wantErr:         errors.New("parse image uri nginx: missing repository name"),
+		},
+		"error if fail to copy the image": {
+			inBuildRequired: true,
+			inSrcImage:      mockSrcImage,
+			mock: func(m *deployMocks) {
+				m.mockImageCopier.EXPECT().CopyImage(mockSrcImage, "press/mockWkld", "v1").Return("", mockError)
+			},
+			wantErr: errors.New("copy image 123456789012.dkr.ecr.us-east-1.amazonaws.com/press/mockWkld:v1 to region us-west-2: some error"),
+		},
+		"copy the image and upload the other artifacts": {
+			inBuildRequired: true,
+			inSrcImage:      "123456789012.dkr.ecr.us-east-1.amazonaws.com/press/mockWkld@sha256:abc",
+			mock: func(m *deployMocks) {
+				m.mockImageCopier.EXPECT().CopyImage("123456789012.dkr.ecr.us-east-1.amazonaws.com/press/mockWkld@sha256:abc", "press/mockWkld").
+					Return("sha256:abc", nil)
+				m.mockTemplater.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{
+					WlName: "mockWkld",
+				})
+			},
+			wantImageDigest: aws.String("sha256:abc"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployMocks{
+				mockUploader:    mocks.NewMockuploader(ctrl),
+				mockTemplater:   mocks.NewMocktemplater(ctrl),
+				mockImageCopier: mocks.NewMockimageCopier(ctrl),
+				mockFileReader:  mocks.NewMockfileReader(ctrl),
+			}
+			tc.mock(m)
+
+			deployer := workloadDeployer{
+				name: mockName,
+				env: &config.Environment{
+					Name:   "prod",
+					Region: "us-west-2",
+				},
+				app: &config.Application{
+					Name: mockAppName,
+				},
+				resources: &stack.AppRegionalResources{
+					S3Bucket: mockS3Bucket,
+				},
+				mft: &mockWorkloadMft{
+					buildRequired: tc.inBuildRequired,
+				},
+
+				templater:   m.mockTemplater,
+				fs:          m.mockFileReader,
+				s3Client:    m.mockUploader,
+				imageCopier: m.mockImageCopier,
+			}
+
+			got, gotErr := deployer.PromoteArtifacts(&PromoteArtifactsInput{
+				SourceImage: tc.inSrcImage,
+			})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantImageDigest, got.ImageDigest)
+			}
+		})
+	}
+}
+
 func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	mockError := errors.New("some error")
 	const (
@@ -270,6 +375,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	tests := map[string]struct {
 		inAliases         manifest.Alias
 		inNLB             manifest.NetworkLoadBalancerConfiguration
+		inGlobal          manifest.GlobalServiceConfig
 		inApp             *config.Application
 		inEnvironment     *config.Environment
 		inForceDeploy     bool
@@ -530,6 +636,152 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockGlobalSvcDeployer.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockName).Return(nil)
+			},
+		},
+		"global alias outside of Copilot-managed hosted zones": {
+			inGlobal: manifest.GlobalServiceConfig{
+				Alias:        aws.String("api.other.com"),
+				Environments: []string{mockEnvName, "otherEnv"},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: errors.New(`global alias "api.other.com" is not supported in hosted zones managed by Copilot`),
+		},
+		"fail if an environment serving the global alias is in a different account": {
+			inGlobal: manifest.GlobalServiceConfig{
+				Alias:        aws.String("api.mockApp.mockDomain"),
+				Environments: []string{mockEnvName, "otherEnv"},
+			},
+			inEnvironment: &config.Environment{
+				Name:      mockEnvName,
+				Region:    "us-west-2",
+				AccountID: "2222",
+			},
+			inApp: &config.Application{
+				Name:      mockAppName,
+				AccountID: "1111",
+				Domain:    "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: errors.New("environment mockEnv serving global alias must be in the account 1111 of application mockApp"),
+		},
+		"fail to get outputs of an environment serving the global alias": {
+			inGlobal: manifest.GlobalServiceConfig{
+				Alias:        aws.String("api.mockApp.mockDomain"),
+				Environments: []string{mockEnvName, "otherEnv"},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockEnvOutputsGetter.EXPECT().Outputs().Return(nil, mockError)
+			},
+			wantErr: errors.New("get outputs of environment mockEnv: some error"),
+		},
+		"fail to remove the record of an environment that no longer serves the global alias": {
+			inGlobal: manifest.GlobalServiceConfig{
+				Alias:        aws.String("api.mockApp.mockDomain"),
+				Environments: []string{"otherEnv"},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockGlobalSvcDeployer.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockName).Return(mockError)
+			},
+			wantErr: errors.New("delete global alias record of service mockWkld in environment mockEnv: some error"),
+		},
+		"success removing the record of an environment that no longer serves the global alias": {
+			inGlobal: manifest.GlobalServiceConfig{
+				Alias:        aws.String("api.mockApp.mockDomain"),
+				Environments: []string{"otherEnv"},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockGlobalSvcDeployer.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockName).Return(nil)
+			},
+		},
+		"success with global alias": {
+			inGlobal: manifest.GlobalServiceConfig{
+				Alias:        aws.String("api.mockApp.mockDomain"),
+				Routing:      aws.String(manifest.GlobalRoutingFailover),
+				Primary:      aws.String(mockEnvName),
+				Environments: []string{"otherEnv", mockEnvName},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+				Tags: map[string]string{
+					"owner": "boss",
+				},
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockEnvOutputsGetter.EXPECT().Outputs().Return(map[string]string{
+					"PublicLoadBalancerDNSName":    "mockLB.us-west-2.elb.amazonaws.com",
+					"PublicLoadBalancerHostedZone": "Z1H1FL5HABSF5",
+				}, nil)
+				m.mockGlobalSvcDeployer.EXPECT().DeployGlobalService(gomock.Any(), &deploy.CreateGlobalServiceInput{
+					App:                      mockAppName,
+					Env:                      mockEnvName,
+					Name:                     mockName,
+					AppDomain:                "mockDomain",
+					Alias:                    "api.mockApp.mockDomain",
+					Routing:                  manifest.GlobalRoutingFailover,
+					IsPrimary:                true,
+					Region:                   "us-west-2",
+					LoadBalancerDNSName:      "mockLB.us-west-2.elb.amazonaws.com",
+					LoadBalancerHostedZoneID: "Z1H1FL5HABSF5",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+				}).Return(nil)
 			},
 		},
 		"success with force update": {
@@ -566,19 +818,22 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockServiceForceUpdater:    mocks.NewMockserviceForceUpdater(ctrl),
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
+				mockGlobalSvcDeployer:      mocks.NewMockglobalServiceDeployer(ctrl),
+				mockEnvOutputsGetter:       mocks.NewMockenvOutputsGetter(ctrl),
 			}
 			tc.mock(m)
 
 			deployer := lbSvcDeployer{
 				svcDeployer: &svcDeployer{
 					workloadDeployer: &workloadDeployer{
-						name:           mockName,
-						app:            tc.inApp,
-						env:            tc.inEnvironment,
-						resources:      mockResources,
-						deployer:       m.mockServiceDeployer,
-						endpointGetter: m.mockEndpointGetter,
-						spinner:        m.mockSpinner,
+						name:             mockName,
+						app:              tc.inApp,
+						env:              tc.inEnvironment,
+						resources:        mockResources,
+						deployer:         m.mockServiceDeployer,
+						endpointGetter:   m.mockEndpointGetter,
+						envOutputsGetter: m.mockEnvOutputsGetter,
+						spinner:          m.mockSpinner,
 					},
 					newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 						return m.mockServiceForceUpdater
@@ -589,6 +844,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				},
 				appVersionGetter:       m.mockVersionGetter,
 				publicCIDRBlocksGetter: m.mockPublicCIDRBlocksGetter,
+				globalSvcDeployer:      m.mockGlobalSvcDeployer,
 				lbMft: &manifest.LoadBalancedWebService{
 					Workload: manifest.Workload{
 						Name: aws.String(mockName),
@@ -609,6 +865,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 							},
 						},
 						NLBConfig: tc.inNLB,
+						Global:    tc.inGlobal,
 					},
 				},
 			}
@@ -953,6 +1210,126 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 	}
 }
 
+func TestWorkloadDeployer_checkPolicies(t *testing.T) {
+	const mockTemplate = "Resources: {}"
+	mockManifest := &manifest.BackendService{
+		Workload: manifest.Workload{
+			Name: aws.String("api"),
+			Type: aws.String(manifest.BackendServiceType),
+		},
+	}
+	testCases := map[string]struct {
+		mock func(m *mocks.MockpolicyEvaluator)
+
+		wantedErr string
+	}{
+		"error if the policies can't be evaluated": {
+			mock: func(m *mocks.MockpolicyEvaluator) {
+				m.EXPECT().Evaluate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "evaluate policies for api: some error",
+		},
+		"succeed if only warning rules fail": {
+			mock: func(m *mocks.MockpolicyEvaluator) {
+				m.EXPECT().Evaluate(gomock.Any()).DoAndReturn(func(in policy.Input) ([]policy.Violation, error) {
+					require.Equal(t, "test", in.Environment)
+					require.Contains(t, string(in.Manifest), "type: Backend Service")
+					require.Equal(t, mockTemplate, string(in.Template))
+					return []policy.Violation{
+						{Rule: "alarms", Severity: policy.SeverityWarn, Message: "every service should have alarms"},
+					}, nil
+				})
+			},
+		},
+		"error if blocking rules fail": {
+			mock: func(m *mocks.MockpolicyEvaluator) {
+				m.EXPECT().Evaluate(gomock.Any()).Return([]policy.Violation{
+					{Rule: "alarms", Severity: policy.SeverityWarn, Message: "every service should have alarms"},
+					{Rule: "max-memory", Severity: policy.SeverityBlock, Message: "memory must be at most 8192"},
+				}, nil)
+			},
+			wantedErr: "blocked by policy rules: max-memory: memory must be at most 8192",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpolicyEvaluator(ctrl)
+			tc.mock(m)
+			deployer := &workloadDeployer{
+				name:     "api",
+				env:      &config.Environment{Name: "test"},
+				mft:      mockManifest,
+				policies: m,
+			}
+
+			// WHEN
+			err := deployer.checkPolicies(mockTemplate)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorkloadDeployer_partialOverrides(t *testing.T) {
+	wsRoot := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(wsRoot, "templates"), 0755))
+	testCases := map[string]struct {
+		inTemplateOverrides string
+
+		wantedOverrides bool
+		wantedErr       error
+	}{
+		"no template overrides": {},
+		"template overrides relative to the workspace root": {
+			inTemplateOverrides: "templates",
+			wantedOverrides:     true,
+		},
+		"absolute template overrides": {
+			inTemplateOverrides: filepath.Join(wsRoot, "templates"),
+			wantedOverrides:     true,
+		},
+		"error if the template overrides are missing from the workspace": {
+			inTemplateOverrides: "partials",
+			wantedErr:           errors.New("template overrides directory partials of application phonetool is not in this workspace"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			deployer := &workloadDeployer{
+				app: &config.Application{
+					Name:              "phonetool",
+					TemplateOverrides: tc.inTemplateOverrides,
+				},
+				workspacePath: wsRoot,
+			}
+
+			// WHEN
+			got, err := deployer.partialOverrides()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedOverrides {
+				require.NotNil(t, got)
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
 func Test_validateTopicsExist(t *testing.T) {
 	mockApp := "app"
 	mockEnv := "env"
@@ -1008,3 +1385,271 @@ func Test_validateTopicsExist(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateServiceConnect(t *testing.T) {
+	testCases := map[string]struct {
+		inConnect manifest.ServiceConnectBoolOrArgs
+		inEnv     *config.Environment
+
+		wantErr string
+	}{
+		"service connect is not specified": {
+			inEnv: &config.Environment{
+				Name: "test",
+			},
+		},
+		"service connect is disabled": {
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(false),
+			},
+			inEnv: &config.Environment{
+				Name: "test",
+			},
+		},
+		"environment uses service connect": {
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: manifest.ServiceConnectArgs{
+					Alias: aws.String("api"),
+				},
+			},
+			inEnv: &config.Environment{
+				Name:           "test",
+				ServiceConnect: true,
+			},
+		},
+		"environment does not use service connect": {
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(true),
+			},
+			inEnv: &config.Environment{
+				Name: "test",
+			},
+			wantErr: `"network.connect" is specified but environment test was not initialized with "--service-connect"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateServiceConnect(tc.inConnect, tc.inEnv)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateEC2Capacity(t *testing.T) {
+	testCases := map[string]struct {
+		inCapacity manifest.CapacityProviders
+		inEnv      *config.Environment
+
+		wantErr string
+	}{
+		"capacity providers are not specified": {
+			inEnv: &config.Environment{
+				Name: "test",
+			},
+		},
+		"tasks are only placed on Fargate": {
+			inCapacity: manifest.CapacityProviders{
+				{
+					Provider: aws.String(manifest.CapacityProviderFargateSpot),
+				},
+			},
+			inEnv: &config.Environment{
+				Name: "test",
+			},
+		},
+		"environment has EC2 capacity": {
+			inCapacity: manifest.CapacityProviders{
+				{
+					Provider: aws.String(manifest.CapacityProviderEC2),
+				},
+			},
+			inEnv: &config.Environment{
+				Name: "test",
+				EC2Capacity: &config.EC2Capacity{
+					InstanceType: "t3.medium",
+					MaxSize:      5,
+				},
+			},
+		},
+		"environment does not have EC2 capacity": {
+			inCapacity: manifest.CapacityProviders{
+				{
+					Provider: aws.String(manifest.CapacityProviderEC2),
+				},
+			},
+			inEnv: &config.Environment{
+				Name: "test",
+			},
+			wantErr: `"capacity_providers" places tasks on "EC2" but environment test was not initialized with "--ec2-instance-type"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateEC2Capacity(tc.inCapacity, tc.inEnv)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateEventBus(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inUsesEventBus bool
+		setupMocks     func(m *mocks.MockenvOutputsGetter)
+
+		wantErr string
+	}{
+		"event bus is not used": {
+			setupMocks: func(m *mocks.MockenvOutputsGetter) {},
+		},
+		"fail to get environment outputs": {
+			inUsesEventBus: true,
+			setupMocks: func(m *mocks.MockenvOutputsGetter) {
+				m.EXPECT().Outputs().Return(nil, mockError)
+			},
+			wantErr: "get outputs of environment test: some error",
+		},
+		"environment does not have an event bus": {
+			inUsesEventBus: true,
+			setupMocks: func(m *mocks.MockenvOutputsGetter) {
+				m.EXPECT().Outputs().Return(map[string]string{
+					"ClusterId": "test-cluster",
+				}, nil)
+			},
+			wantErr: `"publish.event_bus" or "subscribe.events" is specified but environment test does not have an event bus, run "copilot env upgrade --app phonetool --name test" first`,
+		},
+		"environment has an event bus": {
+			inUsesEventBus: true,
+			setupMocks: func(m *mocks.MockenvOutputsGetter) {
+				m.EXPECT().Outputs().Return(map[string]string{
+					"EventBusName": "phonetool-test",
+				}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvOutputsGetter(ctrl)
+			tc.setupMocks(m)
+
+			err := validateEventBus(tc.inUsesEventBus, "phonetool", "test", m)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_readPolicies(t *testing.T) {
+	const (
+		orgRules = `
+rules:
+  - name: max-memory
+    target: manifest
+    path: memory
+    max: 8192`
+		wsRules = `
+rules:
+  - name: alarms
+    severity: warn
+    target: template
+    path: Resources.*.Type
+    includes: AWS::CloudWatch::Alarm`
+	)
+	testCases := map[string]struct {
+		inApp      *config.Application
+		setupMocks func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader)
+
+		wantedRules []string
+		wantErr     string
+	}{
+		"no policies": {
+			inApp: &config.Application{Name: "phonetool"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				ws.EXPECT().ReadPolicies().Return(nil, &workspace.ErrFileNotExists{FileName: "policies.yml"})
+			},
+		},
+		"fail to read the policies parameter": {
+			inApp: &config.Application{Name: "phonetool", PoliciesParameter: "/org/copilot/policies"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				params.EXPECT().GetParameter("/org/copilot/policies").Return("", errors.New("some error"))
+			},
+			wantErr: "read policies of application phonetool: some error",
+		},
+		"fail to read the workspace policies": {
+			inApp: &config.Application{Name: "phonetool"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				ws.EXPECT().ReadPolicies().Return(nil, errors.New("some error"))
+			},
+			wantErr: "read workspace policies: some error",
+		},
+		"workspace policies can't reuse the name of an application rule": {
+			inApp: &config.Application{Name: "phonetool", PoliciesParameter: "/org/copilot/policies"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				params.EXPECT().GetParameter("/org/copilot/policies").Return(orgRules, nil)
+				ws.EXPECT().ReadPolicies().Return([]byte(orgRules), nil)
+			},
+			wantErr: `add workspace policies: rule name "max-memory" is used more than once`,
+		},
+		"only application policies": {
+			inApp: &config.Application{Name: "phonetool", PoliciesParameter: "/org/copilot/policies"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				params.EXPECT().GetParameter("/org/copilot/policies").Return(orgRules, nil)
+				ws.EXPECT().ReadPolicies().Return(nil, &workspace.ErrFileNotExists{FileName: "policies.yml"})
+			},
+			wantedRules: []string{"max-memory"},
+		},
+		"only workspace policies": {
+			inApp: &config.Application{Name: "phonetool"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				ws.EXPECT().ReadPolicies().Return([]byte(wsRules), nil)
+			},
+			wantedRules: []string{"alarms"},
+		},
+		"workspace policies are added to the application policies": {
+			inApp: &config.Application{Name: "phonetool", PoliciesParameter: "/org/copilot/policies"},
+			setupMocks: func(params *mocks.MockparameterGetter, ws *mocks.MockpoliciesReader) {
+				params.EXPECT().GetParameter("/org/copilot/policies").Return(orgRules, nil)
+				ws.EXPECT().ReadPolicies().Return([]byte(wsRules), nil)
+			},
+			wantedRules: []string{"max-memory", "alarms"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			params := mocks.NewMockparameterGetter(ctrl)
+			ws := mocks.NewMockpoliciesReader(ctrl)
+			tc.setupMocks(params, ws)
+
+			got, err := readPolicies(tc.inApp, params, ws)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedRules == nil {
+				require.Nil(t, got)
+				return
+			}
+			var names []string
+			for _, rule := range got.(*policy.Policies).Rules {
+				names = append(names, rule.Name)
+			}
+			require.Equal(t, tc.wantedRules, names)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	policy "github.com/aws/copilot-cli/internal/pkg/policy"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	gomock "github.com/golang/mock/gomock"
@@ -94,6 +95,49 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
+// MockimageCopier is a mock of imageCopier interface.
+type MockimageCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageCopierMockRecorder
+}
+
+// MockimageCopierMockRecorder is the mock recorder for MockimageCopier.
+type MockimageCopierMockRecorder struct {
+	mock *MockimageCopier
+}
+
+// NewMockimageCopier creates a new mock instance.
+func NewMockimageCopier(ctrl *gomock.Controller) *MockimageCopier {
+	mock := &MockimageCopier{ctrl: ctrl}
+	mock.recorder = &MockimageCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageCopier) EXPECT() *MockimageCopierMockRecorder {
+	return m.recorder
+}
+
+// CopyImage mocks base method.
+func (m *MockimageCopier) CopyImage(srcImageURI, repoName string, tags ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{srcImageURI, repoName}
+	for _, a := range tags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopyImage", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockimageCopierMockRecorder) CopyImage(srcImageURI, repoName interface{}, tags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{srcImageURI, repoName}, tags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockimageCopier)(nil).CopyImage), varargs...)
+}
+
 // Mockuploader is a mock of uploader interface.
 type Mockuploader struct {
 	ctrl     *gomock.Controller
@@ -505,6 +549,95 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(out, conf, bucketName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// MockglobalServiceDeployer is a mock of globalServiceDeployer interface.
+type MockglobalServiceDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockglobalServiceDeployerMockRecorder
+}
+
+// MockglobalServiceDeployerMockRecorder is the mock recorder for MockglobalServiceDeployer.
+type MockglobalServiceDeployerMockRecorder struct {
+	mock *MockglobalServiceDeployer
+}
+
+// NewMockglobalServiceDeployer creates a new mock instance.
+func NewMockglobalServiceDeployer(ctrl *gomock.Controller) *MockglobalServiceDeployer {
+	mock := &MockglobalServiceDeployer{ctrl: ctrl}
+	mock.recorder = &MockglobalServiceDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockglobalServiceDeployer) EXPECT() *MockglobalServiceDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeleteGlobalService mocks base method.
+func (m *MockglobalServiceDeployer) DeleteGlobalService(app, env, svc string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalService", app, env, svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGlobalService indicates an expected call of DeleteGlobalService.
+func (mr *MockglobalServiceDeployerMockRecorder) DeleteGlobalService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalService", reflect.TypeOf((*MockglobalServiceDeployer)(nil).DeleteGlobalService), app, env, svc)
+}
+
+// DeployGlobalService mocks base method.
+func (m *MockglobalServiceDeployer) DeployGlobalService(out progress.FileWriter, in *deploy.CreateGlobalServiceInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployGlobalService", out, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployGlobalService indicates an expected call of DeployGlobalService.
+func (mr *MockglobalServiceDeployerMockRecorder) DeployGlobalService(out, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployGlobalService", reflect.TypeOf((*MockglobalServiceDeployer)(nil).DeployGlobalService), out, in)
+}
+
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
 // MockserviceForceUpdater is a mock of serviceForceUpdater interface.
 type MockserviceForceUpdater struct {
 	ctrl     *gomock.Controller
@@ -604,6 +737,44 @@ func (mr *MockspinnerMockRecorder) Stop(label interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*Mockspinner)(nil).Stop), label)
 }
 
+// MockpolicyEvaluator is a mock of policyEvaluator interface.
+type MockpolicyEvaluator struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicyEvaluatorMockRecorder
+}
+
+// MockpolicyEvaluatorMockRecorder is the mock recorder for MockpolicyEvaluator.
+type MockpolicyEvaluatorMockRecorder struct {
+	mock *MockpolicyEvaluator
+}
+
+// NewMockpolicyEvaluator creates a new mock instance.
+func NewMockpolicyEvaluator(ctrl *gomock.Controller) *MockpolicyEvaluator {
+	mock := &MockpolicyEvaluator{ctrl: ctrl}
+	mock.recorder = &MockpolicyEvaluatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpolicyEvaluator) EXPECT() *MockpolicyEvaluatorMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockpolicyEvaluator) Evaluate(in policy.Input) ([]policy.Violation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", in)
+	ret0, _ := ret[0].([]policy.Violation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockpolicyEvaluatorMockRecorder) Evaluate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockpolicyEvaluator)(nil).Evaluate), in)
+}
+
 // MockfileReader is a mock of fileReader interface.
 type MockfileReader struct {
 	ctrl     *gomock.Controller
@@ -642,6 +813,82 @@ func (mr *MockfileReaderMockRecorder) ReadFile(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockfileReader)(nil).ReadFile), arg0)
 }
 
+// MockpoliciesReader is a mock of policiesReader interface.
+type MockpoliciesReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockpoliciesReaderMockRecorder
+}
+
+// MockpoliciesReaderMockRecorder is the mock recorder for MockpoliciesReader.
+type MockpoliciesReaderMockRecorder struct {
+	mock *MockpoliciesReader
+}
+
+// NewMockpoliciesReader creates a new mock instance.
+func NewMockpoliciesReader(ctrl *gomock.Controller) *MockpoliciesReader {
+	mock := &MockpoliciesReader{ctrl: ctrl}
+	mock.recorder = &MockpoliciesReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpoliciesReader) EXPECT() *MockpoliciesReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadPolicies mocks base method.
+func (m *MockpoliciesReader) ReadPolicies() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPolicies")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPolicies indicates an expected call of ReadPolicies.
+func (mr *MockpoliciesReaderMockRecorder) ReadPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPolicies", reflect.TypeOf((*MockpoliciesReader)(nil).ReadPolicies))
+}
+
+// MockparameterGetter is a mock of parameterGetter interface.
+type MockparameterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockparameterGetterMockRecorder
+}
+
+// MockparameterGetterMockRecorder is the mock recorder for MockparameterGetter.
+type MockparameterGetterMockRecorder struct {
+	mock *MockparameterGetter
+}
+
+// NewMockparameterGetter creates a new mock instance.
+func NewMockparameterGetter(ctrl *gomock.Controller) *MockparameterGetter {
+	mock := &MockparameterGetter{ctrl: ctrl}
+	mock.recorder = &MockparameterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockparameterGetter) EXPECT() *MockparameterGetterMockRecorder {
+	return m.recorder
+}
+
+// GetParameter mocks base method.
+func (m *MockparameterGetter) GetParameter(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameter", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameter indicates an expected call of GetParameter.
+func (mr *MockparameterGetterMockRecorder) GetParameter(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameter", reflect.TypeOf((*MockparameterGetter)(nil).GetParameter), name)
+}
+
 // MocktimeoutError is a mock of timeoutError interface.
 type MocktimeoutError struct {
 	ctrl     *gomock.Controller
@@ -143,7 +143,7 @@ func (o *deleteEnvOpts) Ask() error {
 }
 
 // Execute deletes the environment from the application by:
-// 1. Deleting the cloudformation stack.
+// 1. Deleting the cloudformation stacks of the environment and its addons.
 // 2. Deleting the EnvManagerRole and CFNExecutionRole.
 // 3. Deleting the parameter from the SSM store.
 // The environment is removed from the store only if other delete operations succeed.
@@ -330,6 +330,10 @@ func (o *deleteEnvOpts) deleteStack() error {
 	if err != nil {
 		return err
 	}
+	// The environment addons stack imports values from the environment stack, so it has to be deleted first.
+	if err := o.deployer.DeleteEnvAddons(o.appName, o.name, env.ExecutionRoleARN); err != nil {
+		return fmt.Errorf("delete environment %s addons stack: %w", o.name, err)
+	}
 	if err := o.deployer.DeleteEnvironment(o.appName, o.name, env.ExecutionRoleARN); err != nil {
 		return fmt.Errorf("delete environment %s stack: %w", o.name, err)
 	}
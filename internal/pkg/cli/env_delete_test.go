@@ -279,6 +279,42 @@ Resources:
 			},
 			wantedError: errors.New("update environment stack to retain environment roles: some error"),
 		},
+		"returns wrapped error when the addons stack cannot be deleted": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any())
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return(`
+Resources:
+  CloudformationExecutionRole:
+    DeletionPolicy: Retain
+  EnvironmentManagerRole:
+    # An IAM Role to manage resources in your environment
+    DeletionPolicy: Retain`, nil)
+				deployer.EXPECT().DeleteEnvAddons(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+				prog.EXPECT().Stop(log.Serror("Failed to delete environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:                 rg,
+					deployer:           deployer,
+					prog:               prog,
+					envConfig:          &config.Environment{},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New("delete environment test addons stack: some error"),
+		},
 		"returns wrapped error when stack cannot be deleted": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				rg := mocks.NewMockresourceGetter(ctrl)
@@ -296,6 +332,7 @@ Resources:
   EnvironmentManagerRole:
     # An IAM Role to manage resources in your environment
     DeletionPolicy: Retain`, nil)
+				deployer.EXPECT().DeleteEnvAddons(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				deployer.EXPECT().DeleteEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
 
 				prog.EXPECT().Stop(log.Serror("Failed to delete environment test from application phonetool.\n"))
@@ -335,6 +372,7 @@ Resources:
     DeletionPolicy: Retain
     Type: AWS::IAM::Role
 `, nil)
+				deployer.EXPECT().DeleteEnvAddons("phonetool", "test", "execARN").Return(nil)
 				deployer.EXPECT().DeleteEnvironment("phonetool", "test", "execARN").Return(nil)
 
 				iam := mocks.NewMockroleDeleter(ctrl)
@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDeployAppNamePrompt = "In which application is your environment?"
+	envDeployNamePrompt    = "Which environment's addons would you like to deploy?"
+	envDeployNameHelp      = `The addons under copilot/environments/addons/ are deployed
+alongside the selected environment and can be shared by its workloads.`
+)
+
+type deployEnvVars struct {
+	appName string
+	name    string
+}
+
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store     store
+	sel       appEnvSelector
+	envAddons templater
+
+	// Cached variables.
+	targetEnv *config.Environment
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newEnvAddonsDeployer func(conf *config.Environment) (envAddonsDeployer, error)
+}
+
+func newDeployEnvOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env deploy"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+
+		store:     store,
+		sel:       selector.NewSelect(prompt.New(), store),
+		envAddons: envAddons,
+
+		newEnvAddonsDeployer: func(conf *config.Environment) (envAddonsDeployer, error) {
+			sess, err := sessProvider.FromRole(conf.ManagerRoleARN, conf.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", conf.ManagerRoleARN, conf.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if o.name != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *deployEnvOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(envDeployAppNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		env, err := o.sel.Environment(envDeployNamePrompt, envDeployNameHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.name = env
+	}
+	return nil
+}
+
+// Execute deploys the addons under copilot/environments/addons/ as a stack next to the environment stack.
+func (o *deployEnvOpts) Execute() error {
+	tpl, err := o.envAddons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("generate environment addons template: %w", err)
+		}
+		log.Infof("No addons found under %s, there is nothing to deploy.\n", color.HighlightResource("copilot/environments/addons/"))
+		return nil
+	}
+	env, err := o.getTargetEnv()
+	if err != nil {
+		return err
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	deployer, err := o.newEnvAddonsDeployer(env)
+	if err != nil {
+		return err
+	}
+	if err := deployer.DeployEnvAddons(os.Stderr, &deploy.CreateEnvAddonsInput{
+		App:               o.appName,
+		Env:               o.name,
+		Template:          tpl,
+		AdditionalTags:    app.Tags,
+		CFNServiceRoleARN: env.ExecutionRoleARN,
+	}); err != nil {
+		return fmt.Errorf("deploy addons of environment %s: %w", o.name, err)
+	}
+	log.Successf("Deployed the addons of environment %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// RecommendActions prints follow-up actions for the user.
+func (o *deployEnvOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("List the addons that a workload can access under %s in its manifest.", color.HighlightCode("storage.env_addons")),
+		fmt.Sprintf("Run %s to grant the workload access to the addons.", color.HighlightCode(fmt.Sprintf("copilot deploy -e %s", o.name))),
+	})
+	return nil
+}
+
+func (o *deployEnvOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	o.targetEnv = env
+	return env, nil
+}
+
+// buildEnvDeployCmd builds the command for deploying the addons of an environment.
+func buildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the addons shared by the workloads in an environment.",
+		Long: `Deploys the addons shared by the workloads in an environment.
+The CloudFormation templates under copilot/environments/addons/ are deployed as a stack next to the environment stack,
+and their outputs are exported so that workloads can list them under "storage.env_addons" in their manifest.`,
+		Example: `
+  Deploys the environment addons to the "test" environment.
+  /code $ copilot env deploy -n test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeployEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	return cmd
+}
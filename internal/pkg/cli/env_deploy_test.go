@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deployEnvMocks struct {
+	store     *mocks.Mockstore
+	sel       *mocks.MockappEnvSelector
+	envAddons *mocks.Mocktemplater
+	deployer  *mocks.MockenvAddonsDeployer
+}
+
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		setupMocks func(m *deployEnvMocks)
+
+		wantedErr error
+	}{
+		"skip validation if app name is not provided": {
+			setupMocks: func(m *deployEnvMocks) {},
+		},
+		"error if the application does not exist": {
+			inAppName: "phonetool",
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool configuration: some error"),
+		},
+		"error if the environment does not exist": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test configuration: some error"),
+		},
+		"success": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				store: m.store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		setupMocks func(m *deployEnvMocks)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"error if fail to select application": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.sel.EXPECT().Application(envDeployAppNamePrompt, "").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+		"error if fail to select environment": {
+			inAppName: "phonetool",
+			setupMocks: func(m *deployEnvMocks) {
+				m.sel.EXPECT().Environment(envDeployNamePrompt, envDeployNameHelp, "phonetool").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+		"prompt for both application and environment": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.sel.EXPECT().Application(envDeployAppNamePrompt, "").Return("phonetool", nil)
+				m.sel.EXPECT().Environment(envDeployNamePrompt, envDeployNameHelp, "phonetool").Return("test", nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"skip prompting if flags are provided": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			setupMocks:    func(m *deployEnvMocks) {},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployEnvMocks{
+				sel: mocks.NewMockappEnvSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.name)
+		})
+	}
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	mockEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		ExecutionRoleARN: "execARN",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *deployEnvMocks)
+
+		wantedErr error
+	}{
+		"no-op if there are no environment addons": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.envAddons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+			},
+		},
+		"error if fail to generate the addons template": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.envAddons.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("generate environment addons template: some error"),
+		},
+		"error if fail to deploy the addons": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.envAddons.EXPECT().Template().Return("Resources: {}", nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.deployer.EXPECT().DeployEnvAddons(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("deploy addons of environment test: some error"),
+		},
+		"deploys the addons with the execution role of the environment": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.envAddons.EXPECT().Template().Return("Resources: {}", nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Tags: map[string]string{"owner": "boss"},
+				}, nil)
+				m.deployer.EXPECT().DeployEnvAddons(gomock.Any(), &deploy.CreateEnvAddonsInput{
+					App:               "phonetool",
+					Env:               "test",
+					Template:          "Resources: {}",
+					AdditionalTags:    map[string]string{"owner": "boss"},
+					CFNServiceRoleARN: "execARN",
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployEnvMocks{
+				store:     mocks.NewMockstore(ctrl),
+				envAddons: mocks.NewMocktemplater(ctrl),
+				deployer:  mocks.NewMockenvAddonsDeployer(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:     m.store,
+				envAddons: m.envAddons,
+				newEnvAddonsDeployer: func(conf *config.Environment) (envAddonsDeployer, error) {
+					return m.deployer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
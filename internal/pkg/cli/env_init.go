@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -82,6 +83,13 @@ var (
 	envInitCustomizedEnvTypes             = []string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption, envInitImportEnvResourcesSelectOption}
 )
 
+const defaultEC2MaxSize = 5
+
+var (
+	ec2InstanceTypeRegexp    = regexp.MustCompile(`^[a-z][a-z0-9-]*\.[a-z0-9]+$`)
+	ec2GPUInstanceTypeRegexp = regexp.MustCompile(`^(p|g|inf|trn|dl|vt|f)\d`) // Accelerated computing families need a specialized AMI.
+)
+
 type importVPCVars struct {
 	ID               string
 	PublicSubnetIDs  []string
@@ -126,11 +134,34 @@ func (v tempCredsVars) isSet() bool {
 
 type telemetryVars struct {
 	EnableContainerInsights bool
+	EncryptLogs             bool
 }
 
 func (v telemetryVars) toConfig() *config.Telemetry {
 	return &config.Telemetry{
 		EnableContainerInsights: v.EnableContainerInsights,
+		EncryptLogs:             v.EncryptLogs,
+	}
+}
+
+type ec2CapacityVars struct {
+	InstanceType string
+	MinSize      int
+	MaxSize      int
+}
+
+func (v ec2CapacityVars) isSet() bool {
+	return v.InstanceType != ""
+}
+
+func (v ec2CapacityVars) toConfig() *config.EC2Capacity {
+	if !v.isSet() {
+		return nil
+	}
+	return &config.EC2Capacity{
+		InstanceType: v.InstanceType,
+		MinSize:      v.MinSize,
+		MaxSize:      v.MaxSize,
 	}
 }
 
@@ -141,9 +172,12 @@ type initEnvVars struct {
 	isProduction  bool   // True means retain resources even after deletion.
 	defaultConfig bool   // True means using default environment configuration.
 
-	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
-	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
-	telemetry telemetryVars // Configure observability and monitoring settings.
+	importVPC importVPCVars   // Existing VPC resources to use instead of creating new ones.
+	adjustVPC adjustVPCVars   // Configure parameters for VPC resources generated while initializing an environment.
+	telemetry telemetryVars   // Configure observability and monitoring settings.
+	ec2       ec2CapacityVars // Configure an Auto Scaling group capacity provider for the cluster.
+
+	serviceConnect bool // True means workloads can join the environment's ECS Service Connect namespace.
 
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
@@ -322,6 +356,8 @@ func (o *initEnvOpts) Execute() error {
 	env.Prod = o.isProduction
 	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
 	env.Telemetry = o.telemetry.toConfig()
+	env.EC2Capacity = o.ec2.toConfig()
+	env.ServiceConnect = o.serviceConnect
 
 	// 6. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
@@ -374,6 +410,31 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 			return errors.New("at least two availability zones must be provided to enable Load Balancing")
 		}
 	}
+	return o.validateEC2Capacity()
+}
+
+func (o *initEnvOpts) validateEC2Capacity() error {
+	if !o.ec2.isSet() {
+		return nil
+	}
+	if !ec2InstanceTypeRegexp.MatchString(o.ec2.InstanceType) {
+		return fmt.Errorf("instance type %s is not valid, for example %s", o.ec2.InstanceType, "t3.medium")
+	}
+	if ec2GPUInstanceTypeRegexp.MatchString(o.ec2.InstanceType) {
+		return fmt.Errorf("instance type %s is an accelerated computing instance type which is not supported by the EC2 capacity provider", o.ec2.InstanceType)
+	}
+	if o.ec2.MinSize < 0 {
+		return fmt.Errorf("--%s must be a non-negative number", ec2MinSizeFlag)
+	}
+	if o.ec2.MaxSize < 1 {
+		return fmt.Errorf("--%s must be at least 1", ec2MaxSizeFlag)
+	}
+	if o.ec2.MinSize > o.ec2.MaxSize {
+		return fmt.Errorf("--%s %d cannot be greater than --%s %d", ec2MinSizeFlag, o.ec2.MinSize, ec2MaxSizeFlag, o.ec2.MaxSize)
+	}
+	if o.importVPC.isSet() && len(o.importVPC.PublicSubnetIDs) == 0 && len(o.importVPC.PrivateSubnetIDs) == 0 {
+		return errors.New("subnets must be imported to launch the EC2 capacity provider's instances")
+	}
 	return nil
 }
 
@@ -691,6 +752,8 @@ func (o *initEnvOpts) deployEnv(app *config.Application,
 			Name:                o.appName,
 			DNSName:             app.Domain,
 			AccountPrincipalARN: caller.RootUserARN,
+			PermissionsBoundary: app.PermissionsBoundary,
+			RolePath:            app.RolePath,
 		},
 		Prod:                 o.isProduction,
 		AdditionalTags:       app.Tags,
@@ -700,6 +763,8 @@ func (o *initEnvOpts) deployEnv(app *config.Application,
 		AdjustVPCConfig:      o.adjustVPCConfig(),
 		ImportVPCConfig:      o.importVPCConfig(),
 		Telemetry:            o.telemetry.toConfig(),
+		EC2Capacity:          o.ec2.toConfig(),
+		ServiceConnect:       o.serviceConnect,
 		Version:              deploy.LatestEnvTemplateVersion,
 	}
 
@@ -811,6 +876,12 @@ func buildEnvInitCmd() *cobra.Command {
   Creates a prod-iad environment using your "prod-admin" AWS profile and enables container insights.
   /code $ copilot env init --name prod-iad --profile prod-admin --container-insights
 
+  Creates an environment with a cluster that can also place tasks on up to 10 ARM-based EC2 instances.
+  /code $ copilot env init --name prod --profile default --ec2-instance-type t4g.large --ec2-max-size 10
+
+  Creates an environment whose services can talk to each other with ECS Service Connect.
+  /code $ copilot env init --name test --profile default --default-config --service-connect
+
   Creates an environment with imported VPC resources.
   /code $ copilot env init --import-vpc-id vpc-099c32d2b98cdcf47 \
   /code --import-public-subnets subnet-013e8b691862966cf,subnet-014661ebb7ab8681a \
@@ -839,6 +910,11 @@ func buildEnvInitCmd() *cobra.Command {
 
 	cmd.Flags().BoolVar(&vars.isProduction, prodEnvFlag, false, prodEnvFlagDescription) // Deprecated. Use telemetry flags instead.
 	cmd.Flags().BoolVar(&vars.telemetry.EnableContainerInsights, enableContainerInsightsFlag, false, enableContainerInsightsFlagDescription)
+	cmd.Flags().BoolVar(&vars.telemetry.EncryptLogs, encryptLogsFlag, false, encryptLogsFlagDescription)
+
+	cmd.Flags().StringVar(&vars.ec2.InstanceType, ec2InstanceTypeFlag, "", ec2InstanceTypeFlagDescription)
+	cmd.Flags().IntVar(&vars.ec2.MinSize, ec2MinSizeFlag, 0, ec2MinSizeFlagDescription)
+	cmd.Flags().IntVar(&vars.ec2.MaxSize, ec2MaxSizeFlag, defaultEC2MaxSize, ec2MaxSizeFlagDescription)
 
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
@@ -850,6 +926,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, overridePublicSubnetCIDRsFlag, nil, overridePublicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, overridePrivateSubnetCIDRsFlag, nil, overridePrivateSubnetCIDRsFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.serviceConnect, serviceConnectFlag, false, serviceConnectFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -871,17 +948,25 @@ func buildEnvInitCmd() *cobra.Command {
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overrideAZsFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overridePublicSubnetCIDRsFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overridePrivateSubnetCIDRsFlag))
+	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(serviceConnectFlag))
 
 	telemetryFlags := pflag.NewFlagSet("Telemetry", pflag.ContinueOnError)
 	telemetryFlags.AddFlag(cmd.Flags().Lookup(enableContainerInsightsFlag))
+	telemetryFlags.AddFlag(cmd.Flags().Lookup(encryptLogsFlag))
+
+	capacityFlags := pflag.NewFlagSet("EC2 Capacity", pflag.ContinueOnError)
+	capacityFlags.AddFlag(cmd.Flags().Lookup(ec2InstanceTypeFlag))
+	capacityFlags.AddFlag(cmd.Flags().Lookup(ec2MinSizeFlag))
+	capacityFlags.AddFlag(cmd.Flags().Lookup(ec2MaxSizeFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                    "Common,Import Existing Resources,Configure Default Resources,Telemetry",
+		"sections":                    "Common,Import Existing Resources,Configure Default Resources,Telemetry,EC2 Capacity",
 		"Common":                      flags.FlagUsages(),
 		"Import Existing Resources":   resourcesImportFlags.FlagUsages(),
 		"Configure Default Resources": resourcesConfigFlags.FlagUsages(),
 		"Telemetry":                   telemetryFlags.FlagUsages(),
+		"EC2 Capacity":                capacityFlags.FlagUsages(),
 	}
 
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
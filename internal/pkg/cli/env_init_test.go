@@ -58,6 +58,8 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inSecretAccessKey string
 		inSessionToken    string
 
+		inEC2 ec2CapacityVars
+
 		setupMocks func(m initEnvMocks)
 
 		wantedErrMsg string
@@ -169,6 +171,41 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			inPublicIDs:  []string{"mockID", "anotherMockID", "yetAnotherMockID"},
 			inPrivateIDs: []string{"mockID", "anotherMockID"},
 		},
+		"invalid EC2 instance type": {
+			inEC2: ec2CapacityVars{
+				InstanceType: "large",
+				MaxSize:      5,
+			},
+			wantedErrMsg: "instance type large is not valid, for example t3.medium",
+		},
+		"accelerated computing EC2 instance type": {
+			inEC2: ec2CapacityVars{
+				InstanceType: "g4dn.xlarge",
+				MaxSize:      5,
+			},
+			wantedErrMsg: "instance type g4dn.xlarge is an accelerated computing instance type which is not supported by the EC2 capacity provider",
+		},
+		"EC2 min size greater than max size": {
+			inEC2: ec2CapacityVars{
+				InstanceType: "t4g.medium",
+				MinSize:      3,
+				MaxSize:      2,
+			},
+			wantedErrMsg: "--ec2-min-size 3 cannot be greater than --ec2-max-size 2",
+		},
+		"EC2 max size less than one": {
+			inEC2: ec2CapacityVars{
+				InstanceType: "t4g.medium",
+			},
+			wantedErrMsg: "--ec2-max-size must be at least 1",
+		},
+		"valid EC2 capacity": {
+			inEC2: ec2CapacityVars{
+				InstanceType: "m6i.large",
+				MinSize:      1,
+				MaxSize:      5,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -204,6 +241,7 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						SecretAccessKey: tc.inSecretAccessKey,
 						SessionToken:    tc.inSessionToken,
 					},
+					ec2: tc.inEC2,
 				},
 				store: m.store,
 			}
@@ -271,9 +271,9 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 							EnableContainerInsights: true,
 						},
 					}, nil)
-				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", PermissionsBoundary: "MyBoundary", RolePath: "/copilot/"}, nil)
 				mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
-				mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+				mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool", PermissionsBoundary: "MyBoundary", RolePath: "/copilot/"}, "us-west-2").
 					Return(&stack.AppRegionalResources{
 						S3Bucket:  "mockBucket",
 						KMSKeyARN: "mockKMS",
@@ -285,7 +285,9 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
 					Version: deploy.LatestEnvTemplateVersion,
 					App: deploy.AppInformation{
-						Name: "phonetool",
+						Name:                "phonetool",
+						PermissionsBoundary: "MyBoundary",
+						RolePath:            "/copilot/",
 					},
 					Name: "test",
 					ImportVPCConfig: &config.ImportVPC{
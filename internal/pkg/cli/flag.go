@@ -18,6 +18,7 @@ const (
 	appFlag        = "app"
 	envFlag        = "env"
 	workloadFlag   = "workload"
+	workloadsFlag  = "workloads"
 	svcTypeFlag    = "svc-type"
 	jobTypeFlag    = "job-type"
 	typeFlag       = "type"
@@ -27,45 +28,63 @@ const (
 	allFlag        = "all"
 	forceFlag      = "force"
 	noRollbackFlag = "no-rollback"
+	fromEnvFlag    = "from-env"
 	// Command specific flags.
-	dockerFileFlag        = "dockerfile"
-	dockerFileContextFlag = "build-context"
-	imageTagFlag          = "tag"
-	resourceTagsFlag      = "resource-tags"
-	stackOutputDirFlag    = "output-dir"
-	uploadAssetsFlag      = "upload-assets"
-	limitFlag             = "limit"
-	followFlag            = "follow"
-	sinceFlag             = "since"
-	startTimeFlag         = "start-time"
-	endTimeFlag           = "end-time"
-	tasksFlag             = "tasks"
-	logGroupFlag          = "log-group"
-	prodEnvFlag           = "prod"
-	deployFlag            = "deploy"
-	resourcesFlag         = "resources"
-	githubURLFlag         = "github-url"
-	repoURLFlag           = "url"
-	githubAccessTokenFlag = "github-access-token"
-	gitBranchFlag         = "git-branch"
-	envsFlag              = "environments"
-	domainNameFlag        = "domain"
-	localFlag             = "local"
-	deleteSecretFlag      = "delete-secret"
-	svcPortFlag           = "port"
+	dockerFileFlag          = "dockerfile"
+	dockerFileContextFlag   = "build-context"
+	imageTagFlag            = "tag"
+	resourceTagsFlag        = "resource-tags"
+	stackOutputDirFlag      = "output-dir"
+	uploadAssetsFlag        = "upload-assets"
+	limitFlag               = "limit"
+	followFlag              = "follow"
+	watchFlag               = "watch"
+	sinceFlag               = "since"
+	startTimeFlag           = "start-time"
+	endTimeFlag             = "end-time"
+	tasksFlag               = "tasks"
+	logGroupFlag            = "log-group"
+	logFilterFlag           = "filter"
+	logQueryFlag            = "query"
+	allEnvsFlag             = "all-envs"
+	prodEnvFlag             = "prod"
+	deployFlag              = "deploy"
+	resourcesFlag           = "resources"
+	githubURLFlag           = "github-url"
+	repoURLFlag             = "url"
+	githubAccessTokenFlag   = "github-access-token"
+	gitBranchFlag           = "git-branch"
+	envsFlag                = "environments"
+	domainNameFlag          = "domain"
+	permissionsBoundaryFlag = "permissions-boundary"
+	rolePathFlag            = "role-path"
+	templateOverridesFlag   = "template-overrides"
+	policiesParameterFlag   = "policies-parameter"
+	localFlag               = "local"
+	deleteSecretFlag        = "delete-secret"
+	svcPortFlag             = "port"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
 
-	storageTypeFlag              = "storage-type"
-	storagePartitionKeyFlag      = "partition-key"
-	storageSortKeyFlag           = "sort-key"
-	storageNoSortFlag            = "no-sort"
-	storageLSIConfigFlag         = "lsi"
-	storageNoLSIFlag             = "no-lsi"
-	storageRDSEngineFlag         = "engine"
-	storageRDSInitialDBFlag      = "initial-db"
-	storageRDSParameterGroupFlag = "parameter-group"
+	storageTypeFlag                 = "storage-type"
+	storagePartitionKeyFlag         = "partition-key"
+	storageSortKeyFlag              = "sort-key"
+	storageNoSortFlag               = "no-sort"
+	storageLSIConfigFlag            = "lsi"
+	storageNoLSIFlag                = "no-lsi"
+	storageRDSEngineFlag            = "engine"
+	storageRDSInitialDBFlag         = "initial-db"
+	storageRDSParameterGroupFlag    = "parameter-group"
+	storageRDSServerlessVersionFlag = "serverless-version"
+	storageRDSMinCapacityFlag       = "min-capacity"
+	storageRDSMaxCapacityFlag       = "max-capacity"
+	storageRDSInstanceClassFlag     = "instance-class"
+	storageRDSReadersFlag           = "readers"
+	storageRDSSnapshotFlag          = "snapshot"
+	storageRDSRotateSecretFlag      = "rotate-secret"
+	storageSQSFIFOFlag              = "fifo"
+	storageScopeFlag                = "scope"
 
 	taskGroupNameFlag            = "task-group-name"
 	countFlag                    = "count"
@@ -97,6 +116,13 @@ const (
 	overridePrivateSubnetCIDRsFlag = "override-private-cidrs"
 
 	enableContainerInsightsFlag = "container-insights"
+	encryptLogsFlag             = "encrypt-logs"
+
+	ec2InstanceTypeFlag = "ec2-instance-type"
+	ec2MinSizeFlag      = "ec2-min-size"
+	ec2MaxSizeFlag      = "ec2-max-size"
+
+	serviceConnectFlag = "service-connect"
 
 	defaultConfigFlag = "default-config"
 
@@ -111,11 +137,21 @@ const (
 
 	taskIDFlag    = "task-id"
 	containerFlag = "container"
+	noTTYFlag     = "no-tty"
+	allTasksFlag  = "all-tasks"
+
+	localPortFlag  = "local-port"
+	remotePortFlag = "remote-port"
+	remoteHostFlag = "remote-host"
 
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
 
+	secretStoreFlag    = "store"
+	rotationLambdaFlag = "rotation-lambda"
+	rotationDaysFlag   = "rotation-days"
+
 	includeStateMachineLogsFlag = "include-state-machine"
 )
 
@@ -183,6 +219,15 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
+	secretStoreFlagDescription = fmt.Sprintf(`Optional. Where to store the secret. Defaults to "%s".
+Must be one of: %s.`, secretStoreSSM, strings.Join(secretStores, ", "))
+	secretRotationLambdaFlagDescription = fmt.Sprintf(`Optional. ARN of the Lambda function that rotates the secret.
+Your default credentials must be allowed to invoke the function.
+Can only be specified with --%s %s.`, secretStoreFlag, secretStoreSecretsManager)
+	existingSecretNameFlagDescription = "Name of the secret."
+	secretEnvFlagDescription          = "Optional. Name of the environment. Defaults to all environments."
+	secretRotationDaysFlagDescription = fmt.Sprintf(`Optional. Number of days between automatic rotations of the secret.
+Must be specified with --%s. Defaults to %d.`, rotationLambdaFlag, defaultSecretRotationDays)
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
 Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
@@ -206,6 +251,9 @@ rollback in case of deployment failure.
 We do not recommend using this flag for a
 production environment.`
 
+	fromEnvFlagDescription = `Optional. Promote the image deployed in this environment
+instead of building the image from the Dockerfile.`
+
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
 Allows you to categorize resources.`
@@ -226,13 +274,31 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
-
-	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
-	githubURLFlagDescription         = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
-	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
-	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
-	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
-	domainNameFlagDescription        = "Optional. Your existing custom domain name."
+	logFilterFlagDescription               = `Optional. Only return log events that match a CloudWatch Logs filter pattern.
+For example, "ERROR" or '{ $.level = "error" }'.`
+	logContainerFlagDescription = `Optional. Only return logs from a specific container, like a sidecar.
+By default logs from all containers are returned.`
+	logWorkloadsFlagDescription = `Optional. Names of the services or jobs to show logs from.
+Defaults to all workloads deployed in the environments.`
+	logEnvsFlagDescription    = "Names of the environments to show logs from."
+	logAllEnvsFlagDescription = "Optional. Show logs from all the environments of the application."
+	logQueryFlagDescription   = `Optional. Run a CloudWatch Logs Insights query against the log group instead.
+Defaults to the last hour unless any time filtering flags are set.`
+
+	deployTestFlagDescription          = `Deploy your service or job to a "test" environment.`
+	githubURLFlagDescription           = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
+	githubAccessTokenFlagDescription   = "GitHub personal access token for your repository."
+	gitBranchFlagDescription           = "Branch used to trigger your pipeline."
+	pipelineEnvsFlagDescription        = "Environments to add to the pipeline."
+	domainNameFlagDescription          = "Optional. Your existing custom domain name."
+	permissionsBoundaryFlagDescription = `Optional. The name or path of an IAM policy to use as the permissions boundary
+for all IAM roles created by Copilot in the application.`
+	rolePathFlagDescription = `Optional. The IAM path, such as /copilot/, under which Copilot
+creates the IAM roles of the application.`
+	templateOverridesFlagDescription = `Optional. Path to a directory, relative to the workspace root,
+of CloudFormation partials that replace the ones Copilot renders in service and job templates.`
+	policiesParameterFlagDescription = `Optional. Name of an SSM parameter with the policy rules
+that every service and job in the application is checked against before it's deployed.`
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
@@ -257,8 +323,26 @@ Must be of the format '<keyName>:<dataType>'.`
 Must be of the format '<keyName>:<dataType>'.`
 	storageRDSEngineFlagDescription = `The database engine used in the cluster.
 Must be either "MySQL" or "PostgreSQL".`
-	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
-	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageRDSInitialDBFlagDescription         = "The initial database to create in the cluster."
+	storageRDSParameterGroupFlagDescription    = "Optional. The name of the parameter group to associate with the cluster."
+	storageRDSServerlessVersionFlagDescription = `Optional. The version of Aurora Serverless. Defaults to "v1".
+Must be either "v1" or "v2".`
+	storageRDSMinCapacityFlagDescription = `Optional. The minimum capacity in Aurora capacity units (ACUs) of an Aurora Serverless v2 cluster.
+Must be between 0.5 and 128 in increments of 0.5. (default 0.5)`
+	storageRDSMaxCapacityFlagDescription = `Optional. The maximum capacity in Aurora capacity units (ACUs) of an Aurora Serverless v2 cluster.
+Must be between 0.5 and 128 in increments of 0.5. (default 8)`
+	storageRDSInstanceClassFlagDescription = `Optional. The DB instance class of a provisioned cluster, for example "db.r6g.large".
+Cannot be specified with --serverless-version.`
+	storageRDSReadersFlagDescription = `Optional. The number of reader instances in a provisioned or Aurora Serverless v2 cluster.
+Must be between 0 and 15.`
+	storageRDSSnapshotFlagDescription = `Optional. The identifier or ARN of the DB cluster snapshot to restore the cluster from.
+Cannot be specified with --rotate-secret.`
+	storageRDSRotateSecretFlagDescription = `Optional. Rotate the credentials of the cluster every 30 days with a Secrets Manager managed function.
+Cannot be specified with --snapshot.`
+	storageSQSFIFOFlagDescription = "Optional. Create a first-in-first-out (FIFO) queue instead of a standard queue."
+	storageScopeFlagDescription   = `Optional. Scope of the storage resource. Defaults to "workload".
+Must be either "workload" or "env". Storage with the "env" scope is deployed
+once per environment and can be shared by multiple workloads.`
 
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
@@ -292,6 +376,16 @@ Cannot be specified with any other flags.`
 (default 10.0.2.0/24,10.0.3.0/24)`
 
 	enableContainerInsightsFlagDescription = "Optional. Enable CloudWatch Container Insights."
+	encryptLogsFlagDescription             = `Optional. Encrypt the log groups of your services and jobs with a KMS key created by the environment.
+Can only be set when the environment is created.`
+
+	ec2InstanceTypeFlagDescription = `Optional. Instance type of the EC2 capacity provider for the cluster.
+Graviton instance types, like t4g.medium, launch ARM instances.`
+	ec2MinSizeFlagDescription = "Optional. Minimum number of instances of the EC2 capacity provider."
+	ec2MaxSizeFlagDescription = "Optional. Maximum number of instances of the EC2 capacity provider."
+
+	serviceConnectFlagDescription = `Optional. Use the environment's service discovery namespace for ECS Service Connect.
+Services opt in with "network.connect" in their manifest.`
 
 	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
 
@@ -314,6 +408,17 @@ are also accepted.`
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	noTTYFlagDescription       = `Optional. Run the command non-interactively and print its output prefixed with the task ID.
+Exits with a non-zero code if the command fails in any task.`
+	allTasksFlagDescription = "Optional. Run the command in all running tasks of the service in parallel. Requires --no-tty."
+
+	svcStatusWatchFlagDescription = `Optional. Refresh the status in place until interrupted,
+highlighting task transitions and recent service events.`
+
+	localPortFlagDescription  = "Optional. The port on your machine to listen on. Defaults to the remote port."
+	remotePortFlagDescription = "The port in the container, or on the remote host, to forward traffic to."
+	remoteHostFlagDescription = `Optional. A host reachable from the task to forward traffic to, like a database endpoint.
+By default traffic is forwarded to the container.`
 
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
 )
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"context"
 	"encoding"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"io"
@@ -20,7 +21,9 @@ import (
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -148,6 +151,11 @@ type deployedEnvironmentLister interface {
 	ListSNSTopics(appName string, envName string) ([]deploy.Topic, error)
 }
 
+type deployedWorkloadsLister interface {
+	ListDeployedServices(appName, envName string) ([]string, error)
+	ListDeployedJobs(appName, envName string) ([]string, error)
+}
+
 // Secretsmanager interface.
 
 type secretsManager interface {
@@ -267,6 +275,11 @@ type wlLister interface {
 	ListWorkloads() ([]string, error)
 }
 
+type wsWlManifestReader interface {
+	wlLister
+	manifestReader
+}
+
 type wsJobDirReader interface {
 	wsJobReader
 	workspacePathGetter
@@ -295,10 +308,12 @@ type wsPipelineGetter interface {
 type wsAppManager interface {
 	Create(appName string) error
 	Summary() (*workspace.Summary, error)
+	Path() (string, error)
 }
 
 type wsAddonManager interface {
 	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	WriteEnvAddon(f encoding.BinaryMarshaler, name string) (string, error)
 	manifestReader
 	wlLister
 }
@@ -322,17 +337,23 @@ type bucketEmptier interface {
 type environmentDeployer interface {
 	DeployAndRenderEnvironment(out termprogress.FileWriter, env *deploy.CreateEnvironmentInput) error
 	DeleteEnvironment(appName, envName, cfnExecRoleARN string) error
+	DeleteEnvAddons(appName, envName, cfnExecRoleARN string) error
 	GetEnvironment(appName, envName string) (*config.Environment, error)
 	EnvironmentTemplate(appName, envName string) (string, error)
 	UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error
 }
 
+type envAddonsDeployer interface {
+	DeployEnvAddons(out termprogress.FileWriter, in *deploy.CreateEnvAddonsInput) error
+}
+
 type wlDeleter interface {
 	DeleteWorkload(in deploy.DeleteWorkloadInput) error
 }
 
 type svcRemoverFromApp interface {
 	RemoveServiceFromApp(app *config.Application, svcName string) error
+	DeleteGlobalService(app, env, svc string) error
 }
 
 type jobRemoverFromApp interface {
@@ -399,6 +420,10 @@ type domainInfoGetter interface {
 	IsRegisteredDomain(domainName string) error
 }
 
+type parameterGetter interface {
+	GetParameter(name string) (string, error)
+}
+
 type dockerfileParser interface {
 	GetExposedPorts() ([]dockerfile.Port, error)
 	GetHealthCheck() (*dockerfile.HealthCheck, error)
@@ -408,6 +433,11 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type statusWatcher interface {
+	termprogress.DynamicRenderer
+	Watch(ctx context.Context) error
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
@@ -544,6 +574,10 @@ type apprunnerServiceDescriber interface {
 	ServiceARN() (string, error)
 }
 
+type portForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) error
+}
+
 type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
@@ -602,6 +636,28 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretsManagerSecretPutter interface {
+	PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error)
+}
+
+type secretRotator interface {
+	ConfigureRotation(secretName string, conf secretsmanager.RotationConfig) error
+}
+
+type taggedResourceGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
+}
+
+type ssmSecretDescribeDeleter interface {
+	DescribeSecret(name string) (*ssm.SecretMetadata, error)
+	secretDeleter
+}
+
+type secretsManagerSecretDescribeDeleter interface {
+	DescribeSecret(secretName string) (*secretsmanager.SecretMetadata, error)
+	secretDeleter
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
@@ -612,9 +668,14 @@ type interpolator interface {
 
 type workloadDeployer interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
+	PromoteArtifacts(in *clideploy.PromoteArtifactsInput) (*clideploy.UploadArtifactsOutput, error)
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 }
 
+type deployedImageDescriber interface {
+	Image() (string, error)
+}
+
 type workloadTemplateGenerator interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
 	GenerateCloudFormationTemplate(in *clideploy.GenerateCloudFormationTemplateInput) (
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	encoding "encoding"
 	io "io"
 	reflect "reflect"
@@ -14,7 +15,9 @@ import (
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	deploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
@@ -1329,6 +1332,59 @@ func (mr *MockdeployedEnvironmentListerMockRecorder) ListSNSTopics(appName, envN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSNSTopics", reflect.TypeOf((*MockdeployedEnvironmentLister)(nil).ListSNSTopics), appName, envName)
 }
 
+// MockdeployedWorkloadsLister is a mock of deployedWorkloadsLister interface.
+type MockdeployedWorkloadsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedWorkloadsListerMockRecorder
+}
+
+// MockdeployedWorkloadsListerMockRecorder is the mock recorder for MockdeployedWorkloadsLister.
+type MockdeployedWorkloadsListerMockRecorder struct {
+	mock *MockdeployedWorkloadsLister
+}
+
+// NewMockdeployedWorkloadsLister creates a new mock instance.
+func NewMockdeployedWorkloadsLister(ctrl *gomock.Controller) *MockdeployedWorkloadsLister {
+	mock := &MockdeployedWorkloadsLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedWorkloadsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedWorkloadsLister) EXPECT() *MockdeployedWorkloadsListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedJobs mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedJobs), appName, envName)
+}
+
+// ListDeployedServices mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedServices(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedServices", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedServices indicates an expected call of ListDeployedServices.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedServices(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedServices", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedServices), appName, envName)
+}
+
 // MocksecretsManager is a mock of secretsManager interface.
 type MocksecretsManager struct {
 	ctrl     *gomock.Controller
@@ -2489,6 +2545,59 @@ func (mr *MockwlListerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwlLister)(nil).ListWorkloads))
 }
 
+// MockwsWlManifestReader is a mock of wsWlManifestReader interface.
+type MockwsWlManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWlManifestReaderMockRecorder
+}
+
+// MockwsWlManifestReaderMockRecorder is the mock recorder for MockwsWlManifestReader.
+type MockwsWlManifestReaderMockRecorder struct {
+	mock *MockwsWlManifestReader
+}
+
+// NewMockwsWlManifestReader creates a new mock instance.
+func NewMockwsWlManifestReader(ctrl *gomock.Controller) *MockwsWlManifestReader {
+	mock := &MockwsWlManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWlManifestReader) EXPECT() *MockwsWlManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsWlManifestReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWlManifestReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWlManifestReader)(nil).ListWorkloads))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadWorkloadManifest), name)
+}
+
 // MockwsJobDirReader is a mock of wsJobDirReader interface.
 type MockwsJobDirReader struct {
 	ctrl     *gomock.Controller
@@ -2888,6 +2997,21 @@ func (mr *MockwsAppManagerMockRecorder) Create(appName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockwsAppManager)(nil).Create), appName)
 }
 
+// Path mocks base method.
+func (m *MockwsAppManager) Path() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsAppManagerMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsAppManager)(nil).Path))
+}
+
 // Summary mocks base method.
 func (m *MockwsAppManager) Summary() (*workspace.Summary, error) {
 	m.ctrl.T.Helper()
@@ -2971,6 +3095,21 @@ func (mr *MockwsAddonManagerMockRecorder) WriteAddon(f, svc, name interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteAddon), f, svc, name)
 }
 
+// WriteEnvAddon mocks base method.
+func (m *MockwsAddonManager) WriteEnvAddon(f encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvAddon", f, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvAddon indicates an expected call of WriteEnvAddon.
+func (mr *MockwsAddonManagerMockRecorder) WriteEnvAddon(f, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteEnvAddon), f, name)
+}
+
 // Mockuploader is a mock of uploader interface.
 type Mockuploader struct {
 	ctrl     *gomock.Controller
@@ -3157,6 +3296,20 @@ func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder
 	return m.recorder
 }
 
+// DeleteEnvAddons mocks base method.
+func (m *MockenvironmentDeployer) DeleteEnvAddons(appName, envName, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvAddons", appName, envName, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvAddons indicates an expected call of DeleteEnvAddons.
+func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvAddons(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvAddons", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvAddons), appName, envName, cfnExecRoleARN)
+}
+
 // DeleteEnvironment mocks base method.
 func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
 	m.ctrl.T.Helper()
@@ -3229,6 +3382,43 @@ func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironmentTemplate(appName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
 }
 
+// MockenvAddonsDeployer is a mock of envAddonsDeployer interface.
+type MockenvAddonsDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvAddonsDeployerMockRecorder
+}
+
+// MockenvAddonsDeployerMockRecorder is the mock recorder for MockenvAddonsDeployer.
+type MockenvAddonsDeployerMockRecorder struct {
+	mock *MockenvAddonsDeployer
+}
+
+// NewMockenvAddonsDeployer creates a new mock instance.
+func NewMockenvAddonsDeployer(ctrl *gomock.Controller) *MockenvAddonsDeployer {
+	mock := &MockenvAddonsDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvAddonsDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvAddonsDeployer) EXPECT() *MockenvAddonsDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvAddons mocks base method.
+func (m *MockenvAddonsDeployer) DeployEnvAddons(out progress.FileWriter, in *deploy0.CreateEnvAddonsInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvAddons", out, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvAddons indicates an expected call of DeployEnvAddons.
+func (mr *MockenvAddonsDeployerMockRecorder) DeployEnvAddons(out, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvAddons", reflect.TypeOf((*MockenvAddonsDeployer)(nil).DeployEnvAddons), out, in)
+}
+
 // MockwlDeleter is a mock of wlDeleter interface.
 type MockwlDeleter struct {
 	ctrl     *gomock.Controller
@@ -3289,6 +3479,20 @@ func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
 	return m.recorder
 }
 
+// DeleteGlobalService mocks base method.
+func (m *MocksvcRemoverFromApp) DeleteGlobalService(app, env, svc string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalService", app, env, svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGlobalService indicates an expected call of DeleteGlobalService.
+func (mr *MocksvcRemoverFromAppMockRecorder) DeleteGlobalService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalService", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).DeleteGlobalService), app, env, svc)
+}
+
 // RemoveServiceFromApp mocks base method.
 func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	m.ctrl.T.Helper()
@@ -3952,6 +4156,20 @@ func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
 }
 
+// DeleteEnvAddons mocks base method.
+func (m *Mockdeployer) DeleteEnvAddons(appName, envName, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvAddons", appName, envName, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvAddons indicates an expected call of DeleteEnvAddons.
+func (mr *MockdeployerMockRecorder) DeleteEnvAddons(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvAddons", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvAddons), appName, envName, cfnExecRoleARN)
+}
+
 // DeleteEnvironment mocks base method.
 func (m *Mockdeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
 	m.ctrl.T.Helper()
@@ -4201,6 +4419,44 @@ func (mr *MockdomainInfoGetterMockRecorder) IsRegisteredDomain(domainName interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRegisteredDomain", reflect.TypeOf((*MockdomainInfoGetter)(nil).IsRegisteredDomain), domainName)
 }
 
+// MockparameterGetter is a mock of parameterGetter interface.
+type MockparameterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockparameterGetterMockRecorder
+}
+
+// MockparameterGetterMockRecorder is the mock recorder for MockparameterGetter.
+type MockparameterGetterMockRecorder struct {
+	mock *MockparameterGetter
+}
+
+// NewMockparameterGetter creates a new mock instance.
+func NewMockparameterGetter(ctrl *gomock.Controller) *MockparameterGetter {
+	mock := &MockparameterGetter{ctrl: ctrl}
+	mock.recorder = &MockparameterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockparameterGetter) EXPECT() *MockparameterGetterMockRecorder {
+	return m.recorder
+}
+
+// GetParameter mocks base method.
+func (m *MockparameterGetter) GetParameter(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameter", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameter indicates an expected call of GetParameter.
+func (mr *MockparameterGetterMockRecorder) GetParameter(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameter", reflect.TypeOf((*MockparameterGetter)(nil).GetParameter), name)
+}
+
 // MockdockerfileParser is a mock of dockerfileParser interface.
 type MockdockerfileParser struct {
 	ctrl     *gomock.Controller
@@ -4292,6 +4548,72 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockstatusWatcher is a mock of statusWatcher interface.
+type MockstatusWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatusWatcherMockRecorder
+}
+
+// MockstatusWatcherMockRecorder is the mock recorder for MockstatusWatcher.
+type MockstatusWatcherMockRecorder struct {
+	mock *MockstatusWatcher
+}
+
+// NewMockstatusWatcher creates a new mock instance.
+func NewMockstatusWatcher(ctrl *gomock.Controller) *MockstatusWatcher {
+	mock := &MockstatusWatcher{ctrl: ctrl}
+	mock.recorder = &MockstatusWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatusWatcher) EXPECT() *MockstatusWatcherMockRecorder {
+	return m.recorder
+}
+
+// Done mocks base method.
+func (m *MockstatusWatcher) Done() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Done")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// Done indicates an expected call of Done.
+func (mr *MockstatusWatcherMockRecorder) Done() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MockstatusWatcher)(nil).Done))
+}
+
+// Render mocks base method.
+func (m *MockstatusWatcher) Render(out io.Writer) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", out)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockstatusWatcherMockRecorder) Render(out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockstatusWatcher)(nil).Render), out)
+}
+
+// Watch mocks base method.
+func (m *MockstatusWatcher) Watch(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockstatusWatcherMockRecorder) Watch(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockstatusWatcher)(nil).Watch), ctx)
+}
+
 // MockenvDescriber is a mock of envDescriber interface.
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -5767,6 +6089,43 @@ func (mr *MockapprunnerServiceDescriberMockRecorder) ServiceARN() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockapprunnerServiceDescriber)(nil).ServiceARN))
 }
 
+// MockportForwarder is a mock of portForwarder interface.
+type MockportForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockportForwarderMockRecorder
+}
+
+// MockportForwarderMockRecorder is the mock recorder for MockportForwarder.
+type MockportForwarderMockRecorder struct {
+	mock *MockportForwarder
+}
+
+// NewMockportForwarder creates a new mock instance.
+func NewMockportForwarder(ctrl *gomock.Controller) *MockportForwarder {
+	mock := &MockportForwarder{ctrl: ctrl}
+	mock.recorder = &MockportForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockportForwarder) EXPECT() *MockportForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockportForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockportForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockportForwarder)(nil).StartPortForwardingSession), in)
+}
+
 // MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
 type MockecsCommandExecutor struct {
 	ctrl     *gomock.Controller
@@ -6346,6 +6705,223 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretsManagerSecretPutter is a mock of secretsManagerSecretPutter interface.
+type MocksecretsManagerSecretPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretPutterMockRecorder
+}
+
+// MocksecretsManagerSecretPutterMockRecorder is the mock recorder for MocksecretsManagerSecretPutter.
+type MocksecretsManagerSecretPutterMockRecorder struct {
+	mock *MocksecretsManagerSecretPutter
+}
+
+// NewMocksecretsManagerSecretPutter creates a new mock instance.
+func NewMocksecretsManagerSecretPutter(ctrl *gomock.Controller) *MocksecretsManagerSecretPutter {
+	mock := &MocksecretsManagerSecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretPutter) EXPECT() *MocksecretsManagerSecretPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretsManagerSecretPutter) PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*secretsmanager.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretsManagerSecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretsManagerSecretPutter)(nil).PutSecret), in)
+}
+
+// MocksecretRotator is a mock of secretRotator interface.
+type MocksecretRotator struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretRotatorMockRecorder
+}
+
+// MocksecretRotatorMockRecorder is the mock recorder for MocksecretRotator.
+type MocksecretRotatorMockRecorder struct {
+	mock *MocksecretRotator
+}
+
+// NewMocksecretRotator creates a new mock instance.
+func NewMocksecretRotator(ctrl *gomock.Controller) *MocksecretRotator {
+	mock := &MocksecretRotator{ctrl: ctrl}
+	mock.recorder = &MocksecretRotatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretRotator) EXPECT() *MocksecretRotatorMockRecorder {
+	return m.recorder
+}
+
+// ConfigureRotation mocks base method.
+func (m *MocksecretRotator) ConfigureRotation(secretName string, conf secretsmanager.RotationConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureRotation", secretName, conf)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfigureRotation indicates an expected call of ConfigureRotation.
+func (mr *MocksecretRotatorMockRecorder) ConfigureRotation(secretName, conf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureRotation", reflect.TypeOf((*MocksecretRotator)(nil).ConfigureRotation), secretName, conf)
+}
+
+// MocktaggedResourceGetter is a mock of taggedResourceGetter interface.
+type MocktaggedResourceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaggedResourceGetterMockRecorder
+}
+
+// MocktaggedResourceGetterMockRecorder is the mock recorder for MocktaggedResourceGetter.
+type MocktaggedResourceGetterMockRecorder struct {
+	mock *MocktaggedResourceGetter
+}
+
+// NewMocktaggedResourceGetter creates a new mock instance.
+func NewMocktaggedResourceGetter(ctrl *gomock.Controller) *MocktaggedResourceGetter {
+	mock := &MocktaggedResourceGetter{ctrl: ctrl}
+	mock.recorder = &MocktaggedResourceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaggedResourceGetter) EXPECT() *MocktaggedResourceGetterMockRecorder {
+	return m.recorder
+}
+
+// GetResourcesByTags mocks base method.
+func (m *MocktaggedResourceGetter) GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourcesByTags", resourceType, tags)
+	ret0, _ := ret[0].([]*resourcegroups.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourcesByTags indicates an expected call of GetResourcesByTags.
+func (mr *MocktaggedResourceGetterMockRecorder) GetResourcesByTags(resourceType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MocktaggedResourceGetter)(nil).GetResourcesByTags), resourceType, tags)
+}
+
+// MockssmSecretDescribeDeleter is a mock of ssmSecretDescribeDeleter interface.
+type MockssmSecretDescribeDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSecretDescribeDeleterMockRecorder
+}
+
+// MockssmSecretDescribeDeleterMockRecorder is the mock recorder for MockssmSecretDescribeDeleter.
+type MockssmSecretDescribeDeleterMockRecorder struct {
+	mock *MockssmSecretDescribeDeleter
+}
+
+// NewMockssmSecretDescribeDeleter creates a new mock instance.
+func NewMockssmSecretDescribeDeleter(ctrl *gomock.Controller) *MockssmSecretDescribeDeleter {
+	mock := &MockssmSecretDescribeDeleter{ctrl: ctrl}
+	mock.recorder = &MockssmSecretDescribeDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmSecretDescribeDeleter) EXPECT() *MockssmSecretDescribeDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MockssmSecretDescribeDeleter) DeleteSecret(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockssmSecretDescribeDeleterMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockssmSecretDescribeDeleter)(nil).DeleteSecret), secretName)
+}
+
+// DescribeSecret mocks base method.
+func (m *MockssmSecretDescribeDeleter) DescribeSecret(name string) (*ssm.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecret", name)
+	ret0, _ := ret[0].(*ssm.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecret indicates an expected call of DescribeSecret.
+func (mr *MockssmSecretDescribeDeleterMockRecorder) DescribeSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecret", reflect.TypeOf((*MockssmSecretDescribeDeleter)(nil).DescribeSecret), name)
+}
+
+// MocksecretsManagerSecretDescribeDeleter is a mock of secretsManagerSecretDescribeDeleter interface.
+type MocksecretsManagerSecretDescribeDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretDescribeDeleterMockRecorder
+}
+
+// MocksecretsManagerSecretDescribeDeleterMockRecorder is the mock recorder for MocksecretsManagerSecretDescribeDeleter.
+type MocksecretsManagerSecretDescribeDeleterMockRecorder struct {
+	mock *MocksecretsManagerSecretDescribeDeleter
+}
+
+// NewMocksecretsManagerSecretDescribeDeleter creates a new mock instance.
+func NewMocksecretsManagerSecretDescribeDeleter(ctrl *gomock.Controller) *MocksecretsManagerSecretDescribeDeleter {
+	mock := &MocksecretsManagerSecretDescribeDeleter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretDescribeDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretDescribeDeleter) EXPECT() *MocksecretsManagerSecretDescribeDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MocksecretsManagerSecretDescribeDeleter) DeleteSecret(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MocksecretsManagerSecretDescribeDeleterMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretsManagerSecretDescribeDeleter)(nil).DeleteSecret), secretName)
+}
+
+// DescribeSecret mocks base method.
+func (m *MocksecretsManagerSecretDescribeDeleter) DescribeSecret(secretName string) (*secretsmanager.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecret", secretName)
+	ret0, _ := ret[0].(*secretsmanager.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecret indicates an expected call of DescribeSecret.
+func (mr *MocksecretsManagerSecretDescribeDeleterMockRecorder) DescribeSecret(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecret", reflect.TypeOf((*MocksecretsManagerSecretDescribeDeleter)(nil).DescribeSecret), secretName)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -6459,6 +7035,21 @@ func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
 }
 
+// PromoteArtifacts mocks base method.
+func (m *MockworkloadDeployer) PromoteArtifacts(in *deploy.PromoteArtifactsInput) (*deploy.UploadArtifactsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteArtifacts", in)
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteArtifacts indicates an expected call of PromoteArtifacts.
+func (mr *MockworkloadDeployerMockRecorder) PromoteArtifacts(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).PromoteArtifacts), in)
+}
+
 // UploadArtifacts mocks base method.
 func (m *MockworkloadDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
 	m.ctrl.T.Helper()
@@ -6474,6 +7065,44 @@ func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
 }
 
+// MockdeployedImageDescriber is a mock of deployedImageDescriber interface.
+type MockdeployedImageDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedImageDescriberMockRecorder
+}
+
+// MockdeployedImageDescriberMockRecorder is the mock recorder for MockdeployedImageDescriber.
+type MockdeployedImageDescriberMockRecorder struct {
+	mock *MockdeployedImageDescriber
+}
+
+// NewMockdeployedImageDescriber creates a new mock instance.
+func NewMockdeployedImageDescriber(ctrl *gomock.Controller) *MockdeployedImageDescriber {
+	mock := &MockdeployedImageDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeployedImageDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedImageDescriber) EXPECT() *MockdeployedImageDescriberMockRecorder {
+	return m.recorder
+}
+
+// Image mocks base method.
+func (m *MockdeployedImageDescriber) Image() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Image")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Image indicates an expected call of Image.
+func (mr *MockdeployedImageDescriberMockRecorder) Image() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Image", reflect.TypeOf((*MockdeployedImageDescriber)(nil).Image))
+}
+
 // MockworkloadTemplateGenerator is a mock of workloadTemplateGenerator interface.
 type MockworkloadTemplateGenerator struct {
 	ctrl     *gomock.Controller
@@ -218,13 +218,15 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 
 	deployPipelineInput := &deploy.CreatePipelineInput{
-		AppName:         o.appName,
-		Name:            pipeline.Name,
-		Source:          source,
-		Build:           deploy.PipelineBuildFromManifest(pipeline.Build),
-		Stages:          stages,
-		ArtifactBuckets: artifactBuckets,
-		AdditionalTags:  o.app.Tags,
+		AppName:             o.appName,
+		Name:                pipeline.Name,
+		Source:              source,
+		Build:               deploy.PipelineBuildFromManifest(pipeline.Build),
+		Stages:              stages,
+		ArtifactBuckets:     artifactBuckets,
+		AdditionalTags:      o.app.Tags,
+		PermissionsBoundary: o.app.PermissionsBoundary,
+		RolePath:            o.app.RolePath,
 	}
 
 	if err := o.deployPipeline(deployPipelineInput); err != nil {
@@ -4,11 +4,193 @@
 package cli
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/spf13/cobra"
 )
 
+const (
+	ssmParameterResourceType         = "ssm:parameter"
+	secretsManagerSecretResourceType = "secretsmanager:secret"
+
+	// Secrets Manager appends a hyphen followed by six random characters to the name of a secret in its ARN.
+	secretsManagerARNSuffixLength = 7
+)
+
+const (
+	// Display settings.
+	minCellWidth           = 10  // minimum number of characters in a table's cell.
+	tabWidth               = 4   // number of characters in between columns.
+	cellPaddingWidth       = 2   // number of padding characters added by default to a cell.
+	paddingChar            = ' ' // character in between columns.
+	noAdditionalFormatting = 0
+)
+
+// envSecret is a secret created by "secret init" in an environment.
+type envSecret struct {
+	name         string // Name of the secret given to "secret init".
+	envName      string
+	store        string // Either secretStoreSSM or secretStoreSecretsManager.
+	resourceName string // Name of the SSM parameter or of the Secrets Manager secret.
+	arn          string
+}
+
+// envSecretClients holds the clients needed to manage the secrets of an environment.
+type envSecretClients struct {
+	rg             taggedResourceGetter
+	ssm            ssmSecretDescribeDeleter
+	secretsManager secretsManagerSecretDescribeDeleter
+	envUpgrader    actionCommand
+
+	isEnvUpgraded bool
+}
+
+// secretsManagerClient returns the Secrets Manager client once the environment is upgraded, as the manager role of
+// environments created before Secrets Manager secrets were supported isn't allowed to describe or delete them.
+func (c *envSecretClients) secretsManagerClient(appName, envName string) (secretsManagerSecretDescribeDeleter, error) {
+	if c.isEnvUpgraded {
+		return c.secretsManager, nil
+	}
+	if err := c.envUpgrader.Execute(); err != nil {
+		return nil, fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, appName, envName, err)
+	}
+	c.isEnvUpgraded = true
+	return c.secretsManager, nil
+}
+
+// secretFinder finds the secrets created by "secret init" in the environments of an application.
+type secretFinder struct {
+	newClients func(env *config.Environment) (*envSecretClients, error)
+	clients    map[string]*envSecretClients // Cached clients by environment name.
+}
+
+func newSecretFinder(sessProvider sessionFromRoleProvider) *secretFinder {
+	return &secretFinder{
+		newClients: func(env *config.Environment) (*envSecretClients, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			upgrader, err := newEnvUpgradeOpts(envUpgradeVars{
+				appName: env.App,
+				name:    env.Name,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env upgrade command: %v", err)
+			}
+			return &envSecretClients{
+				rg:             resourcegroups.New(sess),
+				ssm:            ssm.New(sess),
+				secretsManager: secretsmanager.New(sess),
+				envUpgrader:    upgrader,
+			}, nil
+		},
+		clients: make(map[string]*envSecretClients),
+	}
+}
+
+func (f *secretFinder) envClients(env *config.Environment) (*envSecretClients, error) {
+	if clients, ok := f.clients[env.Name]; ok {
+		return clients, nil
+	}
+	clients, err := f.newClients(env)
+	if err != nil {
+		return nil, err
+	}
+	f.clients[env.Name] = clients
+	return clients, nil
+}
+
+// find returns the secrets of the application in the environments, sorted by secret name and then environment.
+func (f *secretFinder) find(appName string, envs []*config.Environment) ([]envSecret, error) {
+	var secrets []envSecret
+	for _, env := range envs {
+		clients, err := f.envClients(env)
+		if err != nil {
+			return nil, err
+		}
+		envSecrets, err := listEnvSecrets(clients.rg, appName, env.Name)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, envSecrets...)
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		if secrets[i].name != secrets[j].name {
+			return secrets[i].name < secrets[j].name
+		}
+		return secrets[i].envName < secrets[j].envName
+	})
+	return secrets, nil
+}
+
+// listEnvSecrets returns the secrets tagged with the application and environment names whose names
+// follow the naming convention of "secret init". Secrets brought by users are ignored.
+func listEnvSecrets(rg taggedResourceGetter, appName, envName string) ([]envSecret, error) {
+	tags := map[string]string{
+		deploy.AppTagKey: appName,
+		deploy.EnvTagKey: envName,
+	}
+	params, err := rg.GetResourcesByTags(ssmParameterResourceType, tags)
+	if err != nil {
+		return nil, fmt.Errorf("get SSM parameters in environment %s: %w", envName, err)
+	}
+	var secrets []envSecret
+	paramPrefix := fmt.Sprintf(fmtSecretParameterName, appName, envName, "")
+	for _, param := range params {
+		parsed, err := arn.Parse(param.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("parse SSM parameter ARN %s: %w", param.ARN, err)
+		}
+		paramName := strings.TrimPrefix(parsed.Resource, "parameter")
+		if !strings.HasPrefix(paramName, paramPrefix) {
+			continue
+		}
+		secrets = append(secrets, envSecret{
+			name:         strings.TrimPrefix(paramName, paramPrefix),
+			envName:      envName,
+			store:        secretStoreSSM,
+			resourceName: paramName,
+			arn:          param.ARN,
+		})
+	}
+
+	smSecrets, err := rg.GetResourcesByTags(secretsManagerSecretResourceType, tags)
+	if err != nil {
+		return nil, fmt.Errorf("get Secrets Manager secrets in environment %s: %w", envName, err)
+	}
+	smPrefix := fmt.Sprintf(fmtSecretsManagerSecretName, appName, envName, "")
+	for _, smSecret := range smSecrets {
+		parsed, err := arn.Parse(smSecret.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("parse Secrets Manager secret ARN %s: %w", smSecret.ARN, err)
+		}
+		secretName := strings.TrimPrefix(parsed.Resource, "secret:")
+		if len(secretName) <= len(smPrefix)+secretsManagerARNSuffixLength || !strings.HasPrefix(secretName, smPrefix) {
+			continue
+		}
+		secretName = secretName[:len(secretName)-secretsManagerARNSuffixLength]
+		secrets = append(secrets, envSecret{
+			name:         strings.TrimPrefix(secretName, smPrefix),
+			envName:      envName,
+			store:        secretStoreSecretsManager,
+			resourceName: secretName,
+			arn:          smSecret.ARN,
+		})
+	}
+	return secrets, nil
+}
+
 // BuildSecretCmd is the top level command for secret.
 func BuildSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,6 +200,9 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretListCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,221 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretDeleteAppNamePrompt     = "Which application's secret would you like to delete?"
+	secretDeleteAppNamePromptHelp = "An application groups all of your environments and the secrets stored in them."
+	secretDeleteNamePrompt        = "Which secret would you like to delete?"
+	secretDeleteNamePromptHelp    = "The secret will be deleted from all the environments in which it is stored, unless an environment is specified."
+	fmtSecretDeletePrompt         = "Are you sure you want to delete secret %s from %s %s?"
+)
+
+var (
+	errSecretDeleteCancelled = errors.New("secret delete cancelled - no changes made")
+)
+
+type deleteSecretVars struct {
+	appName          string
+	name             string
+	envName          string
+	skipConfirmation bool
+}
+
+type deleteSecretOpts struct {
+	deleteSecretVars
+
+	store  store
+	sel    appSelector
+	prompt prompter
+	finder *secretFinder
+
+	secrets []envSecret // Cached secrets of the application in the target environments.
+}
+
+func newDeleteSecretOpts(vars deleteSecretVars) (*deleteSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret delete"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &deleteSecretOpts{
+		deleteSecretVars: vars,
+		store:            store,
+		sel:              selector.NewSelect(prompter, store),
+		prompt:           prompter,
+		finder:           newSecretFinder(sessProvider),
+	}, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *deleteSecretOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+			}
+		}
+	}
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the application and secret names if they're not provided, and confirms the deletion.
+func (o *deleteSecretOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretDeleteAppNamePrompt, secretDeleteAppNamePromptHelp)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		name, err := askSecretName(o.prompt, o.listSecrets, o.appName, secretDeleteNamePrompt, secretDeleteNamePromptHelp)
+		if err != nil {
+			return err
+		}
+		o.name = name
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	secrets, err := o.targetSecrets()
+	if err != nil {
+		return err
+	}
+	var envs []string
+	for _, secret := range secrets {
+		envs = append(envs, secret.envName)
+	}
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSecretDeletePrompt, color.HighlightUserInput(o.name), english.PluralWord(len(envs), "environment", ""), english.WordSeries(envs, "and")),
+		"", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to delete secret %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errSecretDeleteCancelled
+	}
+	return nil
+}
+
+// Execute deletes the secret from the target environments.
+func (o *deleteSecretOpts) Execute() error {
+	secrets, err := o.targetSecrets()
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets {
+		clients := o.finder.clients[secret.envName] // Clients are configured by finder.find.
+		var deleter secretDeleter = clients.ssm
+		if secret.store == secretStoreSecretsManager {
+			if deleter, err = clients.secretsManagerClient(o.appName, secret.envName); err != nil {
+				return err
+			}
+		}
+		if err := deleter.DeleteSecret(secret.resourceName); err != nil {
+			return fmt.Errorf("delete secret %s in environment %s: %w", secret.name, secret.envName, err)
+		}
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(secret.name), color.HighlightUserInput(secret.envName))
+	}
+	return nil
+}
+
+// RecommendActions shows recommended actions to do after running `secret delete`.
+func (o *deleteSecretOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Remove the secret %s from the %s section of the manifests that reference it, then redeploy the workloads.", color.HighlightUserInput(o.name), color.HighlightCode("secrets")),
+	})
+	return nil
+}
+
+func (o *deleteSecretOpts) listSecrets() ([]envSecret, error) {
+	if o.secrets != nil {
+		return o.secrets, nil
+	}
+	envs, err := targetSecretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := o.finder.find(o.appName, envs)
+	if err != nil {
+		return nil, err
+	}
+	o.secrets = secrets
+	return secrets, nil
+}
+
+// targetSecrets returns the secret to delete in each environment.
+func (o *deleteSecretOpts) targetSecrets() ([]envSecret, error) {
+	secrets, err := o.listSecrets()
+	if err != nil {
+		return nil, err
+	}
+	secrets = filterSecretsByName(secrets, o.name)
+	if len(secrets) == 0 {
+		return nil, fmt.Errorf("secret %s not found in application %s", o.name, o.appName)
+	}
+	return secrets, nil
+}
+
+// buildSecretDeleteCmd builds the command for deleting a secret from the environments of an application.
+func buildSecretDeleteCmd() *cobra.Command {
+	vars := deleteSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from the environments of an application.",
+		Example: `
+  Deletes the secret "db_password" from all the environments.
+  /code $ copilot secret delete --name db_password
+  Deletes the secret "db_password" from the "test" environment without confirmation.
+  /code $ copilot secret delete --name db_password --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeleteSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			return opts.RecommendActions()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", existingSecretNameFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
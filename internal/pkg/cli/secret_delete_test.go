@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretDeleteMocks struct {
+	store          *mocks.Mockstore
+	prompt         *mocks.Mockprompter
+	rg             *mocks.MocktaggedResourceGetter
+	ssm            *mocks.MockssmSecretDescribeDeleter
+	secretsManager *mocks.MocksecretsManagerSecretDescribeDeleter
+	envUpgrader    *mocks.MockactionCommand
+}
+
+func mockSecretDeleteResources(m secretDeleteMocks) {
+	m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "prod"}, {Name: "test"}}, nil)
+	m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, gomock.Any()).Return([]*resourcegroups.Resource{
+		{ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/my-app/prod/secrets/api_key"},
+	}, nil)
+	m.rg.EXPECT().GetResourcesByTags(secretsManagerSecretResourceType, gomock.Any()).Return([]*resourcegroups.Resource{
+		{ARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/my-app/prod/secrets/db_password-AbCdEf"},
+	}, nil)
+	m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, gomock.Any()).Return([]*resourcegroups.Resource{
+		{ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/my-app/test/secrets/db_password"},
+	}, nil)
+	m.rg.EXPECT().GetResourcesByTags(secretsManagerSecretResourceType, gomock.Any()).Return(nil, nil)
+}
+
+func newTestDeleteSecretOpts(vars deleteSecretVars, m secretDeleteMocks) *deleteSecretOpts {
+	return &deleteSecretOpts{
+		deleteSecretVars: vars,
+		store:            m.store,
+		prompt:           m.prompt,
+		finder: &secretFinder{
+			newClients: func(env *config.Environment) (*envSecretClients, error) {
+				return &envSecretClients{
+					rg:             m.rg,
+					ssm:            m.ssm,
+					secretsManager: m.secretsManager,
+					envUpgrader:    m.envUpgrader,
+				}, nil
+			},
+			clients: make(map[string]*envSecretClients),
+		},
+	}
+}
+
+func TestDeleteSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName             string
+		inSkipConfirmation bool
+
+		setupMocks func(m secretDeleteMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"prompts for the secret name and skips confirmation": {
+			inSkipConfirmation: true,
+			setupMocks: func(m secretDeleteMocks) {
+				mockSecretDeleteResources(m)
+				m.prompt.EXPECT().SelectOne(secretDeleteNamePrompt, secretDeleteNamePromptHelp, []string{"api_key", "db_password"}, gomock.Any()).
+					Return("db_password", nil)
+			},
+			wantedName: "db_password",
+		},
+		"error if the secret does not exist": {
+			inName:      "github_token",
+			setupMocks:  mockSecretDeleteResources,
+			wantedError: errors.New("secret github_token not found in application my-app"),
+		},
+		"error if the deletion is cancelled": {
+			inName: "db_password",
+			setupMocks: func(m secretDeleteMocks) {
+				mockSecretDeleteResources(m)
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete secret db_password from environments prod and test?", "", gomock.Any()).
+					Return(false, nil)
+			},
+			wantedError: errSecretDeleteCancelled,
+		},
+		"confirms the deletion": {
+			inName: "db_password",
+			setupMocks: func(m secretDeleteMocks) {
+				mockSecretDeleteResources(m)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+			},
+			wantedName: "db_password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := secretDeleteMocks{
+				store:  mocks.NewMockstore(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+				rg:     mocks.NewMocktaggedResourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newTestDeleteSecretOpts(deleteSecretVars{
+				appName:          "my-app",
+				name:             tc.inName,
+				skipConfirmation: tc.inSkipConfirmation,
+			}, m)
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestDeleteSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m secretDeleteMocks)
+
+		wantedError error
+	}{
+		"error if fail to upgrade the environment of a Secrets Manager secret": {
+			setupMocks: func(m secretDeleteMocks) {
+				mockSecretDeleteResources(m)
+				m.envUpgrader.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedError: errors.New(`execute "env upgrade --app my-app --name prod": some error`),
+		},
+		"error if fail to delete the secret": {
+			setupMocks: func(m secretDeleteMocks) {
+				mockSecretDeleteResources(m)
+				m.envUpgrader.EXPECT().Execute().Return(nil)
+				m.secretsManager.EXPECT().DeleteSecret("copilot/my-app/prod/secrets/db_password").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db_password in environment prod: some error"),
+		},
+		"deletes the secret from every environment": {
+			setupMocks: func(m secretDeleteMocks) {
+				mockSecretDeleteResources(m)
+				m.envUpgrader.EXPECT().Execute().Return(nil)
+				m.secretsManager.EXPECT().DeleteSecret("copilot/my-app/prod/secrets/db_password").Return(nil)
+				m.ssm.EXPECT().DeleteSecret("/copilot/my-app/test/secrets/db_password").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := secretDeleteMocks{
+				store:          mocks.NewMockstore(ctrl),
+				rg:             mocks.NewMocktaggedResourceGetter(ctrl),
+				ssm:            mocks.NewMockssmSecretDescribeDeleter(ctrl),
+				secretsManager: mocks.NewMocksecretsManagerSecretDescribeDeleter(ctrl),
+				envUpgrader:    mocks.NewMockactionCommand(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newTestDeleteSecretOpts(deleteSecretVars{
+				appName: "my-app",
+				name:    "db_password",
+			}, m)
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 
 	"github.com/dustin/go-humanize/english"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	awsssm "github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/spf13/afero"
@@ -34,8 +36,24 @@ import (
 const (
 	fmtSecretParameterName           = "/copilot/%s/%s/secrets/%s"
 	fmtSecretParameterNameMftExample = "/copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/%s"
+
+	fmtSecretsManagerSecretName           = "copilot/%s/%s/secrets/%s"
+	fmtSecretsManagerSecretNameMftExample = "copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/%s"
+)
+
+// Stores that secrets can be written to.
+const (
+	secretStoreSSM            = "ssm"
+	secretStoreSecretsManager = "secretsmanager"
 )
 
+const (
+	defaultSecretRotationDays = 30
+	maxSecretRotationDays     = 1000
+)
+
+var secretStores = []string{secretStoreSSM, secretStoreSecretsManager}
+
 const (
 	secretInitAppPrompt     = "Which application do you want to add the secret to?"
 	secretInitAppPromptHelp = "The secret can then be versioned by your existing environments inside the application."
@@ -54,6 +72,10 @@ type secretInitVars struct {
 	values        map[string]string
 	inputFilePath string
 	overwrite     bool
+
+	secretStore       string
+	rotationLambdaARN string
+	rotationDays      int
 }
 
 type secretInitOpts struct {
@@ -69,8 +91,10 @@ type secretInitOpts struct {
 
 	shouldShowOverwriteHint bool
 
-	envUpgradeCMDs map[string]actionCommand
-	secretPutters  map[string]secretPutter
+	envUpgradeCMDs        map[string]actionCommand
+	secretPutters         map[string]secretPutter
+	secretsManagerPutters map[string]secretsManagerSecretPutter
+	secretRotators        map[string]secretRotator // Configured with the user's credentials, which need to invoke the rotation function.
 
 	configureClientsForEnv func(envName string) error
 	readFile               func() ([]byte, error)
@@ -90,8 +114,9 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		store:          store,
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
 
-		envUpgradeCMDs: make(map[string]actionCommand),
-		secretPutters:  make(map[string]secretPutter),
+		envUpgradeCMDs:        make(map[string]actionCommand),
+		secretPutters:         make(map[string]secretPutter),
+		secretsManagerPutters: make(map[string]secretsManagerSecretPutter),
 
 		prompter: prompter,
 		selector: selector.NewSelect(prompter, store),
@@ -115,6 +140,20 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		if err != nil {
 			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 		}
+		if opts.secretStore == secretStoreSecretsManager {
+			opts.secretsManagerPutters[envName] = secretsmanager.New(sess)
+			if opts.rotationLambdaARN == "" {
+				return nil
+			}
+			// The environment manager role isn't allowed to invoke arbitrary functions,
+			// so rotation is configured with the user's credentials instead.
+			userSess, err := sessProvider.DefaultWithRegion(env.Region)
+			if err != nil {
+				return fmt.Errorf("create default session with region %s: %w", env.Region, err)
+			}
+			opts.secretRotators[envName] = secretsmanager.New(userSess)
+			return nil
+		}
 		opts.secretPutters[envName] = ssm.New(sess)
 
 		return nil
@@ -146,6 +185,10 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	if err := o.validateStore(); err != nil {
+		return err
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -174,6 +217,28 @@ func (o *secretInitOpts) Validate() error {
 	return nil
 }
 
+func (o *secretInitOpts) validateStore() error {
+	if o.secretStore != secretStoreSSM && o.secretStore != secretStoreSecretsManager {
+		return fmt.Errorf("invalid store %q: must be one of %s", o.secretStore, english.WordSeries(secretStores, "or"))
+	}
+	if o.rotationDays != 0 && o.rotationLambdaARN == "" {
+		return fmt.Errorf("--%s must be specified with --%s", rotationDaysFlag, rotationLambdaFlag)
+	}
+	if o.rotationLambdaARN == "" {
+		return nil
+	}
+	if o.secretStore != secretStoreSecretsManager {
+		return fmt.Errorf("--%s can only be specified with --%s %s", rotationLambdaFlag, secretStoreFlag, secretStoreSecretsManager)
+	}
+	if _, err := arn.Parse(o.rotationLambdaARN); err != nil {
+		return fmt.Errorf("parse rotation Lambda function ARN %s: %w", o.rotationLambdaARN, err)
+	}
+	if o.rotationDays < 0 || o.rotationDays > maxSecretRotationDays {
+		return fmt.Errorf("--%s must be between 1 and %d, or 0 to rotate every %d days", rotationDaysFlag, maxSecretRotationDays, defaultSecretRotationDays)
+	}
+	return nil
+}
+
 // Ask prompts the user for any required or important fields that are not provided.
 func (o *secretInitOpts) Ask() error {
 	if o.overwrite {
@@ -290,6 +355,9 @@ func (o *secretInitOpts) putSecret(secretName string, values map[string]string)
 }
 
 func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error {
+	if o.secretStore == secretStoreSecretsManager {
+		return o.putSecretsManagerSecretInEnv(secretName, envName, value)
+	}
 	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
 	in := ssm.PutSecretInput{
 		Name:      name,
@@ -322,6 +390,54 @@ func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error
 	return nil
 }
 
+func (o *secretInitOpts) putSecretsManagerSecretInEnv(secretName, envName, value string) error {
+	name := fmt.Sprintf(fmtSecretsManagerSecretName, o.appName, envName, secretName)
+	in := secretsmanager.PutSecretInput{
+		Name:      name,
+		Value:     value,
+		Overwrite: o.overwrite,
+		Tags: map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: envName,
+		},
+	}
+	out, err := o.secretsManagerPutters[envName].PutSecret(in)
+	if err != nil {
+		var targetErr *secretsmanager.ErrSecretAlreadyExists
+		if errors.As(err, &targetErr) {
+			o.shouldShowOverwriteHint = true
+			log.Successf("Secret %s already exists in environment %s as %s. Did not overwrite. \n", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name))
+			return nil
+		}
+		return err
+	}
+	if err := o.configureRotation(name, envName); err != nil {
+		return err
+	}
+
+	if out.Overwritten {
+		log.Successln(fmt.Sprintf("Secret %s already exists in environment %s. Overwritten.", name, color.HighlightUserInput(envName)))
+		return nil
+	}
+
+	log.Successln(fmt.Sprintf("Successfully put secret %s in environment %s as %s.", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name)))
+	return nil
+}
+
+func (o *secretInitOpts) configureRotation(name, envName string) error {
+	if o.rotationLambdaARN == "" {
+		return nil
+	}
+	days := o.rotationDays
+	if days == 0 {
+		days = defaultSecretRotationDays
+	}
+	return o.secretRotators[envName].ConfigureRotation(name, secretsmanager.RotationConfig{
+		LambdaARN: o.rotationLambdaARN,
+		AfterDays: int64(days),
+	})
+}
+
 func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string, error) {
 	raw, err := o.readFile()
 	if err != nil {
@@ -409,11 +525,17 @@ func (o *secretInitOpts) RecommendActions() error {
 	secretsManifestExample := "secrets:"
 	for secretName := range o.secretValues {
 		currSecret := fmt.Sprintf("%s: %s", secretName, fmt.Sprintf(fmtSecretParameterNameMftExample, secretName))
+		if o.secretStore == secretStoreSecretsManager {
+			currSecret = fmt.Sprintf("%s:\n      secretsmanager: %s", secretName, fmt.Sprintf(fmtSecretsManagerSecretNameMftExample, secretName))
+		}
 		secretsManifestExample = fmt.Sprintf("%s\n%s", secretsManifestExample, fmt.Sprintf("    %s", currSecret))
 	}
 
 	log.Infoln("You can refer to these secrets from your manifest file by editing the `secrets` section.")
 	log.Infoln(color.HighlightCode(secretsManifestExample))
+	if o.secretStore == secretStoreSecretsManager {
+		log.Infof("If a secret value is a JSON object, you can select one of its values with %s.\n", color.HighlightCode("key: <json-key>"))
+	}
 	return nil
 }
 
@@ -471,14 +593,16 @@ func buildSecretInitCmd() *cobra.Command {
 	vars := secretInitVars{}
 	cmd := &cobra.Command{
 		Use:   "init",
-		Short: "Create or update secrets in SSM Parameter Store.",
+		Short: "Create or update secrets in SSM Parameter Store or AWS Secrets Manager.",
 		Example: `
 Create a secret with prompts. 
 /code $ copilot secret init
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
-/code $ copilot secret init --cli-input-yaml input.yml`,
+/code $ copilot secret init --cli-input-yaml input.yml
+Create a secret in AWS Secrets Manager that is rotated every 7 days.
+/code $ copilot secret init --name db-credentials --store secretsmanager --rotation-lambda arn:aws:lambda:us-west-2:123456789012:function:rotate-db-credentials --rotation-days 7`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSecretInitOpts(vars)
 			if err != nil {
@@ -511,5 +635,8 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	cmd.Flags().StringVar(&vars.secretStore, secretStoreFlag, secretStoreSSM, secretStoreFlagDescription)
+	cmd.Flags().StringVar(&vars.rotationLambdaARN, rotationLambdaFlag, "", secretRotationLambdaFlagDescription)
+	cmd.Flags().IntVar(&vars.rotationDays, rotationDaysFlag, 0, secretRotationDaysFlagDescription)
 	return cmd
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
@@ -34,6 +35,9 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 		inValues        map[string]string
 		inOverwrite     bool
 		inInputFilePath string
+		inStore         string
+		inRotationARN   string
+		inRotationDays  int
 
 		setupMocks func(m secretInitMocks)
 
@@ -109,6 +113,41 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 			setupMocks:      func(m secretInitMocks) {},
 			wantedError:     errors.New("cannot specify `--cli-input-yaml` with `--values`"),
 		},
+		"valid with secretsmanager store and rotation": {
+			inStore:        secretStoreSecretsManager,
+			inRotationARN:  "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			inRotationDays: 7,
+			setupMocks:     func(m secretInitMocks) {},
+		},
+		"error if store is invalid": {
+			inStore:     "vault",
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New(`invalid store "vault": must be one of ssm or secretsmanager`),
+		},
+		"error if rotation lambda is specified with ssm store": {
+			inRotationARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			setupMocks:    func(m secretInitMocks) {},
+			wantedError:   errors.New("--rotation-lambda can only be specified with --store secretsmanager"),
+		},
+		"error if rotation days is specified without rotation lambda": {
+			inStore:        secretStoreSecretsManager,
+			inRotationDays: 7,
+			setupMocks:     func(m secretInitMocks) {},
+			wantedError:    errors.New("--rotation-days must be specified with --rotation-lambda"),
+		},
+		"error if rotation lambda is not an ARN": {
+			inStore:       secretStoreSecretsManager,
+			inRotationARN: "rotate",
+			setupMocks:    func(m secretInitMocks) {},
+			wantedError:   errors.New("parse rotation Lambda function ARN rotate: arn: invalid prefix"),
+		},
+		"error if rotation days is out of range": {
+			inStore:        secretStoreSecretsManager,
+			inRotationARN:  "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			inRotationDays: 1001,
+			setupMocks:     func(m secretInitMocks) {},
+			wantedError:    errors.New("--rotation-days must be between 1 and 1000, or 0 to rotate every 30 days"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -117,14 +156,21 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockstore(ctrl)
+			secretStore := secretStoreSSM
+			if tc.inStore != "" {
+				secretStore = tc.inStore
+			}
 
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName:       tc.inApp,
-					name:          tc.inName,
-					values:        tc.inValues,
-					inputFilePath: tc.inInputFilePath,
-					overwrite:     tc.inOverwrite,
+					appName:           tc.inApp,
+					name:              tc.inName,
+					values:            tc.inValues,
+					inputFilePath:     tc.inInputFilePath,
+					overwrite:         tc.inOverwrite,
+					secretStore:       secretStore,
+					rotationLambdaARN: tc.inRotationARN,
+					rotationDays:      tc.inRotationDays,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
 				store: mockStore,
@@ -341,9 +387,11 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 }
 
 type secretInitExecuteMocks struct {
-	mockStore        *mocks.Mockstore
-	mockSecretPutter *mocks.MocksecretPutter
-	mockEnvUpgrader  *mocks.MockactionCommand
+	mockStore                *mocks.Mockstore
+	mockSecretPutter         *mocks.MocksecretPutter
+	mockSecretsManagerPutter *mocks.MocksecretsManagerSecretPutter
+	mockSecretRotator        *mocks.MocksecretRotator
+	mockEnvUpgrader          *mocks.MockactionCommand
 }
 
 func TestSecretInitOpts_Execute(t *testing.T) {
@@ -365,6 +413,10 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 
 		inOverwrite bool
 
+		inStore        string
+		inRotationARN  string
+		inRotationDays int
+
 		mockInputFileContent []byte
 		setupMocks           func(m secretInitExecuteMocks)
 
@@ -498,6 +550,51 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 				},
 			},
 		},
+		"successfully create secrets in secrets manager with rotation": {
+			inAppName:     testApp,
+			inName:        testName,
+			inValues:      map[string]string{"test": "test-password"},
+			inStore:       secretStoreSecretsManager,
+			inRotationARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "copilot/test-app/test/secrets/db-password",
+					Value: "test-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&secretsmanager.PutSecretOutput{
+					ARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/test-app/test/secrets/db-password-abcdef",
+				}, nil)
+				m.mockSecretRotator.EXPECT().ConfigureRotation("copilot/test-app/test/secrets/db-password", secretsmanager.RotationConfig{
+					LambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+					AfterDays: 30,
+				}).Return(nil)
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			},
+		},
+		"do not throw error if secrets manager secret already exists": {
+			inAppName:      testApp,
+			inName:         testName,
+			inValues:       map[string]string{"prod": "prod-password"},
+			inStore:        secretStoreSecretsManager,
+			inRotationARN:  "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			inRotationDays: 7,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "copilot/test-app/prod/secrets/db-password",
+					Value: "prod-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+				}).Return(nil, &secretsmanager.ErrSecretAlreadyExists{})
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			},
+		},
 		"some secrets fail to create during a batch operation": {
 			inAppName:       testApp,
 			inInputFilePath: "some/file",
@@ -565,24 +662,31 @@ db-host:
 			defer ctrl.Finish()
 
 			m := secretInitExecuteMocks{
-				mockStore:        mocks.NewMockstore(ctrl),
-				mockSecretPutter: mocks.NewMocksecretPutter(ctrl),
-				mockEnvUpgrader:  mocks.NewMockactionCommand(ctrl),
+				mockStore:                mocks.NewMockstore(ctrl),
+				mockSecretPutter:         mocks.NewMocksecretPutter(ctrl),
+				mockSecretsManagerPutter: mocks.NewMocksecretsManagerSecretPutter(ctrl),
+				mockSecretRotator:        mocks.NewMocksecretRotator(ctrl),
+				mockEnvUpgrader:          mocks.NewMockactionCommand(ctrl),
 			}
 			tc.setupMocks(m)
 
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName:       tc.inAppName,
-					name:          tc.inName,
-					values:        tc.inValues,
-					overwrite:     tc.inOverwrite,
-					inputFilePath: tc.inInputFilePath,
+					appName:           tc.inAppName,
+					name:              tc.inName,
+					values:            tc.inValues,
+					overwrite:         tc.inOverwrite,
+					inputFilePath:     tc.inInputFilePath,
+					secretStore:       tc.inStore,
+					rotationLambdaARN: tc.inRotationARN,
+					rotationDays:      tc.inRotationDays,
 				},
 				store: m.mockStore,
 
-				secretPutters:  make(map[string]secretPutter),
-				envUpgradeCMDs: make(map[string]actionCommand),
+				secretPutters:         make(map[string]secretPutter),
+				secretsManagerPutters: make(map[string]secretsManagerSecretPutter),
+				secretRotators:        make(map[string]secretRotator),
+				envUpgradeCMDs:        make(map[string]actionCommand),
 				readFile: func() ([]byte, error) {
 					return tc.mockInputFileContent, nil
 				},
@@ -590,6 +694,8 @@ db-host:
 
 			opts.configureClientsForEnv = func(envName string) error {
 				opts.secretPutters[envName] = m.mockSecretPutter
+				opts.secretsManagerPutters[envName] = m.mockSecretsManagerPutter
+				opts.secretRotators[envName] = m.mockSecretRotator
 				opts.envUpgradeCMDs[envName] = m.mockEnvUpgrader
 				return nil
 			}
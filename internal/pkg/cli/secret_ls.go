@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretListAppNamePrompt     = "Which application's secrets would you like to list?"
+	secretListAppNamePromptHelp = "An application groups all of your environments and the secrets stored in them."
+)
+
+type listSecretVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type listSecretOpts struct {
+	listSecretVars
+
+	store  store
+	sel    appSelector
+	finder *secretFinder
+
+	w io.Writer
+}
+
+func newListSecretOpts(vars listSecretVars) (*listSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &listSecretOpts{
+		listSecretVars: vars,
+		store:          store,
+		sel:            selector.NewSelect(prompt.New(), store),
+		finder:         newSecretFinder(sessProvider),
+		w:              os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *listSecretOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.envName == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts for the application name if it's not provided.
+func (o *listSecretOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNamePromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the secrets created by "secret init" in the environments of the application.
+func (o *listSecretOpts) Execute() error {
+	envs, err := targetSecretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	secrets, err := o.finder.find(o.appName, envs)
+	if err != nil {
+		return err
+	}
+	summaries := summarizeSecrets(secrets)
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Secrets []*secretSummary `json:"secrets"`
+		}{
+			Secrets: summaries,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal secrets: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Name", "Store", "Environments")
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "----", "-----", "------------")
+	for _, summary := range summaries {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", summary.Name, summary.Store, strings.Join(summary.Environments, ", "))
+	}
+	return writer.Flush()
+}
+
+// secretSummary groups the environments in which a secret is stored.
+type secretSummary struct {
+	Name         string   `json:"name"`
+	Store        string   `json:"store"`
+	Environments []string `json:"environments"`
+}
+
+// summarizeSecrets groups secrets sorted by name and environment by their name and store.
+func summarizeSecrets(secrets []envSecret) []*secretSummary {
+	var summaries []*secretSummary
+	index := make(map[string]*secretSummary)
+	for _, secret := range secrets {
+		key := secret.store + "/" + secret.name
+		summary, ok := index[key]
+		if !ok {
+			summary = &secretSummary{
+				Name:  secret.name,
+				Store: secret.store,
+			}
+			index[key] = summary
+			summaries = append(summaries, summary)
+		}
+		summary.Environments = append(summary.Environments, secret.envName)
+	}
+	return summaries
+}
+
+// targetSecretEnvs returns the environment if envName is provided, otherwise all the environments of the application.
+func targetSecretEnvs(store store, appName, envName string) ([]*config.Environment, error) {
+	if envName != "" {
+		env, err := store.GetEnvironment(appName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", envName, appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := store.ListEnvironments(appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", appName, err)
+	}
+	return envs, nil
+}
+
+// buildSecretListCmd builds the command for listing the secrets of an application.
+func buildSecretListCmd() *cobra.Command {
+	vars := listSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets created by Copilot in an application.",
+		Example: `
+  Lists all the secrets of the application.
+  /code $ copilot secret ls
+  Lists the secrets of the "test" environment in JSON format.
+  /code $ copilot secret ls --env test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
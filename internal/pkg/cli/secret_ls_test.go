@@ -0,0 +1,139 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretListMocks struct {
+	store *mocks.Mockstore
+	rg    *mocks.MocktaggedResourceGetter
+}
+
+func TestListSecretOpts_Execute(t *testing.T) {
+	testEnvs := []*config.Environment{
+		{Name: "prod"},
+		{Name: "test"},
+	}
+	mockGetResources := func(m secretListMocks) {
+		m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, map[string]string{
+			deploy.AppTagKey: "my-app",
+			deploy.EnvTagKey: "prod",
+		}).Return([]*resourcegroups.Resource{
+			{ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/my-app/prod/secrets/db_password"},
+		}, nil)
+		m.rg.EXPECT().GetResourcesByTags(secretsManagerSecretResourceType, map[string]string{
+			deploy.AppTagKey: "my-app",
+			deploy.EnvTagKey: "prod",
+		}).Return([]*resourcegroups.Resource{
+			{ARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/my-app/prod/secrets/api_key-AbCdEf"},
+			{ARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-own-secret-AbCdEf"},
+		}, nil)
+		m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, map[string]string{
+			deploy.AppTagKey: "my-app",
+			deploy.EnvTagKey: "test",
+		}).Return([]*resourcegroups.Resource{
+			{ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/my-app/test/secrets/db_password"},
+			{ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/my-own-parameter"},
+		}, nil)
+		m.rg.EXPECT().GetResourcesByTags(secretsManagerSecretResourceType, map[string]string{
+			deploy.AppTagKey: "my-app",
+			deploy.EnvTagKey: "test",
+		}).Return(nil, nil)
+	}
+	testCases := map[string]struct {
+		inEnvName string
+		inJSON    bool
+
+		setupMocks func(m secretListMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if fail to list environments": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments in application my-app: some error"),
+		},
+		"error if fail to get the tagged resources": {
+			inEnvName: "test",
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get SSM parameters in environment test: some error"),
+		},
+		"lists the secrets created by Copilot in all environments": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+				mockGetResources(m)
+			},
+			wantedContent: `Name         Store           Environments
+----         -----           ------------
+api_key      secretsmanager  prod
+db_password  ssm             prod, test
+`,
+		},
+		"lists the secrets in JSON format": {
+			inJSON: true,
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+				mockGetResources(m)
+			},
+			wantedContent: `{"secrets":[{"name":"api_key","store":"secretsmanager","environments":["prod"]},{"name":"db_password","store":"ssm","environments":["prod","test"]}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := secretListMocks{
+				store: mocks.NewMockstore(ctrl),
+				rg:    mocks.NewMocktaggedResourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &listSecretOpts{
+				listSecretVars: listSecretVars{
+					appName:          "my-app",
+					envName:          tc.inEnvName,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				finder: &secretFinder{
+					newClients: func(env *config.Environment) (*envSecretClients, error) {
+						return &envSecretClients{rg: m.rg}, nil
+					},
+					clients: make(map[string]*envSecretClients),
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
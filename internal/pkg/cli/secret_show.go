@@ -0,0 +1,416 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretShowAppNamePrompt     = "Which application's secret would you like to show?"
+	secretShowAppNamePromptHelp = "An application groups all of your environments and the secrets stored in them."
+	secretShowNamePrompt        = "Which secret would you like to show?"
+	secretShowNamePromptHelp    = "The metadata of the secret and the workloads that reference it will be shown. The value of the secret is never shown."
+)
+
+// humanizeTime is overridden in tests so that its output is constant as time passes.
+var humanizeTime = humanize.Time
+
+type showSecretVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type showSecretOpts struct {
+	showSecretVars
+
+	store  store
+	ws     wsWlManifestReader
+	sel    appSelector
+	prompt prompter
+	finder *secretFinder
+	w      io.Writer
+
+	newInterpolator func(app, env string) interpolator
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+
+	secrets []envSecret // Cached secrets of the application.
+}
+
+func newShowSecretOpts(vars showSecretVars) (*showSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &showSecretOpts{
+		showSecretVars:  vars,
+		store:           store,
+		ws:              ws,
+		sel:             selector.NewSelect(prompter, store),
+		prompt:          prompter,
+		finder:          newSecretFinder(sessProvider),
+		w:               os.Stdout,
+		newInterpolator: newManifestInterpolator,
+		unmarshal:       manifest.UnmarshalWorkload,
+	}, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *showSecretOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the application and the secret names if they're not provided.
+func (o *showSecretOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretShowAppNamePrompt, secretShowAppNamePromptHelp)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		return nil
+	}
+	name, err := askSecretName(o.prompt, o.listSecrets, o.appName, secretShowNamePrompt, secretShowNamePromptHelp)
+	if err != nil {
+		return err
+	}
+	o.name = name
+	return nil
+}
+
+// Execute shows the metadata of the secret in each environment, and the workloads in the workspace that reference it.
+func (o *showSecretOpts) Execute() error {
+	secrets, err := o.listSecrets()
+	if err != nil {
+		return err
+	}
+	secrets = filterSecretsByName(secrets, o.name)
+	if len(secrets) == 0 {
+		return fmt.Errorf("secret %s not found in application %s", o.name, o.appName)
+	}
+	desc := &secretDescription{
+		Name: o.name,
+	}
+	for _, secret := range secrets {
+		envDesc, err := o.describeInEnv(secret)
+		if err != nil {
+			return err
+		}
+		desc.Environments = append(desc.Environments, envDesc)
+	}
+	if desc.References, err = o.references(secrets); err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		data, err := desc.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, desc.HumanString())
+	return nil
+}
+
+func (o *showSecretOpts) listSecrets() ([]envSecret, error) {
+	if o.secrets != nil {
+		return o.secrets, nil
+	}
+	envs, err := targetSecretEnvs(o.store, o.appName, "")
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := o.finder.find(o.appName, envs)
+	if err != nil {
+		return nil, err
+	}
+	o.secrets = secrets
+	return secrets, nil
+}
+
+func (o *showSecretOpts) describeInEnv(secret envSecret) (*secretEnvDescription, error) {
+	clients := o.finder.clients[secret.envName] // Clients are configured by finder.find.
+	desc := &secretEnvDescription{
+		Environment:  secret.envName,
+		Store:        secret.store,
+		ResourceName: secret.resourceName,
+		ARN:          secret.arn,
+	}
+	if secret.store == secretStoreSSM {
+		metadata, err := clients.ssm.DescribeSecret(secret.resourceName)
+		if err != nil {
+			return nil, fmt.Errorf("describe secret %s in environment %s: %w", secret.name, secret.envName, err)
+		}
+		desc.LastModified = metadata.LastModifiedDate
+		desc.Version = metadata.Version
+		return desc, nil
+	}
+	sm, err := clients.secretsManagerClient(o.appName, secret.envName)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := sm.DescribeSecret(secret.resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("describe secret %s in environment %s: %w", secret.name, secret.envName, err)
+	}
+	desc.LastModified = metadata.LastChangedDate
+	if metadata.Rotation != nil {
+		desc.RotationLambdaARN = metadata.Rotation.LambdaARN
+		desc.RotationDays = metadata.Rotation.AfterDays
+	}
+	return desc, nil
+}
+
+// references returns the workloads in the workspace that inject the secrets in their container.
+// If the command is not run in a workspace, no references are returned.
+func (o *showSecretOpts) references(secrets []envSecret) ([]*secretReference, error) {
+	wls, err := o.ws.ListWorkloads()
+	if err != nil {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if errors.As(err, &errNoWorkspace) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	var refs []*secretReference
+	for _, secret := range secrets {
+		for _, wl := range wls {
+			mftSecrets, err := o.workloadSecrets(wl, secret.envName)
+			if err != nil {
+				return nil, err
+			}
+			vars := make([]string, 0, len(mftSecrets))
+			for name := range mftSecrets {
+				vars = append(vars, name)
+			}
+			sort.Strings(vars)
+			for _, name := range vars {
+				if !referencesSecret(mftSecrets[name], secret) {
+					continue
+				}
+				refs = append(refs, &secretReference{
+					Environment: secret.envName,
+					Workload:    wl,
+					Variable:    name,
+				})
+			}
+		}
+	}
+	return refs, nil
+}
+
+func (o *showSecretOpts) workloadSecrets(wlName, envName string) (map[string]manifest.Secret, error) {
+	raw, err := o.ws.ReadWorkloadManifest(wlName)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", wlName, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, envName).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", wlName, err)
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", wlName, err)
+	}
+	envMft, err := mft.ApplyEnv(envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override to manifest for %s: %w", envName, wlName, err)
+	}
+	type containerSecrets interface {
+		ContainerSecrets() map[string]manifest.Secret
+	}
+	withSecrets, ok := envMft.(containerSecrets)
+	if !ok { // Request-Driven Web Services don't support secrets.
+		return nil, nil
+	}
+	return withSecrets.ContainerSecrets(), nil
+}
+
+// referencesSecret returns true if the secret in a manifest refers to the secret created by "secret init".
+func referencesSecret(mftSecret manifest.Secret, secret envSecret) bool {
+	value := mftSecret.Value()
+	if mftSecret.IsSecretsManagerName() {
+		// The name can be followed by a JSON key, version stage and version ID.
+		return secret.store == secretStoreSecretsManager && (value == secret.resourceName || strings.HasPrefix(value, secret.resourceName+":"))
+	}
+	if secret.store == secretStoreSSM {
+		return value == secret.resourceName || value == secret.arn
+	}
+	return value == secret.arn || strings.HasPrefix(value, secret.arn+":")
+}
+
+// secretDescription contains the metadata of a secret and the workloads that reference it.
+type secretDescription struct {
+	Name         string                  `json:"name"`
+	Environments []*secretEnvDescription `json:"environments"`
+	References   []*secretReference      `json:"references"`
+}
+
+// secretEnvDescription contains the metadata of a secret in an environment.
+type secretEnvDescription struct {
+	Environment       string    `json:"environment"`
+	Store             string    `json:"store"`
+	ResourceName      string    `json:"resourceName"`
+	ARN               string    `json:"arn"`
+	LastModified      time.Time `json:"lastModified"`
+	Version           int64     `json:"version,omitempty"`
+	RotationLambdaARN string    `json:"rotationLambda,omitempty"`
+	RotationDays      int64     `json:"rotationDays,omitempty"`
+}
+
+// secretReference is a variable of a workload whose value is the secret in an environment.
+type secretReference struct {
+	Environment string `json:"environment"`
+	Workload    string `json:"workload"`
+	Variable    string `json:"variable"`
+}
+
+// JSONString returns the stringified secretDescription struct with json format.
+func (d *secretDescription) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal secret description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified secretDescription struct with human readable format.
+func (d *secretDescription) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", d.Name)
+	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "Name", "Store", "Resource", "Last Modified", "Rotation")
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "----", "-----", "--------", "-------------", "--------")
+	for _, env := range d.Environments {
+		rotation := "-"
+		if env.RotationLambdaARN != "" {
+			rotation = fmt.Sprintf("every %d days", env.RotationDays)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", env.Environment, env.Store, env.ResourceName, humanizeTime(env.LastModified), rotation)
+	}
+	writer.Flush()
+	if d.References == nil {
+		return b.String()
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nReferenced By\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Environment", "Workload", "Variable")
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "-----------", "--------", "--------")
+	for _, ref := range d.References {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", ref.Environment, ref.Workload, ref.Variable)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// askSecretName prompts the user to select one of the secrets of the application.
+func askSecretName(prompter prompter, listSecrets func() ([]envSecret, error), appName, msg, help string) (string, error) {
+	secrets, err := listSecrets()
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, summary := range summarizeSecrets(secrets) {
+		if len(names) != 0 && names[len(names)-1] == summary.Name {
+			continue
+		}
+		names = append(names, summary.Name)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("no secrets found in application %s", appName)
+	}
+	name, err := prompter.SelectOne(msg, help, names, prompt.WithFinalMessage("Secret:"))
+	if err != nil {
+		return "", fmt.Errorf("select secret: %w", err)
+	}
+	return name, nil
+}
+
+func filterSecretsByName(secrets []envSecret, name string) []envSecret {
+	var filtered []envSecret
+	for _, secret := range secrets {
+		if secret.name == name {
+			filtered = append(filtered, secret)
+		}
+	}
+	return filtered
+}
+
+// buildSecretShowCmd builds the command for showing the metadata of a secret.
+func buildSecretShowCmd() *cobra.Command {
+	vars := showSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the metadata of a secret and the workloads that reference it.",
+		Long: `Shows the metadata of a secret in each environment and the workloads that reference it.
+The value of the secret is never shown.`,
+		Example: `
+  Shows the metadata of the secret "db_password".
+  /code $ copilot secret show --name db_password`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", existingSecretNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretShowMocks struct {
+	store          *mocks.Mockstore
+	ws             *mocks.MockwsWlManifestReader
+	rg             *mocks.MocktaggedResourceGetter
+	ssm            *mocks.MockssmSecretDescribeDeleter
+	secretsManager *mocks.MocksecretsManagerSecretDescribeDeleter
+	envUpgrader    *mocks.MockactionCommand
+}
+
+func TestShowSecretOpts_Execute(t *testing.T) {
+	mockTime := time.Date(2022, 3, 14, 15, 9, 26, 0, time.UTC)
+	mockSecrets := func(m secretShowMocks) {
+		m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "prod"}, {Name: "test"}}, nil)
+		m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, gomock.Any()).Return(nil, nil)
+		m.rg.EXPECT().GetResourcesByTags(secretsManagerSecretResourceType, gomock.Any()).Return([]*resourcegroups.Resource{
+			{ARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/my-app/prod/secrets/db_password-AbCdEf"},
+		}, nil)
+		m.rg.EXPECT().GetResourcesByTags(ssmParameterResourceType, gomock.Any()).Return([]*resourcegroups.Resource{
+			{ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/my-app/test/secrets/db_password"},
+		}, nil)
+		m.rg.EXPECT().GetResourcesByTags(secretsManagerSecretResourceType, gomock.Any()).Return(nil, nil)
+	}
+	mockDescribe := func(m secretShowMocks) {
+		m.envUpgrader.EXPECT().Execute().Return(nil)
+		m.secretsManager.EXPECT().DescribeSecret("copilot/my-app/prod/secrets/db_password").Return(&secretsmanager.SecretMetadata{
+			LastChangedDate: mockTime,
+			Rotation: &secretsmanager.RotationConfig{
+				LambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+				AfterDays: 7,
+			},
+		}, nil)
+		m.ssm.EXPECT().DescribeSecret("/copilot/my-app/test/secrets/db_password").Return(&ssm.SecretMetadata{
+			LastModifiedDate: mockTime,
+			Version:          2,
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inName string
+		inJSON bool
+
+		setupMocks func(m secretShowMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the secret does not exist": {
+			inName:      "api_key",
+			setupMocks:  mockSecrets,
+			wantedError: errors.New("secret api_key not found in application my-app"),
+		},
+		"error if fail to describe the secret": {
+			inName: "db_password",
+			setupMocks: func(m secretShowMocks) {
+				mockSecrets(m)
+				m.envUpgrader.EXPECT().Execute().Return(nil)
+				m.secretsManager.EXPECT().DescribeSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe secret db_password in environment prod: some error"),
+		},
+		"omits references outside of a workspace": {
+			inName: "db_password",
+			inJSON: true,
+			setupMocks: func(m secretShowMocks) {
+				mockSecrets(m)
+				mockDescribe(m)
+				m.ws.EXPECT().ListWorkloads().Return(nil, &workspace.ErrWorkspaceNotFound{})
+			},
+			wantedContent: `{"name":"db_password","environments":[{"environment":"prod","store":"secretsmanager","resourceName":"copilot/my-app/prod/secrets/db_password","arn":"arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/my-app/prod/secrets/db_password-AbCdEf","lastModified":"2022-03-14T15:09:26Z","rotationLambda":"arn:aws:lambda:us-west-2:123456789012:function:rotate","rotationDays":7},{"environment":"test","store":"ssm","resourceName":"/copilot/my-app/test/secrets/db_password","arn":"arn:aws:ssm:us-west-2:123456789012:parameter/copilot/my-app/test/secrets/db_password","lastModified":"2022-03-14T15:09:26Z","version":2}],"references":null}` + "\n",
+		},
+		"shows the workloads that reference the secret": {
+			inName: "db_password",
+			setupMocks: func(m secretShowMocks) {
+				mockSecrets(m)
+				mockDescribe(m)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "frontend"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(`
+name: api
+type: Backend Service
+image:
+  build: Dockerfile
+secrets:
+  DB_PASSWORD: /copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/db_password
+environments:
+  prod:
+    secrets:
+      DB_PASSWORD:
+        secretsmanager: copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/db_password
+        key: password
+`), nil).Times(2)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(`
+name: frontend
+type: Request-Driven Web Service
+image:
+  build: Dockerfile
+  port: 80
+`), nil).Times(2)
+			},
+			wantedContent: `About
+
+  Name    db_password
+
+Environments
+
+  Name    Store           Resource                                  Last Modified  Rotation
+  ----    -----           --------                                  -------------  --------
+  prod    secretsmanager  copilot/my-app/prod/secrets/db_password   2 days ago     every 7 days
+  test    ssm             /copilot/my-app/test/secrets/db_password  2 days ago     -
+
+Referenced By
+
+  Environment  Workload  Variable
+  -----------  --------  --------
+  prod         api       DB_PASSWORD
+  test         api       DB_PASSWORD
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := secretShowMocks{
+				store:          mocks.NewMockstore(ctrl),
+				ws:             mocks.NewMockwsWlManifestReader(ctrl),
+				rg:             mocks.NewMocktaggedResourceGetter(ctrl),
+				ssm:            mocks.NewMockssmSecretDescribeDeleter(ctrl),
+				secretsManager: mocks.NewMocksecretsManagerSecretDescribeDeleter(ctrl),
+				envUpgrader:    mocks.NewMockactionCommand(ctrl),
+			}
+			tc.setupMocks(m)
+			oldHumanize := humanizeTime
+			humanizeTime = func(then time.Time) string {
+				return "2 days ago"
+			}
+			defer func() { humanizeTime = oldHumanize }()
+			b := &bytes.Buffer{}
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName:          "my-app",
+					name:             tc.inName,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				ws:    m.ws,
+				finder: &secretFinder{
+					newClients: func(env *config.Environment) (*envSecretClients, error) {
+						return &envSecretClients{
+							rg:             m.rg,
+							ssm:            m.ssm,
+							secretsManager: m.secretsManager,
+							envUpgrader:    m.envUpgrader,
+						}, nil
+					},
+					clients: make(map[string]*envSecretClients),
+				},
+				w:               b,
+				newInterpolator: newManifestInterpolator,
+				unmarshal:       manifest.UnmarshalWorkload,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -28,28 +28,51 @@ import (
 )
 
 const (
-	dynamoDBStorageType = "DynamoDB"
-	s3StorageType       = "S3"
-	rdsStorageType      = "Aurora"
+	dynamoDBStorageType   = "DynamoDB"
+	s3StorageType         = "S3"
+	rdsStorageType        = "Aurora"
+	redisStorageType      = "Redis"
+	openSearchStorageType = "OpenSearch"
+	sqsStorageType        = "SQS"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
+	openSearchStorageType,
+	sqsStorageType,
+}
+
+// Scopes of a storage resource.
+const (
+	storageScopeWorkload = "workload"
+	storageScopeEnv      = "env"
+)
+
+var storageScopes = []string{
+	storageScopeWorkload,
+	storageScopeEnv,
 }
 
 // Displayed options for storage types
 const (
-	dynamoDBStorageTypeOption = "DynamoDB"
-	s3StorageTypeOption       = "S3"
-	rdsStorageTypeOption      = "Aurora Serverless"
+	dynamoDBStorageTypeOption   = "DynamoDB"
+	s3StorageTypeOption         = "S3"
+	rdsStorageTypeOption        = "Aurora Serverless"
+	redisStorageTypeOption      = "ElastiCache Redis"
+	openSearchStorageTypeOption = "OpenSearch"
+	sqsStorageTypeOption        = "SQS"
 )
 
 var optionToStorageType = map[string]string{
-	dynamoDBStorageTypeOption: dynamoDBStorageType,
-	s3StorageTypeOption:       s3StorageType,
-	rdsStorageTypeOption:      rdsStorageType,
+	dynamoDBStorageTypeOption:   dynamoDBStorageType,
+	s3StorageTypeOption:         s3StorageType,
+	rdsStorageTypeOption:        rdsStorageType,
+	redisStorageTypeOption:      redisStorageType,
+	openSearchStorageTypeOption: openSearchStorageType,
+	sqsStorageTypeOption:        sqsStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -65,21 +88,40 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "Cache",
+	},
+	openSearchStorageType: {
+		Value: openSearchStorageTypeOption,
+		Hint:  "Search",
+	},
+	sqsStorageType: {
+		Value: sqsStorageTypeOption,
+		Hint:  "Queue",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Redis Replication Group"
+	openSearchFriendlyText    = "OpenSearch Domain"
+	sqsFriendlyText           = "SQS Queue"
 )
 
 // General-purpose prompts, collected for all storage resources.
 var (
 	fmtStorageInitTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to associate with %s?"
+	storageInitEnvTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to share across the workloads in an environment?"
 	storageInitTypeHelp      = `The type of storage you'd like to add to your workload. 
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache Redis is a fully managed in-memory data store, placed in your environment's private subnets.
+OpenSearch is a managed search and analytics engine, placed in your environment's private subnets.
+SQS is a fully managed message queue to decouple the producers and consumers of your messages.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -143,12 +185,27 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+var serverlessVersions = []string{
+	addon.RDSServerlessV1,
+	addon.RDSServerlessV2,
+}
+
+const maxAuroraReaders = 15
+
+// SQS specific questions and help prompts.
+var (
+	storageInitSQSFIFOConfirm = "Would you like to create a " + color.Emphasize("FIFO") + " queue?"
+	storageInitSQSFIFOHelp    = `FIFO (First-In-First-Out) queues preserve the order in which messages are sent and deliver each message exactly once.
+Standard queues support a nearly unlimited throughput but may deliver messages out of order or more than once.`
+)
+
 var errUnavailableAddonParams = errors.New("addon does not require parameters")
 
 type initStorageVars struct {
 	storageType  string
 	storageName  string
 	workloadName string
+	scope        string
 
 	// Dynamo DB specific values collected via flags or prompts
 	partitionKey string
@@ -157,10 +214,20 @@ type initStorageVars struct {
 	noLSI        bool
 	noSort       bool
 
-	// RDS Aurora Serverless specific values collected via flags or prompts
-	rdsEngine         string
-	rdsParameterGroup string
-	rdsInitialDBName  string
+	// RDS Aurora specific values collected via flags or prompts
+	rdsEngine            string
+	rdsParameterGroup    string
+	rdsInitialDBName     string
+	rdsServerlessVersion string
+	rdsMinCapacity       float64
+	rdsMaxCapacity       float64
+	rdsInstanceClass     string
+	rdsReaders           int
+	rdsSnapshot          string
+	rdsRotateSecret      bool
+
+	// SQS specific values collected via flags or prompts
+	sqsFIFO bool
 }
 
 type initStorageOpts struct {
@@ -174,6 +241,8 @@ type initStorageOpts struct {
 	sel    wsSelector
 	prompt prompter
 
+	promptForFIFO bool // True if the --fifo flag is not provided.
+
 	// Cached data.
 	workloadType string
 }
@@ -208,6 +277,12 @@ func (o *initStorageOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.scope != "" && !contains(o.scope, storageScopes) {
+		return fmt.Errorf("invalid scope %s: must be one of %s", o.scope, prettify(storageScopes))
+	}
+	if o.isEnvScoped() && o.workloadName != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s %s", workloadFlag, storageScopeFlag, storageScopeEnv)
+	}
 	if o.workloadName != "" {
 		if err := o.validateWorkloadName(); err != nil {
 			return err
@@ -227,6 +302,12 @@ func (o *initStorageOpts) Validate() error {
 			err = s3BucketNameValidation(o.storageName)
 		case rdsStorageType:
 			err = rdsNameValidation(o.storageName)
+		case redisStorageType:
+			err = redisNameValidation(o.storageName)
+		case openSearchStorageType:
+			err = openSearchNameValidation(o.storageName)
+		case sqsStorageType:
+			err = sqsQueueNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
 			err = dynamoTableNameValidation(o.storageName)
@@ -244,7 +325,7 @@ func (o *initStorageOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return o.validateRDS()
 }
 
 func (o *initStorageOpts) validateDDB() error {
@@ -275,6 +356,59 @@ func (o *initStorageOpts) validateDDB() error {
 	return nil
 }
 
+func (o *initStorageOpts) validateRDS() error {
+	if o.rdsServerlessVersion != "" {
+		if err := validateServerlessVersion(o.rdsServerlessVersion); err != nil {
+			return err
+		}
+	}
+	if o.rdsInstanceClass != "" {
+		// --instance-class and --serverless-version are mutually exclusive.
+		if o.rdsServerlessVersion != "" {
+			return fmt.Errorf("cannot specify both --%s and --%s", storageRDSInstanceClassFlag, storageRDSServerlessVersionFlag)
+		}
+		if err := validateInstanceClass(o.rdsInstanceClass); err != nil {
+			return err
+		}
+	}
+	isServerlessV2 := o.rdsInstanceClass == "" && o.rdsServerlessVersion == addon.RDSServerlessV2
+	if o.rdsMinCapacity != 0 || o.rdsMaxCapacity != 0 {
+		if !isServerlessV2 {
+			return fmt.Errorf("--%s and --%s can only be specified with --%s %s",
+				storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag, storageRDSServerlessVersionFlag, addon.RDSServerlessV2)
+		}
+		for flag, capacity := range map[string]float64{
+			storageRDSMinCapacityFlag: o.rdsMinCapacity,
+			storageRDSMaxCapacityFlag: o.rdsMaxCapacity,
+		} {
+			if capacity == 0 {
+				continue
+			}
+			if err := validateAuroraCapacity(capacity); err != nil {
+				return fmt.Errorf("validate --%s: %w", flag, err)
+			}
+		}
+		if o.rdsMinCapacity != 0 && o.rdsMaxCapacity != 0 && o.rdsMinCapacity > o.rdsMaxCapacity {
+			return fmt.Errorf("--%s %v cannot be greater than --%s %v", storageRDSMinCapacityFlag, o.rdsMinCapacity, storageRDSMaxCapacityFlag, o.rdsMaxCapacity)
+		}
+	}
+	if o.rdsReaders != 0 {
+		if o.rdsReaders < 0 || o.rdsReaders > maxAuroraReaders {
+			return fmt.Errorf("--%s must be between 0 and %d", storageRDSReadersFlag, maxAuroraReaders)
+		}
+		if o.rdsInstanceClass == "" && !isServerlessV2 {
+			return fmt.Errorf("--%s can only be specified with --%s or --%s %s",
+				storageRDSReadersFlag, storageRDSInstanceClassFlag, storageRDSServerlessVersionFlag, addon.RDSServerlessV2)
+		}
+	}
+	// A cluster restored from a snapshot keeps the master user password of the snapshot instead of the one in the secret,
+	// so the rotation function would not be able to sign in to the cluster.
+	if o.rdsSnapshot != "" && o.rdsRotateSecret {
+		return fmt.Errorf("cannot specify both --%s and --%s", storageRDSSnapshotFlag, storageRDSRotateSecretFlag)
+	}
+	return nil
+}
+
 func (o *initStorageOpts) Ask() error {
 	if err := o.askStorageWl(); err != nil {
 		return err
@@ -306,6 +440,10 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.askAuroraInitialDBName(); err != nil {
 			return err
 		}
+	case sqsStorageType:
+		if err := o.askSQSFIFO(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -318,8 +456,11 @@ func (o *initStorageOpts) askStorageType() error {
 	for _, st := range storageTypes {
 		options = append(options, storageTypeOptions[st])
 	}
-	storageTypeOption, err := o.prompt.SelectOption(fmt.Sprintf(
-		fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName)),
+	typePrompt := fmt.Sprintf(fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName))
+	if o.isEnvScoped() {
+		typePrompt = storageInitEnvTypePrompt
+	}
+	storageTypeOption, err := o.prompt.SelectOption(typePrompt,
 		storageInitTypeHelp,
 		options,
 		prompt.WithFinalMessage("Storage type:"))
@@ -377,7 +518,20 @@ func (o *initStorageOpts) askStorageName() error {
 		validator = dynamoTableNameValidation
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
-		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+		defaultName := fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName)
+		if o.isEnvScoped() {
+			defaultName = fmt.Sprintf(fmtRDSStorageNameDefault, o.appName)
+		}
+		return o.askStorageNameWithDefault(rdsFriendlyText, defaultName, rdsNameValidation)
+	case redisStorageType:
+		validator = redisNameValidation
+		friendlyText = redisFriendlyText
+	case openSearchStorageType:
+		validator = openSearchNameValidation
+		friendlyText = openSearchFriendlyText
+	case sqsStorageType:
+		validator = sqsQueueNameValidation
+		friendlyText = sqsFriendlyText
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -393,7 +547,7 @@ func (o *initStorageOpts) askStorageName() error {
 }
 
 func (o *initStorageOpts) askStorageWl() error {
-	if o.workloadName != "" {
+	if o.workloadName != "" || o.isEnvScoped() {
 		return nil
 	}
 	workload, err := o.sel.Workload(storageInitSvcPrompt, "")
@@ -586,6 +740,18 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) askSQSFIFO() error {
+	if !o.promptForFIFO {
+		return nil
+	}
+	fifo, err := o.prompt.Confirm(storageInitSQSFIFOConfirm, storageInitSQSFIFOHelp, prompt.WithFinalMessage("FIFO queue:"))
+	if err != nil {
+		return fmt.Errorf("confirm FIFO queue: %w", err)
+	}
+	o.sqsFIFO = fifo
+	return nil
+}
+
 func (o *initStorageOpts) validateWorkloadName() error {
 	names, err := o.ws.ListWorkloads()
 	if err != nil {
@@ -600,8 +766,10 @@ func (o *initStorageOpts) validateWorkloadName() error {
 }
 
 func (o *initStorageOpts) Execute() error {
-	if err := o.readWorkloadType(); err != nil {
-		return err
+	if !o.isEnvScoped() {
+		if err := o.readWorkloadType(); err != nil {
+			return err
+		}
 	}
 
 	addonBlobs, err := o.addonBlobs()
@@ -609,7 +777,7 @@ func (o *initStorageOpts) Execute() error {
 		return err
 	}
 	for _, addon := range addonBlobs {
-		path, err := o.ws.WriteAddon(addon.blob, o.workloadName, addon.name)
+		path, err := o.writeAddon(addon)
 		if err != nil {
 			e, ok := err.(*workspace.ErrFileExists)
 			if !ok {
@@ -630,6 +798,18 @@ func (o *initStorageOpts) Execute() error {
 	return nil
 }
 
+func (o *initStorageOpts) writeAddon(addon addonBlob) (string, error) {
+	if o.isEnvScoped() {
+		return o.ws.WriteEnvAddon(addon.blob, addon.name)
+	}
+	return o.ws.WriteAddon(addon.blob, o.workloadName, addon.name)
+}
+
+// isEnvScoped returns true if the storage resource is shared by the workloads in an environment.
+func (o *initStorageOpts) isEnvScoped() bool {
+	return o.scope == storageScopeEnv
+}
+
 type addonBlob struct {
 	name        string
 	description string
@@ -672,6 +852,12 @@ func (o *initStorageOpts) newAddonTemplate() (encoding.BinaryMarshaler, error) {
 		templateBlob, err = o.newS3Template()
 	case rdsStorageType:
 		templateBlob, err = o.newRDSTemplate()
+	case redisStorageType:
+		templateBlob, err = o.newRedisTemplate()
+	case openSearchStorageType:
+		templateBlob, err = o.newOpenSearchTemplate()
+	case sqsStorageType:
+		templateBlob, err = o.newSQSTemplate()
 	}
 	if err != nil {
 		return nil, err
@@ -680,12 +866,13 @@ func (o *initStorageOpts) newAddonTemplate() (encoding.BinaryMarshaler, error) {
 }
 
 func (o *initStorageOpts) newAddonParams() (encoding.BinaryMarshaler, error) {
-	if o.storageType != rdsStorageType {
+	if !isVPCStorageType(o.storageType) {
 		return nil, errUnavailableAddonParams
 	}
 	if o.workloadType != manifest.RequestDrivenWebServiceType {
 		return nil, errUnavailableAddonParams
 	}
+	// The security group of the VPC connector is passed to every storage that lives in the VPC.
 	return addon.NewRDSParams(), nil
 }
 
@@ -741,15 +928,56 @@ func (o *initStorageOpts) newRDSTemplate() (*addon.RDSTemplate, error) {
 	}
 
 	return addon.NewRDSTemplate(addon.RDSProps{
-		ClusterName:    o.storageName,
-		Engine:         engine,
-		InitialDBName:  o.rdsInitialDBName,
-		ParameterGroup: o.rdsParameterGroup,
-		Envs:           envs,
-		WorkloadType:   o.workloadType,
+		ClusterName:        o.storageName,
+		Engine:             engine,
+		InitialDBName:      o.rdsInitialDBName,
+		ParameterGroup:     o.rdsParameterGroup,
+		Envs:               envs,
+		WorkloadType:       o.workloadType,
+		ServerlessVersion:  o.rdsServerlessVersion,
+		MinCapacity:        o.rdsMinCapacity,
+		MaxCapacity:        o.rdsMaxCapacity,
+		InstanceClass:      o.rdsInstanceClass,
+		ReaderCount:        o.rdsReaders,
+		SnapshotIdentifier: o.rdsSnapshot,
+		RotateSecret:       o.rdsRotateSecret,
 	}), nil
 }
 
+func (o *initStorageOpts) newRedisTemplate() (*addon.RedisTemplate, error) {
+	envs, err := o.environmentNames()
+	if err != nil {
+		return nil, err
+	}
+	return addon.NewRedisTemplate(addon.RedisProps{
+		Name:         o.storageName,
+		Envs:         envs,
+		WorkloadType: o.workloadType,
+	}), nil
+}
+
+func (o *initStorageOpts) newOpenSearchTemplate() (*addon.OpenSearchTemplate, error) {
+	envs, err := o.environmentNames()
+	if err != nil {
+		return nil, err
+	}
+	return addon.NewOpenSearchTemplate(addon.OpenSearchProps{
+		Name:         o.storageName,
+		Envs:         envs,
+		WorkloadType: o.workloadType,
+	}), nil
+}
+
+func (o *initStorageOpts) newSQSTemplate() (*addon.SQSTemplate, error) {
+	props := &addon.SQSProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		FIFO: o.sqsFIFO,
+	}
+	return addon.NewSQSTemplate(props), nil
+}
+
 func (o *initStorageOpts) environmentNames() ([]string, error) {
 	var envNames []string
 	envs, err := o.store.ListEnvironments(o.appName)
@@ -796,8 +1024,29 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+	case redisStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		portVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Port")
+		retrieveEnvVarCode = fmt.Sprintf("const url = `rediss://${process.env.%s}:${process.env.%s}`", newVar, portVar)
+	case openSearchStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		retrieveEnvVarCode = fmt.Sprintf("const node = `https://${process.env.%s}`", newVar)
+	case sqsStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "QueueUrl")
+		retrieveEnvVarCode = fmt.Sprintf("const queueUrl = process.env.%s", newVar)
+	}
+
+	if o.isEnvScoped() {
+		logRecommendedActions([]string{
+			fmt.Sprintf("Run %s to deploy your storage resources to an environment.", color.HighlightCode("copilot env deploy")),
+			fmt.Sprintf(`Grant a workload access to the storage by adding it to the workload's manifest:
+%s`, color.HighlightCodeBlock(fmt.Sprintf("storage:\n  env_addons:\n    - %s", o.storageName))),
+			fmt.Sprintf(`Update the workload's code to leverage the injected environment variable %s.
+For example, in JavaScript you can write:
+%s`, newVar, color.HighlightCodeBlock(retrieveEnvVarCode)),
+		})
+		return nil
 	}
-
 	actionRetrieveEnvVar := fmt.Sprintf(
 		`Update %s's code to leverage the injected environment variable %s.
 For example, in JavaScript you can write:
@@ -823,7 +1072,9 @@ func buildStorageInitCmd() *cobra.Command {
 		Short: "Creates a new AWS CloudFormation template for a storage resource.",
 		Long: `Creates a new AWS CloudFormation template for a storage resource.
 Storage resources are stored in the Copilot addons directory (e.g. ./copilot/frontend/addons) for a given workload and deployed to your environments when you run ` + color.HighlightCode("copilot deploy") + `. 
-Resource names are injected into your containers as environment variables for easy access.`,
+Resource names are injected into your containers as environment variables for easy access.
+Storage resources with the "env" scope are stored in ./copilot/environments/addons, deployed with ` + color.HighlightCode("copilot env deploy") + `,
+and shared by the workloads that list them under "storage.env_addons" in their manifest.`,
 		Example: `
   Create an S3 bucket named "my-bucket" attached to the "frontend" service.
   /code $ copilot storage init -n my-bucket -t S3 -w frontend
@@ -832,18 +1083,32 @@ Resource names are injected into your containers as environment variables for ea
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create an Aurora Serverless v2 cluster that scales between 0.5 and 16 ACUs with one reader instance.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine MySQL --serverless-version v2 --max-capacity 16 --readers 1
+  Create a provisioned Aurora cluster restored from a snapshot.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --instance-class db.r6g.large --snapshot my-snapshot
+  Create a provisioned Aurora cluster whose credentials are rotated every 30 days.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --instance-class db.r6g.large --rotate-secret
+  Create an ElastiCache Redis replication group attached to the "frontend" service.
+  /code $ copilot storage init -n my-cache -t Redis -w frontend
+  Create a FIFO SQS queue attached to the "worker" service.
+  /code $ copilot storage init -n my-queue -t SQS -w worker --fifo
+  Create an S3 bucket named "shared-bucket" that is shared by the workloads in an environment.
+  /code $ copilot storage init -n shared-bucket -t S3 --scope env`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.promptForFIFO = !cmd.Flags().Changed(storageSQSFIFOFlag)
 			return run(opts)
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.storageName, nameFlag, nameFlagShort, "", storageFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageType, storageTypeFlag, typeFlagShort, "", storageTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", storageWorkloadFlagDescription)
+	cmd.Flags().StringVar(&vars.scope, storageScopeFlag, "", storageScopeFlagDescription)
 
 	cmd.Flags().StringVar(&vars.partitionKey, storagePartitionKeyFlag, "", storagePartitionKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.sortKey, storageSortKeyFlag, "", storageSortKeyFlagDescription)
@@ -854,11 +1119,21 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringVar(&vars.rdsEngine, storageRDSEngineFlag, "", storageRDSEngineFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsServerlessVersion, storageRDSServerlessVersionFlag, "", storageRDSServerlessVersionFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMinCapacity, storageRDSMinCapacityFlag, 0, storageRDSMinCapacityFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMaxCapacity, storageRDSMaxCapacityFlag, 0, storageRDSMaxCapacityFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsInstanceClass, storageRDSInstanceClassFlag, "", storageRDSInstanceClassFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsReaders, storageRDSReadersFlag, 0, storageRDSReadersFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsSnapshot, storageRDSSnapshotFlag, "", storageRDSSnapshotFlagDescription)
+	cmd.Flags().BoolVar(&vars.rdsRotateSecret, storageRDSRotateSecretFlag, false, storageRDSRotateSecretFlagDescription)
+
+	cmd.Flags().BoolVar(&vars.sqsFIFO, storageSQSFIFOFlag, false, storageSQSFIFOFlagDescription)
 
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(workloadFlag))
+	requiredFlags.AddFlag(cmd.Flags().Lookup(storageScopeFlag))
 
 	ddbFlags := pflag.NewFlagSet("DynamoDB", pflag.ContinueOnError)
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storagePartitionKeyFlag))
@@ -867,17 +1142,28 @@ Resource names are injected into your containers as environment variables for ea
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageLSIConfigFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoLSIFlag))
 
-	auroraFlags := pflag.NewFlagSet("Aurora Serverless", pflag.ContinueOnError)
+	auroraFlags := pflag.NewFlagSet("Aurora", pflag.ContinueOnError)
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSEngineFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSServerlessVersionFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSMinCapacityFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSMaxCapacityFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInstanceClassFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSReadersFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSSnapshotFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSRotateSecretFlag))
+
+	sqsFlags := pflag.NewFlagSet("SQS", pflag.ContinueOnError)
+	sqsFlags.AddFlag(cmd.Flags().Lookup(storageSQSFIFOFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless`,
-		"Required":          requiredFlags.FlagUsages(),
-		"DynamoDB":          ddbFlags.FlagUsages(),
-		"Aurora Serverless": auroraFlags.FlagUsages(),
+		"sections": `Required,DynamoDB,Aurora,SQS`,
+		"Required": requiredFlags.FlagUsages(),
+		"DynamoDB": ddbFlags.FlagUsages(),
+		"Aurora":   auroraFlags.FlagUsages(),
+		"SQS":      sqsFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
@@ -30,6 +30,15 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoSort      bool
 		inNoLSI       bool
 		inEngine      string
+		inScope       string
+
+		inServerlessVersion string
+		inMinCapacity       float64
+		inMaxCapacity       float64
+		inInstanceClass     string
+		inReaders           int
+		inSnapshot          string
+		inRotateSecret      bool
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -164,6 +173,22 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			inNoSort:      true,
 			wantedErr:     fmt.Errorf("validate LSI configuration: cannot specify --no-sort and --lsi options at once"),
 		},
+		"invalid SQS queue name": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: sqsStorageType,
+			inStorageName: "my.queue",
+			wantedErr:     errValueBadFormatWithUnderscore,
+		},
+		"invalid Redis name": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: redisStorageType,
+			inStorageName: "1cache",
+			wantedErr:     errInvalidRDSNameCharacters,
+		},
 		"invalid database engine type": {
 			inAppName: "meow",
 			inEngine:  "mysql",
@@ -173,6 +198,131 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid scope": {
+			inAppName: "bowie",
+			inScope:   "app",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid scope app: must be one of \"workload\", \"env\""),
+		},
+		"cannot specify a workload for environment scoped storage": {
+			inAppName: "bowie",
+			inScope:   storageScopeEnv,
+			inSvcName: "frontend",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify both --workload and --scope env"),
+		},
+		"successfully validates environment scoped storage": {
+			inAppName:     "bowie",
+			inScope:       storageScopeEnv,
+			inStorageType: rdsStorageType,
+			inStorageName: "mycluster",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"invalid serverless version": {
+			inAppName:           "bowie",
+			inServerlessVersion: "v3",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid serverless version v3: must be one of \"v1\", \"v2\""),
+		},
+		"cannot specify both an instance class and a serverless version": {
+			inAppName:           "bowie",
+			inServerlessVersion: "v2",
+			inInstanceClass:     "db.r6g.large",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify both --instance-class and --serverless-version"),
+		},
+		"invalid instance class": {
+			inAppName:       "bowie",
+			inInstanceClass: "r6g.large",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid DB instance class r6g.large: must be of the form db.<class>.<size>"),
+		},
+		"capacity requires serverless v2": {
+			inAppName:     "bowie",
+			inMaxCapacity: 16,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--min-capacity and --max-capacity can only be specified with --serverless-version v2"),
+		},
+		"invalid capacity": {
+			inAppName:           "bowie",
+			inServerlessVersion: "v2",
+			inMinCapacity:       0.7,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("validate --min-capacity: value must be between 0.5 and 128 in increments of 0.5"),
+		},
+		"min capacity greater than max capacity": {
+			inAppName:           "bowie",
+			inServerlessVersion: "v2",
+			inMinCapacity:       16,
+			inMaxCapacity:       8,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--min-capacity 16 cannot be greater than --max-capacity 8"),
+		},
+		"too many readers": {
+			inAppName:       "bowie",
+			inInstanceClass: "db.r6g.large",
+			inReaders:       16,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--readers must be between 0 and 15"),
+		},
+		"readers are not supported by serverless v1": {
+			inAppName: "bowie",
+			inReaders: 1,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--readers can only be specified with --instance-class or --serverless-version v2"),
+		},
+		"cannot rotate the secret of a cluster restored from a snapshot": {
+			inAppName:      "bowie",
+			inSnapshot:     "my-snapshot",
+			inRotateSecret: true,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify both --snapshot and --rotate-secret"),
+		},
+		"successfully validates serverless v2 options": {
+			inAppName:           "bowie",
+			inServerlessVersion: "v2",
+			inMinCapacity:       0.5,
+			inMaxCapacity:       16,
+			inReaders:           1,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -194,6 +344,15 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					rdsEngine:    tc.inEngine,
+					scope:        tc.inScope,
+
+					rdsServerlessVersion: tc.inServerlessVersion,
+					rdsMinCapacity:       tc.inMinCapacity,
+					rdsMaxCapacity:       tc.inMaxCapacity,
+					rdsInstanceClass:     tc.inInstanceClass,
+					rdsReaders:           tc.inReaders,
+					rdsSnapshot:          tc.inSnapshot,
+					rdsRotateSecret:      tc.inRotateSecret,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -239,6 +398,11 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 		inDBEngine      string
 		inInitialDBName string
 
+		inFIFO        bool
+		promptForFIFO bool
+
+		inScope string
+
 		mockPrompt func(m *mocks.Mockprompter)
 		mockCfg    func(m *mocks.MockwsSelector)
 		mockWS     func(m *mocks.MockwsAddonManager)
@@ -266,6 +430,18 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "Cache",
+					},
+					{
+						Value: openSearchStorageTypeOption,
+						Hint:  "Search",
+					},
+					{
+						Value: sqsStorageTypeOption,
+						Hint:  "Queue",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -297,6 +473,26 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"does not ask for a workload if the storage is environment scoped": {
+			inAppName:       wantedAppName,
+			inScope:         storageScopeEnv,
+			inDBEngine:      wantedDBEngine,
+			inInitialDBName: wantedInitialDBName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOption(gomock.Eq(storageInitEnvTypePrompt), gomock.Any(), gomock.Any(), gomock.Any()).Return(rdsStorageTypeOption, nil)
+				m.EXPECT().Get(gomock.Eq("What would you like to name this Database Cluster?"), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("ddos-cluster", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:      rdsStorageType,
+				storageName:      "ddos-cluster",
+				scope:            storageScopeEnv,
+				rdsEngine:        wantedDBEngine,
+				rdsInitialDBName: wantedInitialDBName,
+			},
+		},
 		"error if svc not returned": {
 			inAppName:     wantedAppName,
 			inStorageName: wantedBucketName,
@@ -851,6 +1047,76 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: fmt.Errorf("input initial database name: some error"),
 		},
+		"asks whether the queue is FIFO if the flag is not provided": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+			inStorageType: sqsStorageType,
+			promptForFIFO: true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitSQSFIFOConfirm, storageInitSQSFIFOHelp, gomock.Any()).Return(true, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:  sqsStorageType,
+				storageName:  "my-queue",
+				workloadName: wantedSvcName,
+				sqsFIFO:      true,
+			},
+		},
+		"does not ask whether the queue is FIFO if the flag is provided": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+			inStorageType: sqsStorageType,
+			inFIFO:        true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:  sqsStorageType,
+				storageName:  "my-queue",
+				workloadName: wantedSvcName,
+				sqsFIFO:      true,
+			},
+		},
+		"error if fail to confirm FIFO queue": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+			inStorageType: sqsStorageType,
+			promptForFIFO: true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitSQSFIFOConfirm, storageInitSQSFIFOHelp, gomock.Any()).Return(false, errors.New("some error"))
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: errors.New("confirm FIFO queue: some error"),
+		},
+		"asks for a Redis name": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(fmt.Sprintf(fmtStorageInitNamePrompt, color.HighlightUserInput(redisFriendlyText)), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("my-cache", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Backend Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:  redisStorageType,
+				storageName:  "my-cache",
+				workloadName: wantedSvcName,
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -874,11 +1140,15 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 					rdsEngine:        tc.inDBEngine,
 					rdsInitialDBName: tc.inInitialDBName,
+
+					sqsFIFO: tc.inFIFO,
+					scope:   tc.inScope,
 				},
-				appName: tc.inAppName,
-				sel:     mockConfig,
-				prompt:  mockPrompt,
-				ws:      mockWS,
+				appName:       tc.inAppName,
+				sel:           mockConfig,
+				prompt:        mockPrompt,
+				ws:            mockWS,
+				promptForFIFO: tc.promptForFIFO,
 			}
 			tc.mockPrompt(mockPrompt)
 			tc.mockCfg(mockConfig)
@@ -925,6 +1195,8 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inInitialDBName  string
 		inParameterGroup string
 
+		inScope string
+
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
 
@@ -1008,6 +1280,56 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: nil,
 		},
+		"happy calls for Redis with a RDWS": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Request-Driven Web Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mycache").Return("/frontend/addons/mycache.yml", nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "addons.parameters").Return("/frontend/addons/addons.parameters.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).AnyTimes()
+			},
+		},
+		"happy calls for OpenSearch with LBWS": {
+			inSvcName:     wantedSvcName,
+			inStorageType: openSearchStorageType,
+			inStorageName: "mysearch",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "mysearch").Return("/frontend/addons/mysearch.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).AnyTimes()
+			},
+		},
+		"happy calls for SQS": {
+			inSvcName:     wantedSvcName,
+			inStorageType: sqsStorageType,
+			inStorageName: "my-queue",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Request-Driven Web Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-queue").Return("/frontend/addons/my-queue.yml", nil)
+			},
+		},
+		"error if fail to list environments for Redis": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Backend Service"), nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments: some error"),
+		},
 		"error addon exists": {
 			inAppName:     wantedAppName,
 			inStorageType: s3StorageType,
@@ -1024,6 +1346,16 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: fmt.Errorf("addon file already exists: %w", fileExistsError),
 		},
+		"writes environment scoped storage under the environments addons directory": {
+			inAppName:     wantedAppName,
+			inStorageType: s3StorageType,
+			inStorageName: "my-bucket",
+			inScope:       storageScopeEnv,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteEnvAddon(gomock.Any(), "my-bucket").Return("/environments/addons/my-bucket.yml", nil)
+			},
+		},
 		"unexpected read workload manifest error handled": {
 			inAppName:     wantedAppName,
 			inStorageType: s3StorageType,
@@ -1071,6 +1403,8 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 					rdsEngine:         tc.inEngine,
 					rdsParameterGroup: tc.inParameterGroup,
+
+					scope: tc.inScope,
 				},
 				appName: tc.inAppName,
 				ws:      mockAddon,
@@ -0,0 +1,226 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	containerPathPrefix = ":"
+
+	// Files are copied as base64 text through commands run in the container,
+	// so uploads are split into chunks that fit in a single command.
+	cpUploadChunkSize = 3000
+	cpMaxUploadBytes  = 256 * 1024
+
+	cpBeginMarker = "__COPILOT_CP_BEGIN__"
+	cpEndMarker   = "__COPILOT_CP_END__"
+)
+
+type svcCpOpts struct {
+	*svcExecOpts
+	src string
+	dst string
+
+	fs *afero.Afero
+}
+
+func newSvcCpOpts(vars execVars, src, dst string) (*svcCpOpts, error) {
+	execOpts, err := newSvcSessionOpts(vars, "svc cp")
+	if err != nil {
+		return nil, err
+	}
+	return &svcCpOpts{
+		svcExecOpts: execOpts,
+		src:         src,
+		dst:         dst,
+		fs:          &afero.Afero{Fs: afero.NewOsFs()},
+	}, nil
+}
+
+// Validate returns an error for any invalid arguments or optional flags.
+func (o *svcCpOpts) Validate() error {
+	srcInContainer, dstInContainer := isContainerPath(o.src), isContainerPath(o.dst)
+	if srcInContainer == dstInContainer {
+		return fmt.Errorf(`exactly one of the source or destination must be a path in the container prefixed with "%s"`, containerPathPrefix)
+	}
+	if strings.TrimPrefix(o.src, containerPathPrefix) == "" || strings.TrimPrefix(o.dst, containerPathPrefix) == "" {
+		return errors.New("source and destination paths cannot be empty")
+	}
+	if dstInContainer {
+		info, err := o.fs.Stat(o.src)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", o.src, err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("%s is a directory, only files can be copied", o.src)
+		}
+		if info.Size() > cpMaxUploadBytes {
+			return fmt.Errorf("file %s is %d bytes, files copied to a container cannot be larger than %d bytes", o.src, info.Size(), cpMaxUploadBytes)
+		}
+	}
+	return o.svcExecOpts.Validate()
+}
+
+// Execute copies a file between the local file system and a running container.
+func (o *svcCpOpts) Execute() error {
+	sess, svcDesc, err := o.describeService()
+	if err != nil {
+		return err
+	}
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	executor := o.newCommandExecutor(sess)
+	run := func(command string, output *bytes.Buffer) error {
+		if err := executor.ExecuteCommand(awsecs.ExecuteCommandInput{
+			Cluster:   svcDesc.ClusterName,
+			Command:   command,
+			Container: container,
+			Task:      taskID,
+			Output:    output,
+		}); err != nil {
+			var errExecCmd *awsecs.ErrExecuteCommand
+			if errors.As(err, &errExecCmd) {
+				log.Errorf("Failed to copy %s to %s. Is %s set in your manifest?\n", o.src, o.dst, color.HighlightCode("exec: true"))
+			}
+			return fmt.Errorf("execute command in container %s: %w", container, err)
+		}
+		return nil
+	}
+	if isContainerPath(o.src) {
+		err = o.download(run)
+	} else {
+		err = o.upload(run)
+	}
+	if err != nil {
+		return err
+	}
+	log.Successf("Copied %s to %s in task %s.\n", color.HighlightUserInput(o.src), color.HighlightUserInput(o.dst), color.HighlightResource(taskID))
+	return nil
+}
+
+func (o *svcCpOpts) download(run func(command string, output *bytes.Buffer) error) error {
+	src := strings.TrimPrefix(o.src, containerPathPrefix)
+	var out bytes.Buffer
+	if err := run(shellCommand(fmt.Sprintf("echo %s && base64 %s && echo %s", cpBeginMarker, shellQuote(src), cpEndMarker)), &out); err != nil {
+		return err
+	}
+	content := out.String()
+	begin, end := strings.Index(content, cpBeginMarker), strings.LastIndex(content, cpEndMarker)
+	if begin == -1 || end == -1 || end < begin {
+		return fmt.Errorf("read %s from the container: %s", src, strings.TrimSpace(content))
+	}
+	// Drop the line breaks added by base64 and the terminal.
+	encoded := strings.Join(strings.Fields(content[begin+len(cpBeginMarker):end]), "")
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("decode %s: %w", src, err)
+	}
+	if err := o.fs.WriteFile(o.dst, data, 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", o.dst, err)
+	}
+	return nil
+}
+
+func (o *svcCpOpts) upload(run func(command string, output *bytes.Buffer) error) error {
+	dst := strings.TrimPrefix(o.dst, containerPathPrefix)
+	data, err := o.fs.ReadFile(o.src)
+	if err != nil {
+		return fmt.Errorf("read file %s: %w", o.src, err)
+	}
+	tmp := dst + ".copilot-cp"
+	encoded := base64.StdEncoding.EncodeToString(data)
+	redirect := ">"
+	for start := 0; start < len(encoded); start += cpUploadChunkSize {
+		end := start + cpUploadChunkSize
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		// The base64 alphabet doesn't contain any character that the shell interprets.
+		if err := run(shellCommand(fmt.Sprintf("printf %%s %s %s %s", encoded[start:end], redirect, shellQuote(tmp))), &bytes.Buffer{}); err != nil {
+			return err
+		}
+		redirect = ">>"
+	}
+	if len(encoded) == 0 {
+		if err := run(shellCommand(fmt.Sprintf(": > %s", shellQuote(tmp))), &bytes.Buffer{}); err != nil {
+			return err
+		}
+	}
+	return run(shellCommand(fmt.Sprintf("base64 -d %s > %s && rm %s", shellQuote(tmp), shellQuote(dst), shellQuote(tmp))), &bytes.Buffer{})
+}
+
+func isContainerPath(path string) bool {
+	return strings.HasPrefix(path, containerPathPrefix)
+}
+
+// shellCommand returns a command that runs the script with "/bin/sh".
+func shellCommand(script string) string {
+	return "/bin/sh -c " + shellQuote(script)
+}
+
+// shellQuote wraps s in single quotes so that the shell reads it as a single word without expanding it.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// buildSvcCpCmd builds the command for copying files to and from a running container part of a service.
+func buildSvcCpCmd() *cobra.Command {
+	vars := execVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "cp <source> <destination>",
+		Short: "Copy a file to or from a running container part of a service.",
+		Long: `Copy a file to or from a running container part of a service.
+Prefix the path in the container with ":".`,
+		Example: `
+  Download the file "/tmp/heap.hprof" from the "api" service.
+  /code $ copilot svc cp -a my-app -e test -n api :/tmp/heap.hprof ./heap.hprof
+  Upload "config.json" into the "nginx" sidecar container of the task prefixed with ID "8c38184" within the "frontend" service.
+  /code $ copilot svc cp -n frontend --task-id 8c38184 --container nginx ./config.json :/etc/nginx/config.json`,
+		Args: cobra.ExactArgs(2),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcCpOpts(vars, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,286 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		src     string
+		dst     string
+		setupFs func(fs afero.Fs)
+
+		wantedError error
+	}{
+		"return error if neither path is in the container": {
+			src: "./a.txt",
+			dst: "./b.txt",
+
+			wantedError: errors.New(`exactly one of the source or destination must be a path in the container prefixed with ":"`),
+		},
+		"return error if both paths are in the container": {
+			src: ":/tmp/a.txt",
+			dst: ":/tmp/b.txt",
+
+			wantedError: errors.New(`exactly one of the source or destination must be a path in the container prefixed with ":"`),
+		},
+		"return error if the container path is empty": {
+			src: ":",
+			dst: "./a.txt",
+
+			wantedError: errors.New("source and destination paths cannot be empty"),
+		},
+		"return error if the local file does not exist": {
+			src: "a.txt",
+			dst: ":/tmp/a.txt",
+
+			wantedError: errors.New("stat a.txt: open a.txt: file does not exist"),
+		},
+		"return error if the local path is a directory": {
+			src: "dir",
+			dst: ":/tmp/dir",
+			setupFs: func(fs afero.Fs) {
+				_ = fs.MkdirAll("dir", 0755)
+			},
+
+			wantedError: errors.New("dir is a directory, only files can be copied"),
+		},
+		"return error if the local file is too large": {
+			src: "large.bin",
+			dst: ":/tmp/large.bin",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "large.bin", make([]byte, cpMaxUploadBytes+1), 0644)
+			},
+
+			wantedError: fmt.Errorf("file large.bin is 262145 bytes, files copied to a container cannot be larger than 262144 bytes"),
+		},
+		"success with a download": {
+			src: ":/tmp/heap.hprof",
+			dst: "heap.hprof",
+		},
+		"success with an upload": {
+			src: "config.json",
+			dst: ":/etc/config.json",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "config.json", []byte("{}"), 0644)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			opts := &svcCpOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						skipConfirmation: aws.Bool(false),
+					},
+				},
+				src: tc.src,
+				dst: tc.dst,
+				fs:  &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcCp_Execute(t *testing.T) {
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Backend Service",
+	}
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"),
+				LastStatus: aws.String("RUNNING"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		src        string
+		dst        string
+		setupFs    func(fs afero.Fs)
+		setupMocks func(m *mocks.MockecsCommandExecutor)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"return error if fail to execute command": {
+			src: ":/tmp/a.txt",
+			dst: "a.txt",
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("execute command in container mockSvc: some error"),
+		},
+		"return error if the file cannot be read in the container": {
+			src: ":/tmp/a.txt",
+			dst: "a.txt",
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+					fmt.Fprint(in.Output, "__COPILOT_CP_BEGIN__\r\nbase64: /tmp/a.txt: No such file or directory\r\n")
+					return nil
+				})
+			},
+
+			wantedError: errors.New("read /tmp/a.txt from the container: __COPILOT_CP_BEGIN__\r\nbase64: /tmp/a.txt: No such file or directory"),
+		},
+		"download a file": {
+			src: ":/tmp/a.txt",
+			dst: "a.txt",
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+					require.Equal(t, "mockCluster", in.Cluster)
+					require.Equal(t, "mockTaskID", in.Task)
+					require.Equal(t, "mockSvc", in.Container)
+					require.Equal(t, `/bin/sh -c 'echo __COPILOT_CP_BEGIN__ && base64 '\''/tmp/a.txt'\'' && echo __COPILOT_CP_END__'`, in.Command)
+					fmt.Fprint(in.Output, "\r\nStarting session with SessionId: ecs-execute-command-123\r\n__COPILOT_CP_BEGIN__\r\naGVsbG8g\r\nd29ybGQ=\r\n__COPILOT_CP_END__\r\n\r\nExiting session with sessionId: ecs-execute-command-123.\r\n")
+					return nil
+				})
+			},
+
+			wantedContent: "hello world",
+		},
+		"quote a container path with spaces, quotes and semicolons when downloading": {
+			src: ":/tmp/my dir/it's;echo pwned.txt",
+			dst: "a.txt",
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+					require.Equal(t, `/bin/sh -c 'echo __COPILOT_CP_BEGIN__ && base64 '\''/tmp/my dir/it'\''\'\'''\''s;echo pwned.txt'\'' && echo __COPILOT_CP_END__'`, in.Command)
+					fmt.Fprint(in.Output, "__COPILOT_CP_BEGIN__\r\naGVsbG8=\r\n__COPILOT_CP_END__\r\n")
+					return nil
+				})
+			},
+
+			wantedContent: "hello",
+		},
+		"quote a container path with spaces, quotes and semicolons when uploading": {
+			src: "a.txt",
+			dst: ":/tmp/my dir/it's;echo pwned.txt",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "a.txt", []byte("hello"), 0644)
+			},
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				gomock.InOrder(
+					m.EXPECT().ExecuteCommand(gomock.Any()).Do(func(in awsecs.ExecuteCommandInput) {
+						require.Equal(t, `/bin/sh -c 'printf %s aGVsbG8= > '\''/tmp/my dir/it'\''\'\'''\''s;echo pwned.txt.copilot-cp'\'''`, in.Command)
+					}).Return(nil),
+					m.EXPECT().ExecuteCommand(gomock.Any()).Do(func(in awsecs.ExecuteCommandInput) {
+						require.Equal(t, `/bin/sh -c 'base64 -d '\''/tmp/my dir/it'\''\'\'''\''s;echo pwned.txt.copilot-cp'\'' > '\''/tmp/my dir/it'\''\'\'''\''s;echo pwned.txt'\'' && rm '\''/tmp/my dir/it'\''\'\'''\''s;echo pwned.txt.copilot-cp'\'''`, in.Command)
+					}).Return(nil),
+				)
+			},
+		},
+		"upload a file in chunks": {
+			src: "a.txt",
+			dst: ":/tmp/a.txt",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "a.txt", []byte(strings.Repeat("a", 3000)), 0644)
+			},
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				encoded := strings.Repeat("YWFh", 1000)
+				gomock.InOrder(
+					m.EXPECT().ExecuteCommand(gomock.Any()).Do(func(in awsecs.ExecuteCommandInput) {
+						require.Equal(t, fmt.Sprintf(`/bin/sh -c 'printf %%s %s > '\''/tmp/a.txt.copilot-cp'\'''`, encoded[:3000]), in.Command)
+					}).Return(nil),
+					m.EXPECT().ExecuteCommand(gomock.Any()).Do(func(in awsecs.ExecuteCommandInput) {
+						require.Equal(t, fmt.Sprintf(`/bin/sh -c 'printf %%s %s >> '\''/tmp/a.txt.copilot-cp'\'''`, encoded[3000:]), in.Command)
+					}).Return(nil),
+					m.EXPECT().ExecuteCommand(gomock.Any()).Do(func(in awsecs.ExecuteCommandInput) {
+						require.Equal(t, `/bin/sh -c 'base64 -d '\''/tmp/a.txt.copilot-cp'\'' > '\''/tmp/a.txt'\'' && rm '\''/tmp/a.txt.copilot-cp'\'''`, in.Command)
+					}).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+			mockStore.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			tc.setupMocks(mockCommandExecutor)
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+
+			opts := &svcCpOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						name:    "mockSvc",
+						envName: "mockEnv",
+						appName: "mockApp",
+					},
+					store: mockStore,
+					newSvcDescriber: func(_ *session.Session) serviceDescriber {
+						return mockSvcDescriber
+					},
+					newCommandExecutor: func(_ *session.Session) ecsCommandExecutor {
+						return mockCommandExecutor
+					},
+					randInt:      func(i int) int { return 0 },
+					sessProvider: sessions.ImmutableProvider(),
+				},
+				src: tc.src,
+				dst: tc.dst,
+				fs:  &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedContent != "" {
+				content, err := afero.ReadFile(fs, tc.dst)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
@@ -262,6 +262,11 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 
 		cfClient := o.getSvcCFN(sess)
 		o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.name, env.Name))
+		// Stop routing the global alias to the environment before its load balancer rule is deleted.
+		if err := o.appCFN.DeleteGlobalService(o.appName, env.Name, o.name); err != nil {
+			o.spinner.Stop(log.Serrorf(fmtSvcDeleteFailed, o.name, env.Name, err))
+			return fmt.Errorf("delete global alias record of service %s in environment %s: %w", o.name, env.Name, err)
+		}
 		if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
 			Name:    o.name,
 			EnvName: env.Name,
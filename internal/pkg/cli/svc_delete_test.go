@@ -350,6 +350,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.appCFN.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockSvcName).Return(nil),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
 					// emptyECRRepos
@@ -380,6 +381,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.appCFN.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockSvcName).Return(nil),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
 
@@ -395,6 +397,22 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"errors when deleting global alias record": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+					// deleteStacks
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.appCFN.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockSvcName).Return(testError),
+					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcDeleteFailed, mockSvcName, mockEnvName, testError)),
+				)
+			},
+			wantedError: fmt.Errorf("delete global alias record of service backend in environment test: %w", testError),
+		},
 		"errors when deleting stack": {
 			inAppName: mockAppName,
 			inSvcName: mockSvcName,
@@ -405,6 +423,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.appCFN.EXPECT().DeleteGlobalService(mockAppName, mockEnvName, mockSvcName).Return(nil),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(testError),
 					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcDeleteFailed, mockSvcName, mockEnvName, testError)),
 				)
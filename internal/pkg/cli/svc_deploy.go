@@ -16,8 +16,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
-	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -37,6 +38,7 @@ type deployWkldVars struct {
 	resourceTags    map[string]string
 	forceNewUpdate  bool
 	disableRollback bool
+	fromEnvName     string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -54,6 +56,8 @@ type deploySvcOpts struct {
 	sessProvider    *sessions.Provider
 	newSvcDeployer  func(*deploySvcOpts) (workloadDeployer, error)
 
+	newImageDescriber func(*deploySvcOpts) (deployedImageDescriber, error)
+
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
@@ -64,7 +68,7 @@ type deploySvcOpts struct {
 	svcType         string
 	appliedManifest interface{}
 	rootUserARN     string
-	deployRecs      deploy.ActionRecommender
+	deployRecs      clideploy.ActionRecommender
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
@@ -95,6 +99,18 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		sessProvider:    sessProvider,
 		newSvcDeployer:  newSvcDeployer,
 	}
+	opts.newImageDescriber = func(o *deploySvcOpts) (deployedImageDescriber, error) {
+		cfg := describe.NewServiceConfig{
+			App:         o.appName,
+			Env:         o.fromEnvName,
+			Svc:         o.name,
+			ConfigStore: store,
+		}
+		if o.svcType == manifest.RequestDrivenWebServiceType {
+			return describe.NewAppRunnerServiceDescriber(cfg)
+		}
+		return describe.NewECSServiceDescriber(cfg)
+	}
 	return opts, err
 }
 
@@ -104,7 +120,7 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		return nil, err
 	}
 	var deployer workloadDeployer
-	in := deploy.WorkloadDeployerInput{
+	in := clideploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
 		Name:            o.name,
 		App:             targetApp,
@@ -114,13 +130,13 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	}
 	switch t := o.appliedManifest.(type) {
 	case *manifest.LoadBalancedWebService:
-		deployer, err = deploy.NewLBDeployer(&in)
+		deployer, err = clideploy.NewLBDeployer(&in)
 	case *manifest.BackendService:
-		deployer, err = deploy.NewBackendDeployer(&in)
+		deployer, err = clideploy.NewBackendDeployer(&in)
 	case *manifest.RequestDrivenWebService:
-		deployer, err = deploy.NewRDWSDeployer(&in)
+		deployer, err = clideploy.NewRDWSDeployer(&in)
 	case *manifest.WorkerService:
-		deployer, err = deploy.NewWorkerSvcDeployer(&in)
+		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -136,6 +152,9 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
+	if o.fromEnvName != "" && o.imageTag != "" {
+		return fmt.Errorf("--%s cannot be specified with --%s", imageTagFlag, fromEnvFlag)
+	}
 	return nil
 }
 
@@ -157,7 +176,7 @@ func (o *deploySvcOpts) Ask() error {
 	if err := o.validateOrAskEnvName(); err != nil {
 		return err
 	}
-	return nil
+	return o.validateFromEnvName()
 }
 
 // Execute builds and pushes the container image for the service,
@@ -186,7 +205,7 @@ func (o *deploySvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	uploadOut, err := deployer.UploadArtifacts()
+	uploadOut, provenanceTags, err := o.uploadArtifacts(deployer)
 	if err != nil {
 		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
 	}
@@ -194,15 +213,15 @@ func (o *deploySvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	deployRecs, err := deployer.DeployWorkload(&deploy.DeployWorkloadInput{
-		StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
+	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
+		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 			ImageDigest: uploadOut.ImageDigest,
 			EnvFileARN:  uploadOut.EnvFileARN,
 			AddonsURL:   uploadOut.AddonsURL,
 			RootUserARN: o.rootUserARN,
-			Tags:        tags.Merge(targetApp.Tags, o.resourceTags),
+			Tags:        tags.Merge(targetApp.Tags, o.resourceTags, provenanceTags),
 		},
-		Options: deploy.Options{
+		Options: clideploy.Options{
 			ForceNewUpdate:  o.forceNewUpdate,
 			DisableRollback: o.disableRollback,
 		},
@@ -226,6 +245,35 @@ After fixing the deployment, you can:
 	return nil
 }
 
+// uploadArtifacts builds and pushes the container image, or copies the image deployed in the environment to promote from,
+// and uploads the rest of the deployment artifacts.
+// If the image is promoted, it also returns the tags that record where the image comes from.
+func (o *deploySvcOpts) uploadArtifacts(deployer workloadDeployer) (*clideploy.UploadArtifactsOutput, map[string]string, error) {
+	if o.fromEnvName == "" {
+		out, err := deployer.UploadArtifacts()
+		return out, nil, err
+	}
+	describer, err := o.newImageDescriber(o)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create describer for service %s in environment %s: %w", o.name, o.fromEnvName, err)
+	}
+	image, err := describer.Image()
+	if err != nil {
+		return nil, nil, fmt.Errorf("get image of service %s deployed in environment %s: %w", o.name, o.fromEnvName, err)
+	}
+	log.Infof("Promoting image %s from environment %s.\n", color.HighlightResource(image), color.HighlightUserInput(o.fromEnvName))
+	out, err := deployer.PromoteArtifacts(&clideploy.PromoteArtifactsInput{
+		SourceImage: image,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, map[string]string{
+		deploy.PromotedFromEnvTagKey:     o.fromEnvName,
+		deploy.PromotedImageDigestTagKey: aws.StringValue(out.ImageDigest),
+	}, nil
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
 	var recommendations []string
@@ -262,6 +310,19 @@ func (o *deploySvcOpts) validateEnvName() error {
 	return nil
 }
 
+func (o *deploySvcOpts) validateFromEnvName() error {
+	if o.fromEnvName == "" {
+		return nil
+	}
+	if o.fromEnvName == o.envName {
+		return fmt.Errorf("cannot promote the image of environment %s to itself", o.envName)
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.fromEnvName); err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.fromEnvName, err)
+	}
+	return nil
+}
+
 func (o *deploySvcOpts) validateOrAskSvcName() error {
 	if o.name != "" {
 		return o.validateSvcName()
@@ -289,7 +350,10 @@ func (o *deploySvcOpts) validateOrAskEnvName() error {
 }
 
 func (o *deploySvcOpts) configureClients() error {
-	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
+	if o.fromEnvName == "" {
+		// A promoted image is deployed by its digest, so only images built from the Dockerfile are tagged.
+		o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
+	}
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
@@ -432,7 +496,9 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Promotes the image of service "frontend" deployed in the "staging" environment to the "prod" environment.
+  /code $ copilot svc deploy --name frontend --env prod --from-env staging`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -448,6 +514,7 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().StringVar(&vars.fromEnvName, fromEnvFlag, "", fromEnvFlagDescription)
 
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -18,7 +19,43 @@ import (
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inFromEnvName string
+		inImageTag    string
+
+		wantedError error
+	}{
+		"valid without promotion": {
+			inImageTag: "v1",
+		},
+		"valid promotion": {
+			inFromEnvName: "staging",
+		},
+		"error if image tag is set when promoting": {
+			inFromEnvName: "staging",
+			inImageTag:    "v1",
+			wantedError:   errors.New("--tag cannot be specified with --from-env"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					fromEnvName: tc.inFromEnvName,
+					imageTag:    tc.inImageTag,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantedError.Error())
+			}
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -29,9 +66,10 @@ type svcDeployAskMocks struct {
 
 func TestSvcDeployOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName     string
+		inEnvName     string
+		inSvcName     string
+		inFromEnvName string
 
 		setupMocks func(m *svcDeployAskMocks)
 
@@ -81,6 +119,45 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 			wantedSvcName: "frontend",
 			wantedEnvName: "prod-iad",
 		},
+		"error if the environment to promote from is the target environment": {
+			inAppName:     "phonetool",
+			inEnvName:     "prod-iad",
+			inSvcName:     "frontend",
+			inFromEnvName: "prod-iad",
+			setupMocks: func(m *svcDeployAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool")
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-iad").Return(&config.Environment{Name: "prod-iad"}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedError: errors.New("cannot promote the image of environment prod-iad to itself"),
+		},
+		"error if the environment to promote from does not exist": {
+			inAppName:     "phonetool",
+			inEnvName:     "prod-iad",
+			inSvcName:     "frontend",
+			inFromEnvName: "staging",
+			setupMocks: func(m *svcDeployAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool")
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-iad").Return(&config.Environment{Name: "prod-iad"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "staging").Return(nil, errors.New("some error"))
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedError: errors.New("get environment staging configuration: some error"),
+		},
+		"validate the environment to promote from": {
+			inAppName:     "phonetool",
+			inEnvName:     "prod-iad",
+			inSvcName:     "frontend",
+			inFromEnvName: "staging",
+			setupMocks: func(m *svcDeployAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool")
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-iad").Return(&config.Environment{Name: "prod-iad"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "staging").Return(&config.Environment{Name: "staging"}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedSvcName: "frontend",
+			wantedEnvName: "prod-iad",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -97,9 +174,10 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 			tc.setupMocks(m)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
+					appName:     tc.inAppName,
+					name:        tc.inSvcName,
+					envName:     tc.inEnvName,
+					fromEnvName: tc.inFromEnvName,
 				},
 				sel:   m.sel,
 				store: m.store,
@@ -122,10 +200,11 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 }
 
 type deployMocks struct {
-	mockDeployer     *mocks.MockworkloadDeployer
-	mockEnvUpgrader  *mocks.MockactionCommand
-	mockInterpolator *mocks.Mockinterpolator
-	mockWsReader     *mocks.MockwsWlDirReader
+	mockDeployer       *mocks.MockworkloadDeployer
+	mockEnvUpgrader    *mocks.MockactionCommand
+	mockInterpolator   *mocks.Mockinterpolator
+	mockWsReader       *mocks.MockwsWlDirReader
+	mockImageDescriber *mocks.MockdeployedImageDescriber
 }
 
 func TestSvcDeployOpts_Execute(t *testing.T) {
@@ -136,7 +215,8 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	)
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		mock func(m *deployMocks)
+		inFromEnvName string
+		mock          func(m *deployMocks)
 
 		wantedError error
 	}{
@@ -185,6 +265,53 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: some error"),
 		},
+		"error if failed to get the image to promote": {
+			inFromEnvName: "staging",
+			mock: func(m *deployMocks) {
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockImageDescriber.EXPECT().Image().Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("upload deploy resources for service frontend: get image of service frontend deployed in environment staging: some error"),
+		},
+		"error if failed to promote artifacts": {
+			inFromEnvName: "staging",
+			mock: func(m *deployMocks) {
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockImageDescriber.EXPECT().Image().Return("mockImage", nil)
+				m.mockDeployer.EXPECT().PromoteArtifacts(&deploy.PromoteArtifactsInput{
+					SourceImage: "mockImage",
+				}).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("upload deploy resources for service frontend: some error"),
+		},
+		"promote the image and record its provenance": {
+			inFromEnvName: "staging",
+			mock: func(m *deployMocks) {
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockImageDescriber.EXPECT().Image().Return("mockImage", nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Times(0)
+				m.mockDeployer.EXPECT().PromoteArtifacts(gomock.Any()).Return(&deploy.UploadArtifactsOutput{
+					ImageDigest: aws.String("sha256:abc"),
+				}, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(&deploy.DeployWorkloadInput{
+					StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
+						ImageDigest: aws.String("sha256:abc"),
+						Tags: map[string]string{
+							"copilot-promoted-from-environment": "staging",
+							"copilot-promoted-image-digest":     "sha256:abc",
+						},
+					},
+				}).Return(nil, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -194,24 +321,29 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			m := &deployMocks{
-				mockDeployer:     mocks.NewMockworkloadDeployer(ctrl),
-				mockEnvUpgrader:  mocks.NewMockactionCommand(ctrl),
-				mockInterpolator: mocks.NewMockinterpolator(ctrl),
-				mockWsReader:     mocks.NewMockwsWlDirReader(ctrl),
+				mockDeployer:       mocks.NewMockworkloadDeployer(ctrl),
+				mockEnvUpgrader:    mocks.NewMockactionCommand(ctrl),
+				mockInterpolator:   mocks.NewMockinterpolator(ctrl),
+				mockWsReader:       mocks.NewMockwsWlDirReader(ctrl),
+				mockImageDescriber: mocks.NewMockdeployedImageDescriber(ctrl),
 			}
 			tc.mock(m)
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: mockAppName,
-					name:    mockSvcName,
-					envName: mockEnvName,
+					appName:     mockAppName,
+					name:        mockSvcName,
+					envName:     mockEnvName,
+					fromEnvName: tc.inFromEnvName,
 
 					clientConfigured: true,
 				},
 				newSvcDeployer: func(dso *deploySvcOpts) (workloadDeployer, error) {
 					return m.mockDeployer, nil
 				},
+				newImageDescriber: func(dso *deploySvcOpts) (deployedImageDescriber, error) {
+					return m.mockImageDescriber, nil
+				},
 				envUpgradeCmd: m.mockEnvUpgrader,
 				newInterpolator: func(app, env string) interpolator {
 					return m.mockInterpolator
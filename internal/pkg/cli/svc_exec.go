@@ -4,10 +4,15 @@
 package cli
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -28,6 +33,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -41,14 +47,27 @@ Would you like to install the plugin to execute into the container?`
 See https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html`
 	ssmPluginUpdatePrompt = `Looks like the Session Manager plugin is using version %s.
 Would you like to update it to the latest version %s?`
+
+	// The remote exit code is printed on its own line after the command since the Session Manager plugin does not return it.
+	execExitCodeMarker = "__COPILOT_EXIT_CODE__"
 )
 
+// Lines written by the Session Manager plugin around the output of a command.
+var ssmSessionOutputPrefixes = []string{
+	"Starting session with SessionId:",
+	"Exiting session with sessionId:",
+}
+
 var (
 	errSSMPluginCommandInstallCancelled = errors.New("ssm plugin install cancelled")
 )
 
 type svcExecOpts struct {
 	execVars
+	noTTY    bool
+	allTasks bool
+
+	w                  io.Writer
 	store              store
 	sel                deploySelector
 	newSvcDescriber    func(*session.Session) serviceDescriber
@@ -61,7 +80,12 @@ type svcExecOpts struct {
 }
 
 func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc exec"))
+	return newSvcSessionOpts(vars, "svc exec")
+}
+
+// newSvcSessionOpts returns the options to open a Session Manager session into a container of a service for the given command.
+func newSvcSessionOpts(vars execVars, cmdName string) (*svcExecOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras(cmdName))
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -73,6 +97,7 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 	}
 	return &svcExecOpts{
 		execVars: vars,
+		w:        log.OutputWriter,
 		store:    ssmStore,
 		sel:      selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
 		newSvcDescriber: func(s *session.Session) serviceDescriber {
@@ -93,6 +118,15 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcExecOpts) Validate() error {
+	if o.allTasks && !o.noTTY {
+		return fmt.Errorf("--%s requires --%s", allTasksFlag, noTTYFlag)
+	}
+	if o.allTasks && o.taskID != "" {
+		return fmt.Errorf("only one of --%s or --%s may be used", allTasksFlag, taskIDFlag)
+	}
+	if o.noTTY && o.command == defaultCommand {
+		return fmt.Errorf("--%s must be specified with --%s", commandFlag, noTTYFlag)
+	}
 	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
 }
 
@@ -109,22 +143,18 @@ func (o *svcExecOpts) Ask() error {
 
 // Execute executes a command in a running container.
 func (o *svcExecOpts) Execute() error {
-	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	sess, svcDesc, err := o.describeService()
 	if err != nil {
-		return fmt.Errorf("get workload: %w", err)
+		return err
 	}
-	if wkld.Type == manifest.RequestDrivenWebServiceType {
-		return fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	if o.noTTY {
+		return o.executeNoTTY(sess, svcDesc)
 	}
-	sess, err := o.envSession()
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
 	if err != nil {
 		return err
 	}
-	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
-	if err != nil {
-		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
-	}
-	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 	if err != nil {
 		return err
 	}
@@ -146,6 +176,136 @@ func (o *svcExecOpts) Execute() error {
 	return nil
 }
 
+// executeNoTTY runs the command in the selected tasks in parallel and writes their output prefixed with the task ID.
+func (o *svcExecOpts) executeNoTTY(sess *session.Session, svcDesc *ecs.ServiceDesc) error {
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if !o.allTasks {
+		task, err := o.selectTask(tasks)
+		if err != nil {
+			return err
+		}
+		tasks = []*awsecs.Task{task}
+	}
+	if len(tasks) == 0 {
+		return fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	container := o.selectContainer()
+	executor := o.newCommandExecutor(sess)
+	exitCodes := make([]int, len(tasks))
+	taskIDs := make([]string, len(tasks))
+	var mu sync.Mutex
+	g := new(errgroup.Group)
+	for i, task := range tasks {
+		i, task := i, task
+		g.Go(func() error {
+			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+			if err != nil {
+				return err
+			}
+			taskIDs[i] = taskID
+			var out bytes.Buffer
+			if err := executor.ExecuteCommand(awsecs.ExecuteCommandInput{
+				Cluster:   svcDesc.ClusterName,
+				Command:   fmt.Sprintf(`/bin/sh -c '%s; printf "\n%s%%d\n" $?'`, strings.ReplaceAll(o.command, "'", `'\''`), execExitCodeMarker),
+				Container: container,
+				Task:      taskID,
+				Output:    &out,
+			}); err != nil {
+				return fmt.Errorf("execute command %s in container %s of task %s: %w", o.command, container, taskID, err)
+			}
+			lines, exitCode, err := parseExecOutput(&out)
+			if err != nil {
+				return fmt.Errorf("parse output of command %s in task %s: %w", o.command, taskID, err)
+			}
+			exitCodes[i] = exitCode
+			mu.Lock()
+			defer mu.Unlock()
+			prefix := taskID
+			if len(prefix) > shortTaskIDLength {
+				prefix = prefix[:shortTaskIDLength]
+			}
+			for _, line := range lines {
+				fmt.Fprintf(o.w, "%s %s\n", color.Grey.Sprintf("[%s]", prefix), line)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		var errExecCmd *awsecs.ErrExecuteCommand
+		if errors.As(err, &errExecCmd) {
+			log.Errorf("Failed to execute command %s. Is %s set in your manifest?\n", o.command, color.HighlightCode("exec: true"))
+		}
+		return err
+	}
+	failed := &errExecCommandFailed{
+		command: o.command,
+		total:   len(tasks),
+	}
+	for i, code := range exitCodes {
+		if code == 0 {
+			continue
+		}
+		log.Errorf("Command %s exited with code %d in task %s.\n", color.HighlightCode(o.command), code, color.HighlightResource(taskIDs[i]))
+		if failed.exitCode == 0 {
+			failed.exitCode = code
+		}
+		failed.failedTasks = append(failed.failedTasks, taskIDs[i])
+	}
+	if len(failed.failedTasks) != 0 {
+		return failed
+	}
+	return nil
+}
+
+// parseExecOutput returns the lines written by the command, without the lines written by the Session Manager plugin,
+// and the exit code of the command.
+func parseExecOutput(r io.Reader) ([]string, int, error) {
+	var lines []string
+	exitCode := -1
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if i := strings.LastIndex(line, execExitCodeMarker); i != -1 {
+			code, err := strconv.Atoi(line[i+len(execExitCodeMarker):])
+			if err != nil {
+				return nil, 0, fmt.Errorf("parse exit code from %s: %w", line, err)
+			}
+			exitCode = code
+			if i == 0 {
+				continue
+			}
+			line = line[:i] // The command's output didn't end with a new line.
+		}
+		if isSSMSessionOutput(line) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("read command output: %w", err)
+	}
+	if exitCode == -1 {
+		return nil, 0, errors.New("exit code of the command not found in its output")
+	}
+	// The Session Manager plugin surrounds the output with empty lines.
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines, exitCode, nil
+}
+
+func isSSMSessionOutput(line string) bool {
+	for _, prefix := range ssmSessionOutputPrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *svcExecOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
@@ -183,6 +343,26 @@ func (o *svcExecOpts) validateAndAskSvcEnvName() error {
 	return nil
 }
 
+// describeService returns the environment session and the ECS service description of the selected service.
+func (o *svcExecOpts) describeService() (*session.Session, *ecs.ServiceDesc, error) {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return nil, nil, fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	sess, err := o.envSession()
+	if err != nil {
+		return nil, nil, err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	return sess, svcDesc, nil
+}
+
 func (o *svcExecOpts) envSession() (*session.Session, error) {
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
@@ -191,27 +371,23 @@ func (o *svcExecOpts) envSession() (*session.Session, error) {
 	return o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 }
 
-func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
+func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
 	if len(tasks) == 0 {
-		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
 	}
 	if o.taskID != "" {
 		for _, task := range tasks {
 			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
+				return task, nil
 			}
 		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+		return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
 	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
-	if err != nil {
-		return "", err
-	}
-	return taskID, nil
+	return tasks[o.randInt(len(tasks))], nil
 }
 
 func (o *svcExecOpts) selectContainer() string {
@@ -273,10 +449,26 @@ https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-wor
 	}
 }
 
+type errExecCommandFailed struct {
+	command     string
+	failedTasks []string
+	total       int
+	exitCode    int
+}
+
+func (e *errExecCommandFailed) Error() string {
+	return fmt.Sprintf("command %s failed in %d out of %d task(s): %s", e.command, len(e.failedTasks), e.total, strings.Join(e.failedTasks, ", "))
+}
+
+// ExitCode returns the exit code of the command in the first task that failed.
+func (e *errExecCommandFailed) ExitCode() int {
+	return e.exitCode
+}
+
 // buildSvcExecCmd builds the command for execute a running container in a service.
 func buildSvcExecCmd() *cobra.Command {
 	vars := execVars{}
-	var skipPrompt bool
+	var skipPrompt, noTTY, allTasks bool
 	cmd := &cobra.Command{
 		Use:   "exec",
 		Short: "Execute a command in a running container part of a service.",
@@ -284,12 +476,16 @@ func buildSvcExecCmd() *cobra.Command {
   Start an interactive bash session with a task part of the "frontend" service.
   /code $ copilot svc exec -a my-app -e test -n frontend
   Runs the 'ls' command in the task prefixed with ID "8c38184" within the "backend" service.
-  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"`,
+  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"
+  Runs a database migration in every running task of the "api" service from a script.
+  /code $ copilot svc exec -n api --command "./migrate.sh" --no-tty --all-tasks`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcExecOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.noTTY = noTTY
+			opts.allTasks = allTasks
 			if cmd.Flags().Changed(yesFlag) {
 				opts.skipConfirmation = aws.Bool(false)
 				if skipPrompt {
@@ -306,6 +502,8 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+	cmd.Flags().BoolVar(&noTTY, noTTYFlag, false, noTTYFlagDescription)
+	cmd.Flags().BoolVar(&allTasks, allTasksFlag, false, allTasksFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	return cmd
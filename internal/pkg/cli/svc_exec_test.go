@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -42,11 +43,36 @@ func TestSvcExec_Validate(t *testing.T) {
 		inputApp         string
 		inputEnv         string
 		inputSvc         string
+		inputCommand     string
+		inputTaskID      string
+		noTTY            bool
+		allTasks         bool
 		skipConfirmation *bool
 		setupMocks       func(mocks execSvcMocks)
 
 		wantedError error
 	}{
+		"return error if --all-tasks is used without --no-tty": {
+			allTasks:   true,
+			setupMocks: func(m execSvcMocks) {},
+
+			wantedError: errors.New("--all-tasks requires --no-tty"),
+		},
+		"return error if both --all-tasks and --task-id are used": {
+			noTTY:       true,
+			allTasks:    true,
+			inputTaskID: "8c38184",
+			setupMocks:  func(m execSvcMocks) {},
+
+			wantedError: errors.New("only one of --all-tasks or --task-id may be used"),
+		},
+		"return error if --no-tty is used with the default command": {
+			noTTY:        true,
+			inputCommand: defaultCommand,
+			setupMocks:   func(m execSvcMocks) {},
+
+			wantedError: errors.New("--command must be specified with --no-tty"),
+		},
 		"skip without installing/updating if yes flag is set to be false": {
 			inputApp:         mockApp,
 			inputEnv:         mockEnv,
@@ -231,8 +257,12 @@ func TestSvcExec_Validate(t *testing.T) {
 					name:             tc.inputSvc,
 					appName:          tc.inputApp,
 					envName:          tc.inputEnv,
+					command:          tc.inputCommand,
+					taskID:           tc.inputTaskID,
 					skipConfirmation: tc.skipConfirmation,
 				},
+				noTTY:            tc.noTTY,
+				allTasks:         tc.allTasks,
 				store:            mockStoreReader,
 				ssmPluginManager: mockSSMPluginManager,
 				prompter:         mockPrompter,
@@ -600,3 +630,141 @@ func TestSvcExec_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcExec_ExecuteNoTTY(t *testing.T) {
+	const (
+		mockTaskARN      = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/8c38184a2e8b4d2f"
+		mockOtherTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/1f2c7e9b53d04a6c"
+	)
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String(mockTaskARN),
+				LastStatus: aws.String("RUNNING"),
+			},
+			{
+				TaskArn:    aws.String(mockOtherTaskARN),
+				LastStatus: aws.String("RUNNING"),
+			},
+		},
+	}
+	writeOutput := func(output string) func(in awsecs.ExecuteCommandInput) error {
+		return func(in awsecs.ExecuteCommandInput) error {
+			fmt.Fprintf(in.Output, "\r\nStarting session with SessionId: ecs-execute-command-123\r\n%s\r\n\r\nExiting session with sessionId: ecs-execute-command-123.\r\n\r\n", output)
+			return nil
+		}
+	}
+	testCases := map[string]struct {
+		allTasks   bool
+		setupMocks func(m *mocks.MockecsCommandExecutor)
+
+		wantedOutput   string
+		wantedError    error
+		wantedExitCode int
+	}{
+		"return error if fail to execute command": {
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("execute command echo 'hello' in container mockSvc of task 8c38184a2e8b4d2f: some error"),
+		},
+		"return error if the exit code is missing from the output": {
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(writeOutput("hello"))
+			},
+
+			wantedError: errors.New("parse output of command echo 'hello' in task 8c38184a2e8b4d2f: exit code of the command not found in its output"),
+		},
+		"runs the command in a single task": {
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+					require.Equal(t, "mockCluster", in.Cluster)
+					require.Equal(t, "8c38184a2e8b4d2f", in.Task)
+					require.Equal(t, "mockSvc", in.Container)
+					require.Equal(t, `/bin/sh -c 'echo '\''hello'\''; printf "\n__COPILOT_EXIT_CODE__%d\n" $?'`, in.Command)
+					return writeOutput("hello\r\n\r\n__COPILOT_EXIT_CODE__0")(in)
+				})
+			},
+
+			wantedOutput: "[8c38184a] hello\n",
+		},
+		"parses the exit code when the output does not end with a new line": {
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(writeOutput("hello__COPILOT_EXIT_CODE__0"))
+			},
+
+			wantedOutput: "[8c38184a] hello\n",
+		},
+		"returns the exit code of the failed task when running in all tasks": {
+			allTasks: true,
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+					if in.Task == "8c38184a2e8b4d2f" {
+						return writeOutput("hello\r\n__COPILOT_EXIT_CODE__0")(in)
+					}
+					return writeOutput("oops\r\n__COPILOT_EXIT_CODE__3")(in)
+				}).Times(2)
+			},
+
+			wantedError:    errors.New("command echo 'hello' failed in 1 out of 2 task(s): 1f2c7e9b53d04a6c"),
+			wantedExitCode: 3,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&config.Workload{
+				Type: "Backend Service",
+			}, nil)
+			mockStore.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			tc.setupMocks(mockCommandExecutor)
+			b := &strings.Builder{}
+
+			execSvcs := &svcExecOpts{
+				execVars: execVars{
+					name:    "mockSvc",
+					envName: "mockEnv",
+					appName: "mockApp",
+					command: "echo 'hello'",
+				},
+				noTTY:    true,
+				allTasks: tc.allTasks,
+				w:        b,
+				store:    mockStore,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return mockSvcDescriber
+				},
+				newCommandExecutor: func(_ *session.Session) ecsCommandExecutor {
+					return mockCommandExecutor
+				},
+				randInt:      func(i int) int { return 0 },
+				sessProvider: sessions.ImmutableProvider(),
+			}
+
+			// WHEN
+			err := execSvcs.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, b.String())
+			}
+			if tc.wantedExitCode != 0 {
+				var ec *errExecCommandFailed
+				require.True(t, errors.As(err, &ec))
+				require.Equal(t, tc.wantedExitCode, ec.ExitCode())
+			}
+		})
+	}
+}
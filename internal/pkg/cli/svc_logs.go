@@ -43,6 +43,9 @@ type wkldLogsVars struct {
 	taskIDs          []string
 	since            time.Duration
 	logGroup         string
+	filter           string
+	container        string
+	query            string
 }
 
 type svcLogsOpts struct {
@@ -151,6 +154,18 @@ func (o *svcLogsOpts) Validate() error {
 		o.endTime = aws.Int64(endTime)
 	}
 
+	if o.query != "" {
+		if o.follow {
+			return errors.New("only one of --follow or --query may be used")
+		}
+		if o.filter != "" {
+			return errors.New("only one of --filter or --query may be used")
+		}
+		if len(o.taskIDs) != 0 || o.container != "" {
+			return errors.New("--tasks and --container cannot be used with --query, filter on @logStream in the query instead")
+		}
+	}
+
 	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
 	}
@@ -185,6 +200,9 @@ func (o *svcLogsOpts) Execute() error {
 		EndTime:   o.endTime,
 		StartTime: o.startTime,
 		TaskIDs:   o.taskIDs,
+		Filter:    o.filter,
+		Container: o.container,
+		Query:     o.query,
 		OnEvents:  eventsWriter,
 	})
 	if err != nil {
@@ -274,7 +292,11 @@ func buildSvcLogsCmd() *cobra.Command {
   Displays logs in real time.
   /code $ copilot svc logs --follow
   Display logs from specific log group.
-  /code $ copilot svc logs --log-group system`,
+  /code $ copilot svc logs --log-group system
+  Displays error logs from the "nginx" sidecar container.
+  /code $ copilot svc logs --container nginx --filter ERROR
+  Counts log events by log stream over the last day with CloudWatch Logs Insights.
+  /code $ copilot svc logs --since 24h --query "stats count(*) by @logStream"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -294,5 +316,8 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.filter, logFilterFlag, "", logFilterFlagDescription)
+	cmd.Flags().StringVar(&vars.container, containerFlag, "", logContainerFlagDescription)
+	cmd.Flags().StringVar(&vars.query, logQueryFlag, "", logQueryFlagDescription)
 	return cmd
 }
@@ -40,6 +40,9 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputFilter    string
+		inputContainer string
+		inputQuery     string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -99,6 +102,37 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
 		},
+		"returns error if follow and query flags are set together": {
+			inputFollow: true,
+			inputQuery:  "fields @message",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --query may be used"),
+		},
+		"returns error if filter and query flags are set together": {
+			inputFilter: "ERROR",
+			inputQuery:  "fields @message",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --filter or --query may be used"),
+		},
+		"returns error if container and query flags are set together": {
+			inputContainer: "nginx",
+			inputQuery:     "fields @message",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--tasks and --container cannot be used with --query, filter on @logStream in the query instead"),
+		},
+		"valid with filter and container flags": {
+			inputFollow:    true,
+			inputFilter:    "ERROR",
+			inputContainer: "nginx",
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -119,6 +153,9 @@ func TestSvcLogs_Validate(t *testing.T) {
 					since:          tc.inputSince,
 					name:           tc.inputSvc,
 					appName:        tc.inputApp,
+					filter:         tc.inputFilter,
+					container:      tc.inputContainer,
+					query:          tc.inputQuery,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
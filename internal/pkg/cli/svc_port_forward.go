@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	minPortNumber = 1
+	maxPortNumber = 65535
+)
+
+type svcPortForwardVars struct {
+	execVars
+	localPort  int
+	remotePort int
+	remoteHost string
+}
+
+type svcPortForwardOpts struct {
+	*svcExecOpts
+	localPort  int
+	remotePort int
+	remoteHost string
+
+	newPortForwarder func(*session.Session) portForwarder
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	execOpts, err := newSvcSessionOpts(vars.execVars, "svc port-forward")
+	if err != nil {
+		return nil, err
+	}
+	return &svcPortForwardOpts{
+		svcExecOpts: execOpts,
+		localPort:   vars.localPort,
+		remotePort:  vars.remotePort,
+		remoteHost:  vars.remoteHost,
+		newPortForwarder: func(s *session.Session) portForwarder {
+			return ssm.New(s)
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.remotePort == 0 {
+		return errors.New("--remote-port must be specified")
+	}
+	if err := validatePortNumber(remotePortFlag, o.remotePort); err != nil {
+		return err
+	}
+	if o.localPort != 0 {
+		if err := validatePortNumber(localPortFlag, o.localPort); err != nil {
+			return err
+		}
+	}
+	return o.svcExecOpts.Validate()
+}
+
+// Execute forwards a local port to a port in a running container, or to a remote host reachable from the container.
+func (o *svcPortForwardOpts) Execute() error {
+	sess, svcDesc, err := o.describeService()
+	if err != nil {
+		return err
+	}
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	target, err := task.SSMTarget(container)
+	if err != nil {
+		return fmt.Errorf("get session target for container %s: %w", container, err)
+	}
+	localPort := o.localPort
+	if localPort == 0 {
+		localPort = o.remotePort
+	}
+	destination := fmt.Sprintf("port %d in container %s", o.remotePort, color.HighlightUserInput(container))
+	if o.remoteHost != "" {
+		destination = fmt.Sprintf("%s:%d through container %s", o.remoteHost, o.remotePort, color.HighlightUserInput(container))
+	}
+	log.Infof("Forward local port %d to %s in task %s.\n", localPort, destination, color.HighlightResource(task.String()))
+	if err := o.newPortForwarder(sess).StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Target:     target,
+		LocalPort:  localPort,
+		RemotePort: o.remotePort,
+		RemoteHost: o.remoteHost,
+	}); err != nil {
+		log.Errorf("Failed to forward port %d. Is %s set in your manifest?\n", o.remotePort, color.HighlightCode("exec: true"))
+		return fmt.Errorf("forward port %d in container %s: %w", o.remotePort, container, err)
+	}
+	return nil
+}
+
+func validatePortNumber(flag string, port int) error {
+	if port < minPortNumber || port > maxPortNumber {
+		return fmt.Errorf("--%s %d is out-of-bounds, value must be between %d and %d", flag, port, minPortNumber, maxPortNumber)
+	}
+	return nil
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port to a running container part of a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a running container part of a service.",
+		Example: `
+  Forward local port 8080 to port 80 of the "frontend" service.
+  /code $ copilot svc port-forward -a my-app -e test -n frontend --local-port 8080 --remote-port 80
+  Connect to a database reachable from the task prefixed with ID "8c38184" within the "api" service.
+  /code $ copilot svc port-forward -n api --task-id 8c38184 --remote-host mydb.cluster-abc.us-west-2.rds.amazonaws.com --remote-port 5432`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().IntVar(&vars.localPort, localPortFlag, 0, localPortFlagDescription)
+	cmd.Flags().IntVar(&vars.remotePort, remotePortFlag, 0, remotePortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", remoteHostFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
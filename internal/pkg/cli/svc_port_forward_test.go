@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPortForward_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		localPort  int
+		remotePort int
+
+		wantedError error
+	}{
+		"return error if remote port is not specified": {
+			wantedError: errors.New("--remote-port must be specified"),
+		},
+		"return error if remote port is out of bounds": {
+			remotePort: 65536,
+
+			wantedError: errors.New("--remote-port 65536 is out-of-bounds, value must be between 1 and 65535"),
+		},
+		"return error if local port is out of bounds": {
+			localPort:  -1,
+			remotePort: 80,
+
+			wantedError: errors.New("--local-port -1 is out-of-bounds, value must be between 1 and 65535"),
+		},
+		"success": {
+			localPort:  8080,
+			remotePort: 80,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcPortForwardOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						skipConfirmation: aws.Bool(false),
+					},
+				},
+				localPort:  tc.localPort,
+				remotePort: tc.remotePort,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Load Balanced Web Service",
+	}
+	mockTask := &awsecs.Task{
+		TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"),
+		ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"),
+		LastStatus: aws.String("RUNNING"),
+		Containers: []*ecsapi.Container{
+			{
+				Name:      aws.String("mockSvc"),
+				RuntimeId: aws.String("mockTaskID-123"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		containerName string
+		localPort     int
+		remoteHost    string
+		setupMocks    func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder)
+
+		wantedError error
+	}{
+		"return error if fail to describe service": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("describe ECS service for mockSvc in environment mockEnv: some error"),
+		},
+		"return error if the container does not exist": {
+			containerName: "nginx",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+			},
+
+			wantedError: fmt.Errorf("get session target for container nginx: container nginx not found in task mockTaskID"),
+		},
+		"return error if fail to forward port": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+				forwarder.EXPECT().StartPortForwardingSession(gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("forward port 80 in container mockSvc: some error"),
+		},
+		"forward to the container with the remote port by default": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+				forwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:     "ecs:mockCluster_mockTaskID_mockTaskID-123",
+					LocalPort:  80,
+					RemotePort: 80,
+				}).Return(nil)
+			},
+		},
+		"forward to a remote host": {
+			localPort:  5432,
+			remoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+				forwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:     "ecs:mockCluster_mockTaskID_mockTaskID-123",
+					LocalPort:  5432,
+					RemotePort: 80,
+					RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockForwarder := mocks.NewMockportForwarder(ctrl)
+			tc.setupMocks(mockStore, mockSvcDescriber, mockForwarder)
+
+			opts := &svcPortForwardOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						name:          "mockSvc",
+						envName:       "mockEnv",
+						appName:       "mockApp",
+						containerName: tc.containerName,
+					},
+					store: mockStore,
+					newSvcDescriber: func(_ *session.Session) serviceDescriber {
+						return mockSvcDescriber
+					},
+					randInt:      func(i int) int { return 0 },
+					sessProvider: sessions.ImmutableProvider(),
+				},
+				localPort:  tc.localPort,
+				remotePort: 80,
+				remoteHost: tc.remoteHost,
+				newPortForwarder: func(_ *session.Session) portForwarder {
+					return mockForwarder
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,8 +4,13 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -17,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -25,10 +31,13 @@ import (
 const (
 	svcStatusNamePrompt     = "Which service's status would you like to show?"
 	svcStatusNameHelpPrompt = "Displays the service's task status, most recent deployment and alarm statuses."
+
+	svcStatusWatchInterval = 5 * time.Second
 )
 
 type svcStatusVars struct {
 	shouldOutputJSON bool
+	watch            bool
 	svcName          string
 	envName          string
 	appName          string
@@ -38,8 +47,10 @@ type svcStatusOpts struct {
 	svcStatusVars
 
 	w                   io.Writer
+	watchOut            termprogress.FileWriter
 	store               store
 	statusDescriber     statusDescriber
+	statusWatcher       statusWatcher
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
 }
@@ -60,6 +71,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		svcStatusVars: vars,
 		store:         configStore,
 		w:             log.OutputWriter,
+		watchOut:      os.Stdout,
 		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
@@ -67,6 +79,9 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 				return fmt.Errorf("retrieve %s from application %s: %w", o.appName, o.svcName, err)
 			}
 			if wkld.Type == manifest.RequestDrivenWebServiceType {
+				if o.watch {
+					return fmt.Errorf("--%s is not supported for services with type: '%s'", watchFlag, manifest.RequestDrivenWebServiceType)
+				}
 				d, err := describe.NewAppRunnerStatusDescriber(&describe.NewServiceStatusConfig{
 					App:         o.appName,
 					Env:         o.envName,
@@ -88,6 +103,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.appName, err)
 				}
 				o.statusDescriber = d
+				o.statusWatcher = describe.NewECSStatusWatcher(d, svcStatusWatchInterval)
 			}
 			return nil
 		},
@@ -96,6 +112,9 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcStatusOpts) Validate() error {
+	if o.watch && o.shouldOutputJSON {
+		return fmt.Errorf("only one of --%s or --%s may be used", watchFlag, jsonFlag)
+	}
 	return nil
 }
 
@@ -113,6 +132,11 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		return o.watchStatus(ctx)
+	}
 	svcStatus, err := o.statusDescriber.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
@@ -130,6 +154,21 @@ func (o *svcStatusOpts) Execute() error {
 	return nil
 }
 
+// watchStatus renders the status of the service in place until ctx is canceled.
+func (o *svcStatusOpts) watchStatus(ctx context.Context) error {
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- o.statusWatcher.Watch(ctx)
+	}()
+	if err := termprogress.Render(ctx, termprogress.NewTabbedFileWriter(o.watchOut), o.statusWatcher); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("render status of service %s: %w", o.svcName, err)
+	}
+	if err := <-watchErr; err != nil {
+		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
+	}
+	return nil
+}
+
 func (o *svcStatusOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
@@ -176,7 +215,9 @@ func buildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Watches the status of the service "my-svc" in environment "prod" during an incident.
+  /code $ copilot svc status -n my-svc -e prod --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -189,5 +230,6 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, svcStatusWatchFlagDescription)
 	return cmd
 }
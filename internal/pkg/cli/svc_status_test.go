@@ -5,8 +5,10 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -18,7 +20,42 @@ import (
 )
 
 func TestSvcStatus_Validate(t *testing.T) {
-	// NOTE: No optional flag to `copilot svc pause` needs to be validated.
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		watch            bool
+
+		wantedError error
+	}{
+		"errors if both --watch and --json are used": {
+			shouldOutputJSON: true,
+			watch:            true,
+
+			wantedError: errors.New("only one of --watch or --json may be used"),
+		},
+		"success with --watch": {
+			watch: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svcStatus := &svcStatusOpts{
+				svcStatusVars: svcStatusVars{
+					shouldOutputJSON: tc.shouldOutputJSON,
+					watch:            tc.watch,
+				},
+			}
+
+			// WHEN
+			err := svcStatus.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 type svcStatusAskMock struct {
@@ -202,3 +239,81 @@ func TestSvcStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+type fakeFileWriter struct {
+	bytes.Buffer
+}
+
+func (w *fakeFileWriter) Fd() uintptr {
+	return 0
+}
+
+func TestSvcStatus_ExecuteWatch(t *testing.T) {
+	testCases := map[string]struct {
+		mockStatusWatcher func(m *mocks.MockstatusWatcher, done chan struct{})
+
+		wantedError  error
+		wantedOutput string
+	}{
+		"errors if failed to describe the status of the service": {
+			mockStatusWatcher: func(m *mocks.MockstatusWatcher, done chan struct{}) {
+				m.EXPECT().Watch(gomock.Any()).DoAndReturn(func(_ context.Context) error {
+					close(done)
+					return errors.New("some error")
+				})
+				m.EXPECT().Done().Return(done).AnyTimes()
+				m.EXPECT().Render(gomock.Any()).Return(0, nil).AnyTimes()
+			},
+
+			wantedError: errors.New("describe status of service mockSvc: some error"),
+		},
+		"renders the status until the watch stops": {
+			mockStatusWatcher: func(m *mocks.MockstatusWatcher, done chan struct{}) {
+				m.EXPECT().Watch(gomock.Any()).DoAndReturn(func(_ context.Context) error {
+					close(done)
+					return nil
+				})
+				m.EXPECT().Done().Return(done).AnyTimes()
+				m.EXPECT().Render(gomock.Any()).DoAndReturn(func(out io.Writer) (int, error) {
+					fmt.Fprint(out, "Task Summary\n")
+					return 1, nil
+				}).AnyTimes()
+			},
+
+			wantedOutput: "Task Summary\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			out := &fakeFileWriter{}
+			mockStatusWatcher := mocks.NewMockstatusWatcher(ctrl)
+			tc.mockStatusWatcher(mockStatusWatcher, make(chan struct{}))
+
+			svcStatus := &svcStatusOpts{
+				svcStatusVars: svcStatusVars{
+					svcName: "mockSvc",
+					envName: "mockEnv",
+					appName: "mockApp",
+					watch:   true,
+				},
+				statusWatcher:       mockStatusWatcher,
+				initStatusDescriber: func(*svcStatusOpts) error { return nil },
+				watchOut:            out,
+			}
+
+			// WHEN
+			err := svcStatus.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Contains(t, out.String(), tc.wantedOutput)
+			}
+		})
+	}
+}
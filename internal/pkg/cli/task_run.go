@@ -147,6 +147,7 @@ type runTaskOpts struct {
 
 	provider          sessionProvider
 	sess              *session.Session
+	targetApplication *config.Application
 	targetEnvironment *config.Environment
 
 	// Configurer functions.
@@ -282,8 +283,17 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 
 func (o *runTaskOpts) configureSessAndEnv() error {
 	var sess *session.Session
+	var app *config.Application
 	var env *config.Environment
 
+	if o.appName != "" {
+		var err error
+		app, err = o.store.GetApplication(o.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+
 	if o.env != "" {
 		var err error
 		env, err = o.targetEnv(o.appName, o.env)
@@ -303,6 +313,7 @@ func (o *runTaskOpts) configureSessAndEnv() error {
 		}
 	}
 
+	o.targetApplication = app
 	o.targetEnvironment = env
 	o.sess = sess
 	return nil
@@ -949,6 +960,10 @@ func (o *runTaskOpts) deploy() error {
 		Env:                   o.env,
 		AdditionalTags:        o.resourceTags,
 	}
+	if o.targetApplication != nil {
+		input.PermissionsBoundary = o.targetApplication.PermissionsBoundary
+		input.RolePath = o.targetApplication.RolePath
+	}
 	return o.deployer.DeployTask(os.Stderr, input, deployOpts...)
 }
 
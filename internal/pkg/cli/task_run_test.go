@@ -769,6 +769,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inCommand    string
 		inEntryPoint string
 
+		inApp string
 		inEnv string
 
 		setupMocks func(m runTaskMocks)
@@ -877,6 +878,37 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.defaultClusterGetter.EXPECT().HasDefaultCluster().Times(0)
 			},
 		},
+		"deploy with the permissions boundary and role path of the application": {
+			inApp: "phonetool",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:                "phonetool",
+					PermissionsBoundary: "MyBoundary",
+					RolePath:            "/copilot/",
+				}, nil)
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), &deploy.CreateTaskResourcesInput{
+					Name:                inGroupName,
+					Image:               "",
+					Command:             []string{},
+					EntryPoint:          []string{},
+					App:                 "phonetool",
+					PermissionsBoundary: "MyBoundary",
+					RolePath:            "/copilot/",
+				}).Return(nil)
+				mockRepositoryAnytime(m)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Return(nil)
+				m.runner.EXPECT().Run().AnyTimes()
+				mockHasDefaultCluster(m)
+			},
+		},
+		"fail to get the application": {
+			inApp: "phonetool",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
 		"deploy without execution role option if env is empty": {
 			setupMocks: func(m runTaskMocks) {
 				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
@@ -1028,6 +1060,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					imageTag:              tc.inTag,
 					dockerfileContextPath: tc.inDockerCtx,
 
+					appName:    tc.inApp,
 					env:        tc.inEnv,
 					follow:     tc.inFollow,
 					secrets:    tc.inSecrets,
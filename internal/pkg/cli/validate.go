@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"regexp"
 	"strconv"
@@ -36,6 +37,8 @@ var (
 	errValueNotIPNetSlice   = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
 	errPortInvalid          = errors.New("value must be in range 1-65535")
 	errDomainInvalid        = errors.New("value must contain at least one '.' character")
+	errPolicyNameInvalid    = errors.New("value must be the name of an IAM policy, optionally prefixed with its path (example: boundaries/MyBoundary)")
+	errRolePathInvalid      = errors.New("value must be an IAM path that begins and ends with a '/' (example: /copilot/)")
 	errDurationInvalid      = errors.New("value must be a valid Go duration string (example: 1h30m)")
 	errDurationBadUnits     = errors.New("duration cannot be in units smaller than a second")
 	errScheduleInvalid      = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
@@ -62,9 +65,15 @@ var (
 	errInvalidRDSNameCharacters    = errors.New("value must start with a letter")
 	errRDWSNotConnectedToVPC       = fmt.Errorf("%s requires a VPC connection", manifest.RequestDrivenWebServiceType)
 	fmtErrInvalidEngineType        = "invalid engine type %s: must be one of %s"
+	fmtErrInvalidServerlessVersion = "invalid serverless version %s: must be one of %s"
+	fmtErrInvalidInstanceClass     = "invalid DB instance class %s: must be of the form db.<class>.<size>"
+	errInvalidAuroraCapacity       = errors.New("value must be between 0.5 and 128 in increments of 0.5")
 	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
 	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
 
+	// SQS-specific errors.
+	errValueBadFormatWithUnderscore = errors.New("value must contain only alphanumeric characters and _-")
+
 	// Topic subscription errors.
 	errMissingPublishTopicField = errors.New("field `publish.topics[].name` cannot be empty")
 	errInvalidPubSubTopicName   = errors.New("topic names can only contain letters, numbers, underscores, and hyphens")
@@ -114,6 +123,14 @@ var (
 
 	domainNameRegexp = regexp.MustCompile(`\.`) // Check for at least one dot in domain name.
 
+	// IAM policy names can contain alphanumeric characters and +=,.@_- up to 128 characters, and be nested under a path.
+	// See https://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicy.html
+	policyNameRegexp = regexp.MustCompile(`^([\w+=,.@-]+/)*[\w+=,.@-]{1,128}$`)
+
+	// IAM role paths must begin and end with a slash and contain printable ASCII characters up to 512 characters.
+	// See https://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateRole.html
+	rolePathRegexp = regexp.MustCompile(`^/([\x21-\x7E]{0,510}/)?$`)
+
 	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Check for strings of the form rate(*) or cron(*).
 )
 
@@ -142,8 +159,15 @@ var (
 		`[a-zA-Z0-9\-\.\_]*` + // Followed by alphanumeric, ._-. Refers to POSIX portable file name character set.
 		"$", // End of string.
 	)
+
+	// https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Concepts.DBInstanceClass.html
+	rdsInstanceClassRegExp = regexp.MustCompile(`^db\.[a-z0-9]+\.[a-z0-9]+$`)
 )
 
+// SQS queue name validation expression.
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_CreateQueue.html#API_CreateQueue_RequestParameters
+var sqsQueueNameRegExp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 // SSM secret parameter name validation expression.
 // https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_PutParameter.html#systemsmanager-PutParameter-request-Name
 var secretParameterNameRegExp = regexp.MustCompile("^[a-zA-Z0-9_.-]+$")
@@ -263,6 +287,28 @@ func validateDomainName(val interface{}) error {
 	return nil
 }
 
+func validatePermissionsBoundary(val interface{}) error {
+	policyName, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !policyNameRegexp.MatchString(policyName) {
+		return errPolicyNameInvalid
+	}
+	return nil
+}
+
+func validateRolePath(val interface{}) error {
+	path, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !rolePathRegexp.MatchString(path) {
+		return errRolePathInvalid
+	}
+	return nil
+}
+
 func validatePath(fs afero.Fs, val interface{}) error {
 	path, ok := val.(string)
 	if !ok {
@@ -292,23 +338,33 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 		return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 	}
 
-	if storageType == rdsStorageType {
-		return validateAuroraStorageType(opts.ws, opts.workloadName)
+	if isVPCStorageType(storageType) {
+		return validateVPCStorageType(opts.ws, opts.workloadName, storageType)
 	}
 	return nil
 }
 
-func validateAuroraStorageType(ws manifestReader, workloadName string) error {
+// isVPCStorageType returns true if the storage resource is placed in the environment's VPC,
+// and is therefore only reachable by workloads that are connected to the VPC.
+func isVPCStorageType(storageType string) bool {
+	switch storageType {
+	case rdsStorageType, redisStorageType, openSearchStorageType:
+		return true
+	}
+	return false
+}
+
+func validateVPCStorageType(ws manifestReader, workloadName, storageType string) error {
 	if workloadName == "" {
 		return nil // Workload not yet selected while validating storage type flag.
 	}
 	mft, err := ws.ReadWorkloadManifest(workloadName)
 	if err != nil {
-		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", rdsStorageType, workloadName, err)
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", storageType, workloadName, err)
 	}
 	mftType, err := mft.WorkloadType()
 	if err != nil {
-		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", rdsStorageType, workloadName, err)
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", storageType, workloadName, err)
 	}
 	if mftType != manifest.RequestDrivenWebServiceType {
 		return nil
@@ -317,10 +373,10 @@ func validateAuroraStorageType(ws manifestReader, workloadName string) error {
 		Network manifest.RequestDrivenWebServiceNetworkConfig `yaml:"network"`
 	}{}
 	if err := yaml.Unmarshal(mft, &data); err != nil {
-		return fmt.Errorf("invalid storage type %s: unmarshal manifest for %s to read network config: %w", rdsStorageType, workloadName, err)
+		return fmt.Errorf("invalid storage type %s: unmarshal manifest for %s to read network config: %w", storageType, workloadName, err)
 	}
 	if data.Network.IsEmpty() {
-		return fmt.Errorf("invalid storage type %s: %w", rdsStorageType, errRDWSNotConnectedToVPC)
+		return fmt.Errorf("invalid storage type %s: %w", storageType, errRDWSNotConnectedToVPC)
 	}
 	return nil
 }
@@ -398,6 +454,38 @@ func validateEngine(val interface{}) error {
 	return fmt.Errorf(fmtErrInvalidEngineType, engine, prettify(engineTypes))
 }
 
+func validateServerlessVersion(val interface{}) error {
+	version, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, valid := range serverlessVersions {
+		if version == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidServerlessVersion, version, prettify(serverlessVersions))
+}
+
+func validateInstanceClass(val interface{}) error {
+	class, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !rdsInstanceClassRegExp.MatchString(class) {
+		return fmt.Errorf(fmtErrInvalidInstanceClass, class)
+	}
+	return nil
+}
+
+// validateAuroraCapacity validates the number of Aurora capacity units (ACUs) of an Aurora Serverless v2 cluster.
+func validateAuroraCapacity(capacity float64) error {
+	if capacity < 0.5 || capacity > 128 || math.Mod(capacity, 0.5) != 0 {
+		return errInvalidAuroraCapacity
+	}
+	return nil
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
@@ -626,6 +714,51 @@ func rdsNameValidation(val interface{}) error {
 	return nil
 }
 
+// The storage names for Redis and OpenSearch storage types are only used as logical ID prefixes in the CFN template.
+// The replication group and domain identifiers are generated by CFN, so we only need to make sure that the
+// longest logical ID built from the name stays within the 255 characters limit.
+// https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/cloudformation-limits.html
+func redisNameValidation(val interface{}) error {
+	return logicalIDPrefixValidation(val, 255-len("EnvRedisConfigurationMap"))
+}
+
+func openSearchNameValidation(val interface{}) error {
+	return logicalIDPrefixValidation(val, 255-len("EnvDomainConfigurationMap"))
+}
+
+func logicalIDPrefixValidation(val interface{}, maxLength int) error {
+	const minLength = 1
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minLength || len(s) > maxLength {
+		return fmt.Errorf(fmtErrValueBadSize, minLength, maxLength)
+	}
+	if m := rdsStorageNameRegExp.FindStringSubmatch(s); m == nil {
+		return errInvalidRDSNameCharacters
+	}
+	return nil
+}
+
+func sqsQueueNameValidation(val interface{}) error {
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/quotas-queues.html
+	const minSQSQueueNameLength = 1
+	const maxSQSQueueNameLength = 80
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minSQSQueueNameLength || len(s) > maxSQSQueueNameLength {
+		return fmt.Errorf(fmtErrValueBadSize, minSQSQueueNameLength, maxSQSQueueNameLength)
+	}
+	if m := sqsQueueNameRegExp.FindStringSubmatch(s); m == nil {
+		return errValueBadFormatWithUnderscore
+	}
+	return nil
+}
+
 func validateKey(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
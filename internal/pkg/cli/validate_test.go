@@ -261,6 +261,33 @@ func TestValidateRDSName(t *testing.T) {
 	}
 }
 
+func TestValidateSQSQueueName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "good_queue-name",
+			want:  nil,
+		},
+		"too long": {
+			input: strings.Repeat("a", 81),
+			want:  errors.New("value must be between 1 and 80 characters in length"),
+		},
+		"bad character": {
+			input: "not.good",
+			want:  errValueBadFormatWithUnderscore,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := sqsQueueNameValidation(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidatePath(t *testing.T) {
 	testCases := map[string]struct {
 		input interface{}
@@ -377,6 +404,41 @@ type: Request-Driven Web Service
 			},
 			want: errors.New("invalid storage type Aurora: Request-Driven Web Service requires a VPC connection"),
 		},
+		"should allow SQS for a RDWS that is not connected to a VPC": {
+			input: "SQS",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+`),
+				},
+				workloadName: "api",
+			},
+		},
+		"should return an error if Redis is selected for a RDWS while not connected to a VPC": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+`),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type Redis: Request-Driven Web Service requires a VPC connection"),
+		},
+		"should return an error if manifest file cannot be read while initializing an OpenSearch storage type": {
+			input: "OpenSearch",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					err: errors.New("some error"),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type OpenSearch: read manifest file for api: some error"),
+		},
 		"should succeed if Aurora is selected and RDWS is connected to a VPC": {
 			input: "Aurora",
 			optionals: validateStorageTypeOpts{
@@ -680,6 +742,69 @@ func TestValidateEngine(t *testing.T) {
 	}
 }
 
+func TestValidateInstanceClass(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "db.r6g.large",
+			want:  nil,
+		},
+		"missing prefix": {
+			input: "r6g.large",
+			want:  errors.New("invalid DB instance class r6g.large: must be of the form db.<class>.<size>"),
+		},
+		"serverless is not an instance class of a provisioned cluster": {
+			input: "db.serverless",
+			want:  errors.New("invalid DB instance class db.serverless: must be of the form db.<class>.<size>"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateInstanceClass(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateAuroraCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		input float64
+		want  error
+	}{
+		"minimum capacity": {
+			input: 0.5,
+		},
+		"maximum capacity": {
+			input: 128,
+		},
+		"too small": {
+			input: 0,
+			want:  errInvalidAuroraCapacity,
+		},
+		"too large": {
+			input: 128.5,
+			want:  errInvalidAuroraCapacity,
+		},
+		"not an increment of 0.5": {
+			input: 2.25,
+			want:  errInvalidAuroraCapacity,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateAuroraCapacity(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidateMySQLDBName(t *testing.T) {
 	testCases := map[string]testCase{
 		"good case": {
@@ -742,6 +867,90 @@ func TestValidatePostgreSQLDBName(t *testing.T) {
 	}
 }
 
+func TestValidatePermissionsBoundary(t *testing.T) {
+	testCases := map[string]testCase{
+		"string as input": {
+			input: "MyBoundary",
+		},
+		"policy under a path": {
+			input: "boundaries/copilot/MyBoundary",
+		},
+		"empty string": {
+			input: "",
+			want:  errPolicyNameInvalid,
+		},
+		"policy ARN": {
+			input: "arn:aws:iam::123456789012:policy/MyBoundary",
+			want:  errPolicyNameInvalid,
+		},
+		"bad character": {
+			input: "My Boundary",
+			want:  errPolicyNameInvalid,
+		},
+		"number as input": {
+			input: 1234,
+			want:  errValueNotAString,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validatePermissionsBoundary(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateRolePath(t *testing.T) {
+	testCases := map[string]testCase{
+		"default path": {
+			input: "/",
+		},
+		"nested path": {
+			input: "/copilot/teams/",
+		},
+		"empty string": {
+			input: "",
+			want:  errRolePathInvalid,
+		},
+		"missing leading slash": {
+			input: "copilot/",
+			want:  errRolePathInvalid,
+		},
+		"missing trailing slash": {
+			input: "/copilot",
+			want:  errRolePathInvalid,
+		},
+		"bad character": {
+			input: "/my path/",
+			want:  errRolePathInvalid,
+		},
+		"too long": {
+			input: "/" + strings.Repeat("a", 511) + "/",
+			want:  errRolePathInvalid,
+		},
+		"number as input": {
+			input: 1234,
+			want:  errValueNotAString,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRolePath(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidateSecretName(t *testing.T) {
 	testCases := map[string]testCase{
 		"bad character": {
@@ -14,12 +14,16 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name               string            `json:"name"`               // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID          string            `json:"account"`            // AccountID this app is mastered in.
-	Domain             string            `json:"domain"`             // Existing domain name in Route53. An empty domain name means the user does not have one.
-	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
-	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
+	Name                string            `json:"name"`                          // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID           string            `json:"account"`                       // AccountID this app is mastered in.
+	Domain              string            `json:"domain"`                        // Existing domain name in Route53. An empty domain name means the user does not have one.
+	DomainHostedZoneID  string            `json:"domainHostedZoneID"`            // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
+	Version             string            `json:"version"`                       // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags                map[string]string `json:"tags,omitempty"`                // Labels to apply to resources created within the app.
+	PermissionsBoundary string            `json:"permissionsBoundary,omitempty"` // Name of the IAM policy set as the permissions boundary of every role created within the app.
+	RolePath            string            `json:"rolePath,omitempty"`            // IAM path of every role created within the app. An empty path means the default path "/".
+	TemplateOverrides   string            `json:"templateOverrides,omitempty"`   // Directory of CloudFormation partials that replace Copilot's workload partials, relative to the workspace root.
+	PoliciesParameter   string            `json:"policiesParameter,omitempty"`   // Name of the SSM parameter with the organization's policy rules that every workload in the app is checked against.
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
@@ -6,25 +6,32 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
+// graviton matches the instance families that run on AWS Graviton processors.
+var graviton = regexp.MustCompile(`^(a1|[a-z]+\d+g[a-z]*)$`)
+
 // Environment represents a deployment environment in an application.
 type Environment struct {
-	App              string        `json:"app"`                    // Name of the app this environment belongs to.
-	Name             string        `json:"name"`                   // Name of the environment, must be unique within a App.
-	Region           string        `json:"region"`                 // Name of the region this environment is stored in.
-	AccountID        string        `json:"accountID"`              // Account ID of the account this environment is stored in.
-	Prod             bool          `json:"prod"`                   // Deprecated. Whether or not this environment is a production environment.
-	RegistryURL      string        `json:"registryURL"`            // URL For ECR Registry for this environment.
-	ExecutionRoleARN string        `json:"executionRoleARN"`       // ARN used by CloudFormation to make modification to the environment stack.
-	ManagerRoleARN   string        `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
-	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
-	Telemetry        *Telemetry    `json:"telemetry,omitempty"`    // Optional environment telemetry features.
+	App              string        `json:"app"`                      // Name of the app this environment belongs to.
+	Name             string        `json:"name"`                     // Name of the environment, must be unique within a App.
+	Region           string        `json:"region"`                   // Name of the region this environment is stored in.
+	AccountID        string        `json:"accountID"`                // Account ID of the account this environment is stored in.
+	Prod             bool          `json:"prod"`                     // Deprecated. Whether or not this environment is a production environment.
+	RegistryURL      string        `json:"registryURL"`              // URL For ECR Registry for this environment.
+	ExecutionRoleARN string        `json:"executionRoleARN"`         // ARN used by CloudFormation to make modification to the environment stack.
+	ManagerRoleARN   string        `json:"managerRoleARN"`           // ARN for the manager role assumed to manipulate the environment and its services.
+	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"`   // Custom environment configuration by users.
+	Telemetry        *Telemetry    `json:"telemetry,omitempty"`      // Optional environment telemetry features.
+	EC2Capacity      *EC2Capacity  `json:"ec2Capacity,omitempty"`    // Optional Auto Scaling group capacity provider for the environment's cluster.
+	ServiceConnect   bool          `json:"serviceConnect,omitempty"` // Whether the environment's cluster uses ECS Service Connect.
 }
 
 // CustomizeEnv represents the custom environment config.
@@ -62,6 +69,20 @@ type AdjustVPC struct {
 // Telemetry represents optional observability and monitoring configuration.
 type Telemetry struct {
 	EnableContainerInsights bool `json:"containerInsights"`
+	EncryptLogs             bool `json:"encryptLogs,omitempty"` // Whether the log groups of the workloads are encrypted with a KMS key of the environment.
+}
+
+// EC2Capacity holds the fields to configure an Auto Scaling group capacity provider for the environment's cluster.
+type EC2Capacity struct {
+	InstanceType string `json:"instanceType"`
+	MinSize      int    `json:"minSize"`
+	MaxSize      int    `json:"maxSize"`
+}
+
+// IsARM returns true if the instance type runs on an AWS Graviton processor, for example "t4g.medium" or "c7gn.large".
+func (c *EC2Capacity) IsARM() bool {
+	family := strings.SplitN(c.InstanceType, ".", 2)[0]
+	return graviton.MatchString(family)
 }
 
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
@@ -386,3 +386,37 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2Capacity_IsARM(t *testing.T) {
+	testCases := map[string]struct {
+		instanceType string
+		wanted       bool
+	}{
+		"x86 instance type": {
+			instanceType: "m5.large",
+			wanted:       false,
+		},
+		"x86 instance type with local NVMe storage": {
+			instanceType: "c5d.xlarge",
+			wanted:       false,
+		},
+		"first generation Graviton": {
+			instanceType: "a1.medium",
+			wanted:       true,
+		},
+		"burstable Graviton": {
+			instanceType: "t4g.small",
+			wanted:       true,
+		},
+		"Graviton with extra attributes": {
+			instanceType: "c7gn.large",
+			wanted:       true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := &EC2Capacity{InstanceType: tc.instanceType}
+			require.Equal(t, tc.wanted, c.IsARM())
+		})
+	}
+}
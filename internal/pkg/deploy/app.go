@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
 const appDNSDelegationRoleName = "DNSDelegationRole"
@@ -22,13 +23,15 @@ type CreateAppInput struct {
 	DomainHostedZoneID    string            // Hosted Zone ID for the domain.
 	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
 	Version               string            // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	PermissionsBoundary   string            // Name of the IAM policy set as the permissions boundary of the roles created for the application.
+	RolePath              string            // IAM path of the roles created for the application.
 }
 
 const (
 	// LegacyAppTemplateVersion is the version associated with the application template before we started versioning.
 	LegacyAppTemplateVersion = "v0.0.0"
 	// LatestAppTemplateVersion is the latest version number available for application templates.
-	LatestAppTemplateVersion = "v1.0.2"
+	LatestAppTemplateVersion = "v1.1.0"
 	// AliasLeastAppTemplateVersion is the least version number available for HTTPS alias.
 	AliasLeastAppTemplateVersion = "v1.0.0"
 )
@@ -38,6 +41,8 @@ type AppInformation struct {
 	AccountPrincipalARN string
 	DNSName             string
 	Name                string
+	PermissionsBoundary string
+	RolePath            string
 }
 
 // DNSDelegationRole returns the ARN of the app's DNS delegation role.
@@ -50,7 +55,7 @@ func (a *AppInformation) DNSDelegationRole() string {
 	if err != nil {
 		return ""
 	}
-	return fmt.Sprintf("arn:%s:iam::%s:role/%s", appRole.Partition, appRole.AccountID, DNSDelegationRoleName(a.Name))
+	return fmt.Sprintf("arn:%s:iam::%s:role%s%s", appRole.Partition, appRole.AccountID, template.RolePathFunc(a.RolePath), DNSDelegationRoleName(a.Name))
 }
 
 // DNSDelegationRoleName returns the DNSDelegation role name of the app.
@@ -43,6 +43,15 @@ func TestAppInformation_DNSDelegationRole(t *testing.T) {
 				DNSName:             "ecs.aws",
 			},
 		},
+		"with a custom role path": {
+			want: "arn:aws:iam::0000000:role/copilot/-DNSDelegationRole",
+
+			in: &AppInformation{
+				AccountPrincipalARN: "arn:aws:iam::0000000:root",
+				DNSName:             "ecs.aws",
+				RolePath:            "/copilot/",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -131,11 +131,13 @@ func (cf CloudFormation) upgradeAppStack(s *cloudformation.Stack) error {
 // DNS HostedZone. This allows us to perform cross account DNS delegation.
 func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, accountID string) error {
 	deployApp := deploy.CreateAppInput{
-		Name:               app.Name,
-		AccountID:          app.AccountID,
-		DomainName:         app.Domain,
-		DomainHostedZoneID: app.DomainHostedZoneID,
-		Version:            deploy.LatestAppTemplateVersion,
+		Name:                app.Name,
+		AccountID:           app.AccountID,
+		DomainName:          app.Domain,
+		DomainHostedZoneID:  app.DomainHostedZoneID,
+		Version:             deploy.LatestAppTemplateVersion,
+		PermissionsBoundary: app.PermissionsBoundary,
+		RolePath:            app.RolePath,
 	}
 
 	appConfig := stack.NewAppStackConfig(&deployApp)
@@ -246,6 +248,7 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 		AccountID:      app.AccountID,
 		AdditionalTags: app.Tags,
 		Version:        deploy.LatestAppTemplateVersion,
+		RolePath:       app.RolePath,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
@@ -304,6 +307,7 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 		Name:      app.Name,
 		AccountID: app.AccountID,
 		Version:   deploy.LatestAppTemplateVersion,
+		RolePath:  app.RolePath,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
@@ -357,6 +361,7 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 		AccountID:      opts.App.AccountID,
 		AdditionalTags: opts.App.Tags,
 		Version:        deploy.LatestAppTemplateVersion,
+		RolePath:       opts.App.RolePath,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
@@ -414,6 +419,7 @@ func (cf CloudFormation) AddPipelineResourcesToApp(
 		Name:      app.Name,
 		AccountID: app.AccountID,
 		Version:   deploy.LatestAppTemplateVersion,
+		RolePath:  app.RolePath,
 	})
 
 	// conditionally create a new stack instance in the application region
@@ -0,0 +1,38 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// DeployEnvAddons deploys the stack of the addons shared by the workloads in an environment,
+// and renders progress updates to out until the deployment is done.
+func (cf CloudFormation) DeployEnvAddons(out progress.FileWriter, in *deploy.CreateEnvAddonsInput) error {
+	s, err := toStack(stack.NewEnvAddonsStackConfig(in))
+	if err != nil {
+		return err
+	}
+	if in.CFNServiceRoleARN != "" {
+		s.RoleARN = aws.String(in.CFNServiceRoleARN)
+	}
+	if err := cf.renderStackChanges(cf.newRenderWorkloadInput(out, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteEnvAddons removes the CloudFormation stack of the addons shared by the workloads in an environment.
+func (cf CloudFormation) DeleteEnvAddons(appName, envName, cfnExecRoleARN string) error {
+	return cf.cfnClient.DeleteAndWaitWithRoleARN(stack.NameForEnvAddons(appName, envName), cfnExecRoleARN)
+}
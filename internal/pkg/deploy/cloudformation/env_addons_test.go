@@ -0,0 +1,36 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+func TestCloudFormation_DeployEnvAddons(t *testing.T) {
+	mockEnvAddons := &deploy.CreateEnvAddonsInput{
+		App:               "phonetool",
+		Env:               "test",
+		Template:          "Resources: {}",
+		CFNServiceRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+	}
+	when := func(w progress.FileWriter, cf CloudFormation) error {
+		return cf.DeployEnvAddons(w, mockEnvAddons)
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployTask_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("calls Update if stack is already created and returns wrapped error if Update fails", func(t *testing.T) {
+		testDeployTask_OnUpdateChangeSetFailure(t, when)
+	})
+	t.Run("returns nil if the change set is empty when calling Update", func(t *testing.T) {
+		testDeployTask_ReturnNilOnEmptyChangeSetWhileUpdatingStack(t, when)
+	})
+	t.Run("returns an error when the ChangeSet cannot be described for stack changes before rendering", func(t *testing.T) {
+		testDeployTask_OnDescribeChangeSetFailure(t, when)
+	})
+}
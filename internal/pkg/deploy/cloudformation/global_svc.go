@@ -0,0 +1,42 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// DeployGlobalService deploys the stack that routes the alias of a global service to its load balancer
+// in an environment, and renders progress updates to out until the deployment is done.
+func (cf CloudFormation) DeployGlobalService(out progress.FileWriter, in *deploy.CreateGlobalServiceInput) error {
+	s, err := toStack(stack.NewGlobalServiceStackConfig(in))
+	if err != nil {
+		return err
+	}
+	if err := cf.renderStackChanges(cf.newRenderWorkloadInput(out, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteGlobalService removes the CloudFormation stack of a global service in an environment, if it exists.
+func (cf CloudFormation) DeleteGlobalService(app, env, svc string) error {
+	stackName := stack.NameForGlobalService(app, env, svc)
+	if _, err := cf.cfnClient.Describe(stackName); err != nil {
+		var errStackNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return nil
+		}
+		return err
+	}
+	return cf.cfnClient.DeleteAndWait(stackName)
+}
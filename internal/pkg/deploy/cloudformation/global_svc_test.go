@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_DeployGlobalService(t *testing.T) {
+	mockGlobalSvc := &deploy.CreateGlobalServiceInput{
+		App:                      "phonetool",
+		Env:                      "us",
+		Name:                     "api",
+		AppDomain:                "example.com",
+		Alias:                    "api.phonetool.example.com",
+		Routing:                  "latency",
+		Region:                   "us-west-2",
+		LoadBalancerDNSName:      "us-lb.us-west-2.elb.amazonaws.com",
+		LoadBalancerHostedZoneID: "Z1H1FL5HABSF5",
+	}
+	when := func(w progress.FileWriter, cf CloudFormation) error {
+		return cf.DeployGlobalService(w, mockGlobalSvc)
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployTask_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("calls Update if stack is already created and returns wrapped error if Update fails", func(t *testing.T) {
+		testDeployTask_OnUpdateChangeSetFailure(t, when)
+	})
+	t.Run("returns nil if the change set is empty when calling Update", func(t *testing.T) {
+		testDeployTask_ReturnNilOnEmptyChangeSetWhileUpdatingStack(t, when)
+	})
+	t.Run("returns an error when the ChangeSet cannot be described for stack changes before rendering", func(t *testing.T) {
+		testDeployTask_OnDescribeChangeSetFailure(t, when)
+	})
+}
+
+func TestCloudFormation_DeleteGlobalService(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockcfnClient)
+
+		wantedErr error
+	}{
+		"skip if the stack does not exist": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-us-api-global").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().DeleteAndWait(gomock.Any()).Times(0)
+			},
+		},
+		"error if the stack can't be described": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-us-api-global").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"delete the stack": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("phonetool-us-api-global").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().DeleteAndWait("phonetool-us-api-global").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.setupMocks(m)
+			client := CloudFormation{cfnClient: m}
+
+			// WHEN
+			err := client.DeleteGlobalService("phonetool", "us", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -62,8 +62,8 @@ const (
 	appDomainHostedZoneIDKey      = "AppDomainHostedZoneID"
 	appNameKey                    = "AppName"
 
-	// arn:${partition}:iam::${account}:role/${roleName}
-	fmtStackSetAdminRoleARN = "arn:%s:iam::%s:role/%s"
+	// arn:${partition}:iam::${account}:role${rolePath}${roleName}
+	fmtStackSetAdminRoleARN = "arn:%s:iam::%s:role%s%s"
 )
 
 var cfTemplateFunctions = map[string]interface{}{
@@ -92,9 +92,13 @@ func (c *AppStackConfig) Template() (string, error) {
 	content, err := c.parser.Parse(appTemplatePath, struct {
 		TemplateVersion         string
 		AppDNSDelegatedAccounts []string
+		PermissionsBoundary     string
+		RolePath                string
 	}{
 		c.Version,
 		c.dnsDelegationAccounts(),
+		c.PermissionsBoundary,
+		c.RolePath,
 	})
 	if err != nil {
 		return "", err
@@ -197,7 +201,7 @@ func (c *AppStackConfig) StackSetAdminRoleARN(region string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(fmtStackSetAdminRoleARN, partition.ID(), c.AccountID, c.stackSetAdminRoleName()), nil
+	return fmt.Sprintf(fmtStackSetAdminRoleARN, partition.ID(), c.AccountID, template.RolePathFunc(c.RolePath), c.stackSetAdminRoleName()), nil
 }
 
 // StackSetExecutionRoleName returns the role name of the role used to actually create
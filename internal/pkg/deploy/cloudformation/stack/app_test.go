@@ -46,9 +46,13 @@ func TestAppTemplate(t *testing.T) {
 				m.EXPECT().Parse(appTemplatePath, struct {
 					TemplateVersion         string
 					AppDNSDelegatedAccounts []string
+					PermissionsBoundary     string
+					RolePath                string
 				}{
 					"v1.0.0",
 					[]string{"123456"},
+					"MyBoundary",
+					"/copilot/",
 				}, gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("template"),
 				}, nil)
@@ -66,8 +70,10 @@ func TestAppTemplate(t *testing.T) {
 			defer ctrl.Finish()
 			appStack := &AppStackConfig{
 				CreateAppInput: &deploy.CreateAppInput{
-					Version:   tc.inVersion,
-					AccountID: "123456",
+					Version:             tc.inVersion,
+					AccountID:           "123456",
+					PermissionsBoundary: "MyBoundary",
+					RolePath:            "/copilot/",
 				},
 			}
 			tc.mockDependencies(ctrl, appStack)
@@ -379,6 +385,36 @@ func TestAppStackSetName(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%s-infrastructure", app.Name), app.StackSetName())
 }
 
+func TestAppStackConfig_StackSetAdminRoleARN(t *testing.T) {
+	testCases := map[string]struct {
+		inRolePath string
+
+		wantedARN string
+	}{
+		"default path": {
+			wantedARN: "arn:aws:iam::1234:role/testapp-adminrole",
+		},
+		"custom role path": {
+			inRolePath: "/copilot/",
+
+			wantedARN: "arn:aws:iam::1234:role/copilot/testapp-adminrole",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &AppStackConfig{
+				CreateAppInput: &deploy.CreateAppInput{Name: "testapp", AccountID: "1234", RolePath: tc.inRolePath},
+			}
+
+			got, err := app.StackSetAdminRoleARN("us-west-2")
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, got)
+		})
+	}
+}
+
 func TestTemplateToAppConfig(t *testing.T) {
 	given := `AWSTemplateFormatVersion: '2010-09-09'
 Description: Cross-regional resources to support the CodePipeline for a workspace
@@ -40,11 +40,18 @@ type BackendService struct {
 
 // NewBackendService creates a new BackendService stack from a manifest file.
 func NewBackendService(mft *manifest.BackendService, env, app string, rc RuntimeConfig) (*BackendService, error) {
-	parser := template.New()
+	parser, err := newWorkloadParser(rc)
+	if err != nil {
+		return nil, err
+	}
 	addons, err := addon.New(aws.StringValue(mft.Name))
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
 	return &BackendService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
@@ -58,6 +65,7 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 			},
 			logRetention:        mft.Logging.Retention,
 			tc:                  mft.TaskConfig,
+			envAddons:           envAddons,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
 		manifest: mft,
@@ -84,11 +92,15 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	envAddonsOutputs, err := s.envAddonsOutputs()
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(s.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
@@ -107,6 +119,9 @@ func (s *BackendService) Template() (string, error) {
 		desiredCountOnSpot = advancedCount.Spot
 		capacityProviders = advancedCount.Cps
 	}
+	if !s.manifest.Capacity.IsEmpty() {
+		capacityProviders = convertCapacityProviderStrategies(s.manifest.Capacity)
+	}
 	entrypoint, err := convertEntryPoint(s.manifest.EntryPoint)
 	if err != nil {
 		return "", err
@@ -119,15 +134,19 @@ func (s *BackendService) Template() (string, error) {
 		Variables:                s.manifest.BackendServiceConfig.Variables,
 		Secrets:                  convertSecrets(s.manifest.BackendServiceConfig.Secrets),
 		NestedStack:              addonsOutputs,
+		EnvAddons:                envAddonsOutputs,
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
+		LaunchOnEC2:              s.manifest.Capacity.UsesProvider(manifest.CapacityProviderEC2),
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ServiceConnect:           convertServiceConnect(s.manifest.Network.Connect, aws.String(s.name)),
 		WorkloadType:             manifest.BackendServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
-		LogConfig:                convertLogging(s.manifest.Logging),
+		LogConfig:                convertLogging(s.manifest.Logging, s.rc.Region),
+		LogGroup:                 convertLogGroup(s.manifest.Logging, s.rc.EncryptLogs),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
@@ -138,7 +157,11 @@ func (s *BackendService) Template() (string, error) {
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
+		PermissionsBoundary:      s.rc.PermissionsBoundary,
+		RolePath:                 s.rc.RolePath,
 		Publish:                  publishers,
+		Observability:            convertObservability(s.manifest.Observability),
+		Permissions:              convertPermissions(s.manifest.Permissions),
 		Platform:                 convertPlatform(s.manifest.Platform),
 	})
 	if err != nil {
@@ -187,6 +187,7 @@ Outputs:
 					StringSlice: []string{"here"},
 				}
 				svc.manifest.ExecuteCommand = manifest.ExecuteCommand{Enable: aws.Bool(true)}
+				svc.manifest.Observability = manifest.Observability{Tracing: aws.String("awsxray")}
 			},
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
 				m := mocks.NewMockbackendSvcReadParser(ctrl)
@@ -215,6 +216,9 @@ Outputs:
 					},
 					EntryPoint: []string{"enter", "from"},
 					Command:    []string{"here"},
+					Observability: template.ObservabilityOpts{
+						Tracing: "AWSXRAY",
+					},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				svc.parser = m
 				svc.addons = mockAddons{
@@ -41,6 +41,9 @@ const (
 	EnvOutputVPCID                   = "VpcId"
 	EnvOutputPublicSubnets           = "PublicSubnets"
 	EnvOutputPrivateSubnets          = "PrivateSubnets"
+	EnvOutputPublicLoadBalancerDNS   = "PublicLoadBalancerDNSName"
+	EnvOutputPublicLoadBalancerZone  = "PublicLoadBalancerHostedZone"
+	EnvOutputEventBusName            = "EventBusName"
 	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
 	EnvParamServiceDiscoveryEndpoint = "ServiceDiscoveryEndpoint"
@@ -100,6 +103,10 @@ func (e *EnvStackConfig) Template() (string, error) {
 		VPCConfig:              vpcConf,
 		Version:                e.in.Version,
 		Telemetry:              e.in.Telemetry,
+		EC2Capacity:            e.in.EC2Capacity,
+		ServiceConnect:         e.in.ServiceConnect,
+		PermissionsBoundary:    e.in.App.PermissionsBoundary,
+		RolePath:               e.in.App.RolePath,
 		LatestVersion:          deploy.LatestEnvTemplateVersion,
 	}, template.WithFuncs(map[string]interface{}{
 		"inc": template.IncFunc,
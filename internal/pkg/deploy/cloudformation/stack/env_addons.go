@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+// Parameter keys of the environment addons stack.
+const (
+	EnvAddonsAppNameParamKey = "App"
+	EnvAddonsEnvNameParamKey = "Env"
+	EnvAddonsNameParamKey    = "Name"
+)
+
+// EnvAddonsStackConfig is for providing all the values to deploy the addons
+// shared by the workloads in an environment.
+type EnvAddonsStackConfig struct {
+	*deploy.CreateEnvAddonsInput
+}
+
+// NewEnvAddonsStackConfig sets up a struct that provides stack configurations for CloudFormation
+// to deploy the environment addons stack.
+func NewEnvAddonsStackConfig(in *deploy.CreateEnvAddonsInput) *EnvAddonsStackConfig {
+	return &EnvAddonsStackConfig{
+		CreateEnvAddonsInput: in,
+	}
+}
+
+// StackName returns the name of the CloudFormation stack for the environment addons.
+func (c *EnvAddonsStackConfig) StackName() string {
+	return NameForEnvAddons(c.App, c.Env)
+}
+
+// Template returns the merged CloudFormation template of the environment addons.
+func (c *EnvAddonsStackConfig) Template() (string, error) {
+	return c.CreateEnvAddonsInput.Template, nil
+}
+
+// Parameters returns the parameter values to be passed to the environment addons CloudFormation template.
+// The "Name" parameter is set to the environment name so that addon templates written for a workload
+// can be reused as environment addons.
+func (c *EnvAddonsStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(EnvAddonsAppNameParamKey),
+			ParameterValue: aws.String(c.App),
+		},
+		{
+			ParameterKey:   aws.String(EnvAddonsEnvNameParamKey),
+			ParameterValue: aws.String(c.Env),
+		},
+		{
+			ParameterKey:   aws.String(EnvAddonsNameParamKey),
+			ParameterValue: aws.String(c.Env),
+		},
+	}, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (c *EnvAddonsStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the environment addons CloudFormation stack.
+func (c *EnvAddonsStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(c.AdditionalTags, map[string]string{
+		deploy.AppTagKey: c.App,
+		deploy.EnvTagKey: c.Env,
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvAddonsStackConfig(t *testing.T) {
+	// GIVEN
+	conf := NewEnvAddonsStackConfig(&deploy.CreateEnvAddonsInput{
+		App:      "phonetool",
+		Env:      "test",
+		Template: "Resources: {}",
+		AdditionalTags: map[string]string{
+			"owner": "boss",
+		},
+	})
+
+	// WHEN
+	tpl, err := conf.Template()
+	require.NoError(t, err)
+	params, err := conf.Parameters()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "phonetool-test-env-addons", conf.StackName())
+	require.Equal(t, "Resources: {}", tpl)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("App"),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String("Env"),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String("Name"),
+			ParameterValue: aws.String("test"),
+		},
+	}, params)
+	require.ElementsMatch(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String(deploy.AppTagKey),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String(deploy.EnvTagKey),
+			Value: aws.String("test"),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("boss"),
+		},
+	}, conf.Tags())
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	globalSvcTemplatePath = "app/global-service.yml"
+
+	globalSvcFailoverPrimary   = "PRIMARY"
+	globalSvcFailoverSecondary = "SECONDARY"
+)
+
+// GlobalServiceStackConfig is for providing all the values to set up the DNS record
+// that routes the global alias of a service to its load balancer in one environment.
+type GlobalServiceStackConfig struct {
+	*deploy.CreateGlobalServiceInput
+	parser template.ReadParser
+}
+
+// NewGlobalServiceStackConfig sets up a struct that provides stack configurations for CloudFormation
+// to deploy the global service stack.
+func NewGlobalServiceStackConfig(in *deploy.CreateGlobalServiceInput) *GlobalServiceStackConfig {
+	return &GlobalServiceStackConfig{
+		CreateGlobalServiceInput: in,
+		parser:                   template.New(),
+	}
+}
+
+// StackName returns the name of the CloudFormation stack for the global service in the environment.
+func (c *GlobalServiceStackConfig) StackName() string {
+	return NameForGlobalService(c.App, c.Env, c.Name)
+}
+
+// Template returns the global service CloudFormation template.
+func (c *GlobalServiceStackConfig) Template() (string, error) {
+	var failover string
+	if c.Routing == manifest.GlobalRoutingFailover {
+		failover = globalSvcFailoverSecondary
+		if c.IsPrimary {
+			failover = globalSvcFailoverPrimary
+		}
+	}
+	content, err := c.parser.Parse(globalSvcTemplatePath, struct {
+		Name                     string
+		Env                      string
+		Alias                    string
+		Routing                  string
+		Failover                 string
+		HostedZoneName           string
+		Region                   string
+		LoadBalancerDNSName      string
+		LoadBalancerHostedZoneID string
+		HealthAlarmName          string
+	}{
+		Name:                     c.Name,
+		Env:                      c.Env,
+		Alias:                    c.Alias,
+		Routing:                  c.Routing,
+		Failover:                 failover,
+		HostedZoneName:           c.hostedZoneName(),
+		Region:                   c.Region,
+		LoadBalancerDNSName:      c.LoadBalancerDNSName,
+		LoadBalancerHostedZoneID: c.LoadBalancerHostedZoneID,
+		HealthAlarmName:          NameForGlobalServiceHealthAlarm(c.App, c.Env, c.Name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("read template for global service stack: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values to be passed to the global service CloudFormation template.
+func (c *GlobalServiceStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return nil, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (c *GlobalServiceStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the global service CloudFormation stack.
+func (c *GlobalServiceStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(c.AdditionalTags, map[string]string{
+		deploy.AppTagKey:     c.App,
+		deploy.EnvTagKey:     c.Env,
+		deploy.ServiceTagKey: c.Name,
+	})
+}
+
+// hostedZoneName returns the name of the Copilot-managed hosted zone that the alias belongs to,
+// either the application's hosted zone or the root domain's hosted zone.
+func (c *GlobalServiceStackConfig) hostedZoneName() string {
+	appZone := fmt.Sprintf("%s.%s", c.App, c.AppDomain)
+	if c.Alias == appZone || strings.HasSuffix(c.Alias, "."+appZone) {
+		return appZone + "."
+	}
+	return c.AppDomain + "."
+}
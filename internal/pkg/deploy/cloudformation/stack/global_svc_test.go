@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGlobalServiceStackConfig_Template(t *testing.T) {
+	type resources struct {
+		HealthCheck struct {
+			Properties struct {
+				HealthCheckConfig struct {
+					Type            string `yaml:"Type"`
+					AlarmIdentifier struct {
+						Name   string `yaml:"Name"`
+						Region string `yaml:"Region"`
+					} `yaml:"AlarmIdentifier"`
+				} `yaml:"HealthCheckConfig"`
+			} `yaml:"Properties"`
+		} `yaml:"HealthCheck"`
+		AliasRecord struct {
+			Properties struct {
+				HostedZoneName string `yaml:"HostedZoneName"`
+				Name           string `yaml:"Name"`
+				SetIdentifier  string `yaml:"SetIdentifier"`
+				Region         string `yaml:"Region"`
+				Failover       string `yaml:"Failover"`
+				HealthCheckID  string `yaml:"HealthCheckId"`
+				AliasTarget    struct {
+					DNSName              string `yaml:"DNSName"`
+					HostedZoneID         string `yaml:"HostedZoneId"`
+					EvaluateTargetHealth bool   `yaml:"EvaluateTargetHealth"`
+				} `yaml:"AliasTarget"`
+			} `yaml:"Properties"`
+		} `yaml:"AliasRecord"`
+	}
+	testCases := map[string]struct {
+		inAlias     string
+		inRouting   string
+		inIsPrimary bool
+
+		wantedZone     string
+		wantedRegion   string
+		wantedFailover string
+	}{
+		"latency record in the application hosted zone": {
+			inAlias:   "api.phonetool.example.com",
+			inRouting: "latency",
+
+			wantedZone:   "phonetool.example.com.",
+			wantedRegion: "us-west-2",
+		},
+		"primary failover record in the root hosted zone": {
+			inAlias:     "api.example.com",
+			inRouting:   "failover",
+			inIsPrimary: true,
+
+			wantedZone:     "example.com.",
+			wantedFailover: "PRIMARY",
+		},
+		"secondary failover record": {
+			inAlias:   "api.example.com",
+			inRouting: "failover",
+
+			wantedZone:     "example.com.",
+			wantedFailover: "SECONDARY",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			conf := NewGlobalServiceStackConfig(&deploy.CreateGlobalServiceInput{
+				App:                      "phonetool",
+				Env:                      "us",
+				Name:                     "api",
+				AppDomain:                "example.com",
+				Alias:                    tc.inAlias,
+				Routing:                  tc.inRouting,
+				IsPrimary:                tc.inIsPrimary,
+				Region:                   "us-west-2",
+				LoadBalancerDNSName:      "us-lb.us-west-2.elb.amazonaws.com",
+				LoadBalancerHostedZoneID: "Z1H1FL5HABSF5",
+			})
+
+			tpl, err := conf.Template()
+
+			require.NoError(t, err)
+			var got struct {
+				Resources resources `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &got))
+			healthCheck := got.Resources.HealthCheck.Properties.HealthCheckConfig
+			require.Equal(t, "CLOUDWATCH_METRIC", healthCheck.Type)
+			require.Equal(t, "phonetool-us-api-global-health", healthCheck.AlarmIdentifier.Name)
+			require.Equal(t, "us-west-2", healthCheck.AlarmIdentifier.Region)
+			record := got.Resources.AliasRecord.Properties
+			require.Equal(t, tc.wantedZone, record.HostedZoneName)
+			require.Equal(t, tc.inAlias, record.Name)
+			require.Equal(t, "us", record.SetIdentifier)
+			require.Equal(t, tc.wantedRegion, record.Region)
+			require.Equal(t, tc.wantedFailover, record.Failover)
+			require.Equal(t, "us-lb.us-west-2.elb.amazonaws.com", record.AliasTarget.DNSName)
+			require.Equal(t, "Z1H1FL5HABSF5", record.AliasTarget.HostedZoneID)
+			require.True(t, record.AliasTarget.EvaluateTargetHealth)
+		})
+	}
+}
+
+func TestGlobalServiceStackConfig_StackName(t *testing.T) {
+	conf := NewGlobalServiceStackConfig(&deploy.CreateGlobalServiceInput{
+		App:  "phonetool",
+		Env:  "us",
+		Name: "api",
+	})
+
+	require.Equal(t, "phonetool-us-api-global", conf.StackName())
+}
+
+func TestGlobalServiceStackConfig_Tags(t *testing.T) {
+	conf := NewGlobalServiceStackConfig(&deploy.CreateGlobalServiceInput{
+		App:  "phonetool",
+		Env:  "us",
+		Name: "api",
+		AdditionalTags: map[string]string{
+			"owner": "boss",
+		},
+	})
+
+	require.ElementsMatch(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String(deploy.AppTagKey),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String(deploy.EnvTagKey),
+			Value: aws.String("us"),
+		},
+		{
+			Key:   aws.String(deploy.ServiceTagKey),
+			Value: aws.String("api"),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("boss"),
+		},
+	}, conf.Tags())
+}
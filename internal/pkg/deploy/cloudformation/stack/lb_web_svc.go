@@ -91,11 +91,18 @@ func WithDNSDelegation(app deploy.AppInformation) func(s *LoadBalancedWebService
 
 // NewLoadBalancedWebService creates a new CFN stack with an ECS service from a manifest file, given the options.
 func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig, opts ...LoadBalancedWebServiceOption) (*LoadBalancedWebService, error) {
-	parser := template.New()
+	parser, err := newWorkloadParser(rc)
+	if err != nil {
+		return nil, err
+	}
 	addons, err := addon.New(aws.StringValue(mft.Name))
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
 	s := &LoadBalancedWebService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
@@ -109,6 +116,7 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 			},
 			logRetention:        mft.Logging.Retention,
 			tc:                  mft.TaskConfig,
+			envAddons:           envAddons,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
 		manifest:     mft,
@@ -144,11 +152,15 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	envAddonsOutputs, err := s.envAddonsOutputs()
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(s.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
@@ -167,6 +179,9 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		desiredCountOnSpot = advancedCount.Spot
 		capacityProviders = advancedCount.Cps
 	}
+	if !s.manifest.Capacity.IsEmpty() {
+		capacityProviders = convertCapacityProviderStrategies(s.manifest.Capacity)
+	}
 
 	entrypoint, err := convertEntryPoint(s.manifest.EntryPoint)
 	if err != nil {
@@ -178,10 +193,17 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	}
 
 	var aliases []string
+	var globalAlias *template.GlobalAliasOpts
 	if s.httpsEnabled {
 		if aliases, err = convertAlias(s.manifest.RoutingRule.Alias); err != nil {
 			return "", err
 		}
+		if s.manifest.Global.IncludesEnv(s.env) {
+			globalAlias = &template.GlobalAliasOpts{
+				Name:            aws.StringValue(s.manifest.Global.Alias),
+				HealthAlarmName: NameForGlobalServiceHealthAlarm(s.app, s.env, s.name),
+			}
+		}
 	}
 
 	var deregistrationDelay *int64 = aws.Int64(60)
@@ -202,15 +224,20 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		Variables:                      s.manifest.TaskConfig.Variables,
 		Secrets:                        convertSecrets(s.manifest.TaskConfig.Secrets),
 		Aliases:                        aliases,
+		GlobalAlias:                    globalAlias,
 		NestedStack:                    addonsOutputs,
+		EnvAddons:                      envAddonsOutputs,
 		AddonsExtraParams:              addonsParams,
 		Sidecars:                       sidecars,
-		LogConfig:                      convertLogging(s.manifest.Logging),
+		LogConfig:                      convertLogging(s.manifest.Logging, s.rc.Region),
+		LogGroup:                       convertLogGroup(s.manifest.Logging, s.rc.EncryptLogs),
 		DockerLabels:                   s.manifest.ImageConfig.Image.DockerLabels,
 		Autoscaling:                    autoscaling,
 		CapacityProviders:              capacityProviders,
+		LaunchOnEC2:                    s.manifest.Capacity.UsesProvider(manifest.CapacityProviderEC2),
 		DesiredCountOnSpot:             desiredCountOnSpot,
 		ExecuteCommand:                 convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ServiceConnect:                 convertServiceConnect(s.manifest.Network.Connect, aws.String(s.name)),
 		WorkloadType:                   manifest.LoadBalancedWebServiceType,
 		HealthCheck:                    convertContainerHealthCheck(s.manifest.ImageConfig.HealthCheck),
 		HTTPHealthCheck:                convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck),
@@ -226,7 +253,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		DependsOn:                      convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:           aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint:       s.rc.ServiceDiscoveryEndpoint,
+		PermissionsBoundary:            s.rc.PermissionsBoundary,
+		RolePath:                       s.rc.RolePath,
 		Publish:                        publishers,
+		Observability:                  convertObservability(s.manifest.Observability),
+		Permissions:                    convertPermissions(s.manifest.Permissions),
 		Platform:                       convertPlatform(s.manifest.Platform),
 		HTTPVersion:                    convertHTTPVersion(s.manifest.RoutingRule.ProtocolVersion),
 		NLB:                            nlbConfig.settings,
@@ -36,6 +36,11 @@ func NameForEnv(app, env string) string {
 	return fmt.Sprintf("%s-%s", app, env)
 }
 
+// NameForEnvAddons returns the stack name for the addons shared by the workloads in an environment.
+func NameForEnvAddons(app, env string) string {
+	return fmt.Sprintf("%s-%s-env-addons", app, env)
+}
+
 // NameForTask returns the stack name for a task.
 func NameForTask(task string) TaskStackName {
 	return TaskStackName(taskStackPrefix + task)
@@ -50,3 +55,14 @@ func NameForAppStack(app string) string {
 func NameForAppStackSet(app string) string {
 	return fmt.Sprintf("%s-infrastructure", app)
 }
+
+// NameForGlobalService returns the stack name for the DNS record of a global service in an environment.
+func NameForGlobalService(app, env, svc string) string {
+	return fmt.Sprintf("%s-%s-%s-global", app, env, svc)
+}
+
+// NameForGlobalServiceHealthAlarm returns the name of the alarm that reports whether a global service
+// can serve traffic in an environment.
+func NameForGlobalServiceHealthAlarm(app, env, svc string) string {
+	return fmt.Sprintf("%s-%s-%s-global-health", app, env, svc)
+}
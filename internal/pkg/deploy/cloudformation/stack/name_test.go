@@ -22,6 +22,12 @@ func TestNameForEnv(t *testing.T) {
 	require.Equal(t, name, "foo-bar")
 }
 
+func TestNameForEnvAddons(t *testing.T) {
+	name := NameForEnvAddons("foo", "bar")
+
+	require.Equal(t, name, "foo-bar-env-addons")
+}
+
 func TestNameForTask(t *testing.T) {
 	name := NameForTask("foo")
 
@@ -39,3 +45,15 @@ func TestNameForAppStackSet(t *testing.T) {
 
 	require.Equal(t, name, "foo-infrastructure")
 }
+
+func TestNameForGlobalService(t *testing.T) {
+	name := NameForGlobalService("phonetool", "us", "api")
+
+	require.Equal(t, name, "phonetool-us-api-global")
+}
+
+func TestNameForGlobalServiceHealthAlarm(t *testing.T) {
+	name := NameForGlobalServiceHealthAlarm("phonetool", "us", "api")
+
+	require.Equal(t, name, "phonetool-us-api-global-health")
+}
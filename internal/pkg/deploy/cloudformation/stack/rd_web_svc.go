@@ -66,7 +66,10 @@ func NewRequestDrivenWebServiceWithAlias(mft *manifest.RequestDrivenWebService,
 
 // NewRequestDrivenWebService creates a new RequestDrivenWebService stack from a manifest file.
 func NewRequestDrivenWebService(mft *manifest.RequestDrivenWebService, env string, app deploy.AppInformation, rc RuntimeConfig) (*RequestDrivenWebService, error) {
-	parser := template.New()
+	parser, err := newWorkloadParser(rc)
+	if err != nil {
+		return nil, err
+	}
 	addons, err := addon.New(aws.StringValue(mft.Name))
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
@@ -121,7 +124,7 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		dnsDelegationRole, dnsName = convertAppInformation(s.app)
 		layerARN = awsSDKLayerForRegion[s.rc.Region]
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app.Name, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app.Name, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
@@ -132,6 +135,10 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		NestedStack:       addonsOutputs,
 		AddonsExtraParams: addonsParams,
 		EnableHealthCheck: !s.healthCheckConfig.IsEmpty(),
+		Observability:     convertObservability(s.manifest.Observability),
+
+		AppRunnerAutoscaling: convertAppRunnerAutoscaling(s.manifest.Scaling),
+		Private:              aws.BoolValue(s.manifest.Private),
 
 		Alias:                s.manifest.Alias,
 		ScriptBucketName:     bucket,
@@ -144,6 +151,8 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 
 		Publish:                  publishers,
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
+		PermissionsBoundary:      s.rc.PermissionsBoundary,
+		RolePath:                 s.rc.RolePath,
 	})
 	if err != nil {
 		return "", err
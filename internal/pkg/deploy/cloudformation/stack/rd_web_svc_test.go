@@ -288,6 +288,40 @@ func TestRequestDrivenWebService_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"should parse template with autoscaling, private ingress and tracing": {
+			inManifest: func(mft manifest.RequestDrivenWebService) manifest.RequestDrivenWebService {
+				mft.Private = aws.Bool(true)
+				mft.Observability.Tracing = aws.String("awsxray")
+				mft.Scaling = manifest.AppRunnerScalingConfig{
+					MaxConcurrency: aws.Int(50),
+					MinSize:        aws.Int(2),
+					MaxSize:        aws.Int(10),
+				}
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
+				addons := mockAddons{tplErr: &addon.ErrAddonsNotFound{}, paramsErr: &addon.ErrAddonsNotFound{}}
+				mockParser.EXPECT().ParseRequestDrivenWebService(template.WorkloadOpts{
+					Variables:                c.manifest.Variables,
+					Tags:                     c.manifest.Tags,
+					ServiceDiscoveryEndpoint: mockSD,
+					EnableHealthCheck:        true,
+					Observability: template.ObservabilityOpts{
+						Tracing: "AWSXRAY",
+					},
+					AppRunnerAutoscaling: &template.AppRunnerAutoscalingOpts{
+						MaxConcurrency: aws.Int(50),
+						MinSize:        aws.Int(2),
+						MaxSize:        aws.Int(10),
+					},
+					Private: true,
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				c.parser = mockParser
+				c.addons = addons
+			},
+			wantedTemplate: "template",
+		},
 		"should parse template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
 				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
@@ -6,7 +6,6 @@ package stack
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
 	"unicode"
@@ -42,8 +41,6 @@ type ScheduledJob struct {
 var (
 	fmtRateScheduleExpression = "rate(%d %s)" // rate({duration} {units})
 	fmtCronScheduleExpression = "cron(%s)"
-
-	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Validates that an expression is of the form rate(xyz) or cron(abc)
 )
 
 const (
@@ -91,11 +88,18 @@ func (e errDurationInvalid) Error() string {
 
 // NewScheduledJob creates a new ScheduledJob stack from a manifest file.
 func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConfig) (*ScheduledJob, error) {
-	parser := template.New()
+	parser, err := newWorkloadParser(rc)
+	if err != nil {
+		return nil, err
+	}
 	addons, err := addon.New(aws.StringValue(mft.Name))
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
 	return &ScheduledJob{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
@@ -109,6 +113,7 @@ func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConf
 			},
 			logRetention:        mft.Logging.Retention,
 			tc:                  mft.TaskConfig,
+			envAddons:           envAddons,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
 		manifest: mft,
@@ -127,11 +132,15 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	envAddonsOutputs, err := j.envAddonsOutputs()
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(j.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
 	}
-	publishers, err := convertPublish(j.manifest.Publish(), j.rc.AccountID, j.rc.Region, j.app, j.env, j.name)
+	publishers, err := convertPublish(j.manifest.PublishConfig, j.rc.AccountID, j.rc.Region, j.app, j.env, j.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for job %s: %w`, j.name, err)
 	}
@@ -160,12 +169,14 @@ func (j *ScheduledJob) Template() (string, error) {
 		Variables:                j.manifest.Variables,
 		Secrets:                  convertSecrets(j.manifest.Secrets),
 		NestedStack:              addonsOutputs,
+		EnvAddons:                envAddonsOutputs,
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
 		ScheduleExpression:       schedule,
 		StateMachine:             stateMachine,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
-		LogConfig:                convertLogging(j.manifest.Logging),
+		LogConfig:                convertLogging(j.manifest.Logging, j.rc.Region),
+		LogGroup:                 convertLogGroup(j.manifest.Logging, j.rc.EncryptLogs),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
 		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
 		Network:                  convertNetworkConfig(j.manifest.Network),
@@ -174,7 +185,11 @@ func (j *ScheduledJob) Template() (string, error) {
 		DependsOn:                convertDependsOn(j.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(j.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: j.rc.ServiceDiscoveryEndpoint,
+		PermissionsBoundary:      j.rc.PermissionsBoundary,
+		RolePath:                 j.rc.RolePath,
 		Publish:                  publishers,
+		Observability:            convertObservability(j.manifest.Observability),
+		Permissions:              convertPermissions(j.manifest.Permissions),
 		Platform:                 convertPlatform(j.manifest.Platform),
 
 		EnvControllerLambda: envControllerLambda.String(),
@@ -231,9 +246,15 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 	if schedule == "" {
 		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
 	}
+	return toAWSScheduleExpression(schedule)
+}
+
+// toAWSScheduleExpression converts a cron expression, a predefined schedule, or a fixed interval
+// into an AWS schedule expression.
+func toAWSScheduleExpression(schedule string) (string, error) {
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
-	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+	if manifest.IsAWSScheduleExpression(schedule) {
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
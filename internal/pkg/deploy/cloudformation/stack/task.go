@@ -66,6 +66,8 @@ func (t *taskStackConfig) Template() (string, error) {
 		App                   string
 		Env                   string
 		ExecutionRole         string
+		PermissionsBoundary   string
+		RolePath              string
 	}{
 		EnvVars:               t.EnvVars,
 		SSMParamSecrets:       t.SSMParamSecrets,
@@ -73,6 +75,8 @@ func (t *taskStackConfig) Template() (string, error) {
 		App:                   t.App,
 		Env:                   t.Env,
 		ExecutionRole:         t.ExecutionRole,
+		PermissionsBoundary:   t.PermissionsBoundary,
+		RolePath:              t.RolePath,
 	}, template.WithFuncs(cfnFuntion))
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
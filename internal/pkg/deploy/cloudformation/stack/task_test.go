@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -73,6 +74,61 @@ func TestTaskStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestTaskStackConfig_TemplateRoles(t *testing.T) {
+	type role struct {
+		Properties struct {
+			Path                string `yaml:"Path"`
+			PermissionsBoundary string `yaml:"PermissionsBoundary"`
+		} `yaml:"Properties"`
+	}
+	testCases := map[string]struct {
+		inPermissionsBoundary string
+		inRolePath            string
+
+		wantedPermissionsBoundary string
+		wantedPath                string
+	}{
+		"no permissions boundary or role path": {},
+		"permissions boundary of the application": {
+			inPermissionsBoundary: "MyBoundary",
+
+			wantedPermissionsBoundary: "arn:${AWS::Partition}:iam::${AWS::AccountId}:policy/MyBoundary",
+		},
+		"role path of the application": {
+			inRolePath: "/copilot/",
+
+			wantedPath: "/copilot/",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			taskStackConfig := NewTaskStackConfig(&deploy.CreateTaskResourcesInput{
+				Name:                testTaskName,
+				App:                 "phonetool",
+				Env:                 "test",
+				PermissionsBoundary: tc.inPermissionsBoundary,
+				RolePath:            tc.inRolePath,
+			})
+
+			tpl, err := taskStackConfig.Template()
+			require.NoError(t, err)
+
+			var got struct {
+				Resources struct {
+					DefaultExecutionRole role `yaml:"DefaultExecutionRole"`
+					DefaultTaskRole      role `yaml:"DefaultTaskRole"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &got))
+			require.Equal(t, tc.wantedPermissionsBoundary, got.Resources.DefaultExecutionRole.Properties.PermissionsBoundary)
+			require.Equal(t, tc.wantedPermissionsBoundary, got.Resources.DefaultTaskRole.Properties.PermissionsBoundary)
+			require.Equal(t, tc.wantedPath, got.Resources.DefaultExecutionRole.Properties.Path)
+			require.Equal(t, tc.wantedPath, got.Resources.DefaultTaskRole.Properties.Path)
+		})
+	}
+}
+
 func TestTaskStackConfig_Parameters(t *testing.T) {
 	expectedParams := []*cloudformation.Parameter{
 		{
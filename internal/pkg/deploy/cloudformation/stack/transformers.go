@@ -4,6 +4,8 @@
 package stack
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"hash/crc32"
 	"strconv"
@@ -18,6 +20,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -37,6 +40,27 @@ const (
 	defaultNLBProtocol     = manifest.TCP
 )
 
+// Default values for step scaling policies.
+const (
+	defaultStepScalingStatistic         = cloudwatch.StatisticAverage
+	defaultStepScalingPeriod            = time.Minute
+	defaultStepScalingEvaluationPeriods = 1
+	defaultStepScalingCooldown          = time.Minute
+)
+
+// Default values for the metric filters of a log group.
+const (
+	defaultLogMetricNamespace = "${AppName}-${EnvName}-${WorkloadName}"
+	defaultLogMetricValue     = "1"
+)
+
+// Default values for logging presets.
+const (
+	defaultLogStreamPrefix = "copilot/"
+	defaultDatadogSite     = "datadoghq.com"
+	defaultSplunkPort      = 8088
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -172,6 +196,23 @@ func convertCapacityProviders(a manifest.AdvancedCount) []*template.CapacityProv
 	return cps
 }
 
+// convertCapacityProviderStrategies converts the "capacity_providers" field into a format
+// parsable by the templates pkg.
+func convertCapacityProviderStrategies(c manifest.CapacityProviders) []*template.CapacityProviderStrategy {
+	if c.IsEmpty() {
+		return nil
+	}
+	cps := make([]*template.CapacityProviderStrategy, len(c))
+	for i, cp := range c {
+		cps[i] = &template.CapacityProviderStrategy{
+			Base:             cp.Base,
+			Weight:           aws.Int(aws.IntValue(cp.Weight)),
+			CapacityProvider: aws.StringValue(cp.Provider),
+		}
+	}
+	return cps
+}
+
 // convertAutoscaling converts the service's Auto Scaling configuration into a format parsable
 // by the templates pkg.
 func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, error) {
@@ -212,9 +253,62 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 			AcceptableBacklogPerTask: acceptableBacklog,
 		}
 	}
+	for _, schedule := range a.Schedules {
+		expr, err := toAWSScheduleExpression(aws.StringValue(schedule.Schedule))
+		if err != nil {
+			return nil, fmt.Errorf("convert scaling schedule %s: %w", aws.StringValue(schedule.Schedule), err)
+		}
+		autoscalingOpts.Schedules = append(autoscalingOpts.Schedules, &template.ScheduledScalingOpts{
+			Schedule:    expr,
+			Timezone:    schedule.Timezone,
+			MinCapacity: schedule.Min,
+			MaxCapacity: schedule.Max,
+		})
+	}
+	for _, step := range a.StepScaling {
+		autoscalingOpts.StepScaling = append(autoscalingOpts.StepScaling, convertStepScaling(step))
+	}
 	return &autoscalingOpts, nil
 }
 
+// convertStepScaling converts a step scaling policy into a format parsable by the templates pkg.
+func convertStepScaling(s manifest.StepScaling) *template.StepScalingOpts {
+	opts := &template.StepScalingOpts{
+		Namespace:          aws.StringValue(s.Metric.Namespace),
+		MetricName:         aws.StringValue(s.Metric.Name),
+		Statistic:          defaultStepScalingStatistic,
+		Dimensions:         s.Metric.Dimensions,
+		ComparisonOperator: cloudwatch.ComparisonOperatorGreaterThanOrEqualToThreshold,
+		Threshold:          aws.Float64Value(s.Threshold),
+		Period:             int64(defaultStepScalingPeriod.Seconds()),
+		EvaluationPeriods:  defaultStepScalingEvaluationPeriods,
+		Cooldown:           int64(defaultStepScalingCooldown.Seconds()),
+	}
+	if s.Metric.Statistic != nil {
+		opts.Statistic = aws.StringValue(s.Metric.Statistic)
+	}
+	if s.ComparisonOperator() == manifest.StepScalingComparisonBelow {
+		opts.ComparisonOperator = cloudwatch.ComparisonOperatorLessThanOrEqualToThreshold
+	}
+	if s.Period != nil {
+		opts.Period = int64(s.Period.Seconds())
+	}
+	if s.EvaluationPeriods != nil {
+		opts.EvaluationPeriods = aws.IntValue(s.EvaluationPeriods)
+	}
+	if s.Cooldown != nil {
+		opts.Cooldown = int64(s.Cooldown.Seconds())
+	}
+	for _, step := range s.Steps {
+		opts.Steps = append(opts.Steps, template.StepAdjustmentOpts{
+			LowerBound: step.LowerBound,
+			UpperBound: step.UpperBound,
+			Adjustment: aws.IntValue(step.Adjustment),
+		})
+	}
+	return opts
+}
+
 // convertHTTPHealthCheck converts the ALB health check configuration into a format parsable by the templates pkg.
 func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPHealthCheckOpts {
 	opts := template.HTTPHealthCheckOpts{
@@ -351,11 +445,58 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
-func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
+// convertPermissions returns the IAM policy statements to attach to the task role.
+// Topic references are converted to the ARNs of the SNS topics published by workloads in the same environment.
+func convertPermissions(perms []manifest.Permission) []*template.PermissionOpts {
+	if len(perms) == 0 {
+		return nil
+	}
+	out := make([]*template.PermissionOpts, len(perms))
+	for i, p := range perms {
+		resources := make([]string, 0, len(p.Resources)+len(p.Topics))
+		resources = append(resources, p.Resources...)
+		for _, topic := range p.Topics {
+			topicARN := fmt.Sprintf("arn:${AWS::Partition}:sns:${AWS::Region}:${AWS::AccountId}:${AppName}-${EnvName}-%s-%s",
+				aws.StringValue(topic.Service), aws.StringValue(topic.Name))
+			if aws.BoolValue(topic.FIFO) {
+				topicARN += ".fifo"
+			}
+			resources = append(resources, topicARN)
+		}
+		out[i] = &template.PermissionOpts{
+			Actions:   p.Actions,
+			Resources: resources,
+		}
+	}
+	return out
+}
+
+// convertServiceConnect returns the Service Connect configuration of a workload.
+// If defaultAlias is nil, the workload only acts as a client of the other services in the namespace.
+func convertServiceConnect(c manifest.ServiceConnectBoolOrArgs, defaultAlias *string) *template.ServiceConnectOpts {
+	if !c.Enabled() {
+		return nil
+	}
+	if defaultAlias == nil {
+		return &template.ServiceConnectOpts{}
+	}
+	alias := defaultAlias
+	if c.Alias != nil {
+		alias = c.Alias
+	}
+	return &template.ServiceConnectOpts{
+		Alias: alias,
+	}
+}
+
+// convertLogging returns the Firelens configuration of the main container.
+// If a logging preset is specified, it is expanded into the Fluent Bit output options and the task role permissions
+// needed to route logs to the destination. Presets default to the region of the stack.
+func convertLogging(lc manifest.Logging, region string) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
 	}
-	return &template.LogConfigOpts{
+	opts := &template.LogConfigOpts{
 		Image:          lc.LogImage(),
 		ConfigFile:     lc.ConfigFile,
 		EnableMetadata: lc.GetEnableMetadata(),
@@ -364,6 +505,174 @@ func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 		Variables:      lc.Variables,
 		Secrets:        convertSecrets(lc.Secrets),
 	}
+	if !lc.Preset.IsEmpty() {
+		applyLoggingPreset(opts, lc.Preset, region)
+	}
+	return opts
+}
+
+// convertLogGroup returns the configuration of the workload's log group on top of its retention.
+func convertLogGroup(lc manifest.Logging, encrypted bool) *template.LogGroupOpts {
+	if !encrypted && len(lc.SubscriptionFilters) == 0 && len(lc.MetricFilters) == 0 {
+		return nil
+	}
+	opts := &template.LogGroupOpts{
+		Encrypted: encrypted,
+	}
+	for _, filter := range lc.SubscriptionFilters {
+		opts.SubscriptionFilters = append(opts.SubscriptionFilters, &template.LogSubscriptionFilterOpts{
+			DestinationARN: aws.StringValue(filter.Destination),
+			Pattern:        aws.StringValue(filter.Pattern),
+		})
+	}
+	for _, filter := range lc.MetricFilters {
+		metric := &template.LogMetricFilterOpts{
+			MetricName:   aws.StringValue(filter.Name),
+			Namespace:    defaultLogMetricNamespace,
+			Pattern:      aws.StringValue(filter.Pattern),
+			Value:        defaultLogMetricValue,
+			DefaultValue: filter.DefaultValue,
+			Unit:         filter.Unit,
+		}
+		if filter.Namespace != nil {
+			metric.Namespace = aws.StringValue(filter.Namespace)
+		}
+		if filter.Value != nil {
+			metric.Value = aws.StringValue(filter.Value)
+		}
+		opts.MetricFilters = append(opts.MetricFilters, metric)
+	}
+	return opts
+}
+
+func applyLoggingPreset(opts *template.LogConfigOpts, preset manifest.LoggingPreset, region string) {
+	// presetRegion returns the region to pass to Fluent Bit, and the region to use in the ARNs of the IAM policy.
+	presetRegion := func(r *string) (string, string) {
+		if r == nil {
+			return region, "${AWS::Region}"
+		}
+		return aws.StringValue(r), aws.StringValue(r)
+	}
+	addSecretOption := func(name string, secret manifest.Secret) {
+		if opts.SecretOptions == nil {
+			opts.SecretOptions = make(map[string]template.Secret)
+		}
+		for k, v := range convertSecrets(map[string]manifest.Secret{name: secret}) {
+			opts.SecretOptions[k] = v
+		}
+	}
+
+	switch {
+	case preset.CloudWatch != nil:
+		cw := preset.CloudWatch
+		optRegion, arnRegion := presetRegion(cw.Region)
+		opts.Destination = map[string]string{
+			"Name":              "cloudwatch_logs",
+			"region":            optRegion,
+			"log_group_name":    aws.StringValue(cw.LogGroup),
+			"log_stream_prefix": defaultLogStreamPrefix,
+			"auto_create_group": "true",
+		}
+		if cw.StreamPrefix != nil {
+			opts.Destination["log_stream_prefix"] = aws.StringValue(cw.StreamPrefix)
+		}
+		actions := []string{"logs:CreateLogGroup", "logs:CreateLogStream", "logs:DescribeLogStreams", "logs:PutLogEvents"}
+		if cw.Retention != nil {
+			opts.Destination["log_retention_days"] = strconv.Itoa(aws.IntValue(cw.Retention))
+			actions = append(actions, "logs:PutRetentionPolicy")
+		}
+		logGroupARN := fmt.Sprintf("arn:${AWS::Partition}:logs:%s:${AWS::AccountId}:log-group:%s", arnRegion, aws.StringValue(cw.LogGroup))
+		opts.Permissions = []*template.PermissionOpts{
+			{
+				Actions:   actions,
+				Resources: []string{logGroupARN, logGroupARN + ":*"},
+			},
+		}
+	case preset.Firehose != nil:
+		optRegion, arnRegion := presetRegion(preset.Firehose.Region)
+		opts.Destination = map[string]string{
+			"Name":            "kinesis_firehose",
+			"region":          optRegion,
+			"delivery_stream": aws.StringValue(preset.Firehose.DeliveryStream),
+		}
+		opts.Permissions = []*template.PermissionOpts{
+			{
+				Actions: []string{"firehose:PutRecordBatch"},
+				Resources: []string{fmt.Sprintf("arn:${AWS::Partition}:firehose:%s:${AWS::AccountId}:deliverystream/%s",
+					arnRegion, aws.StringValue(preset.Firehose.DeliveryStream))},
+			},
+		}
+	case preset.S3 != nil:
+		optRegion, _ := presetRegion(preset.S3.Region)
+		opts.Destination = map[string]string{
+			"Name":   "s3",
+			"region": optRegion,
+			"bucket": aws.StringValue(preset.S3.Bucket),
+		}
+		objects := "*"
+		if prefix := strings.Trim(aws.StringValue(preset.S3.KeyPrefix), "/"); prefix != "" {
+			opts.Destination["s3_key_format"] = fmt.Sprintf("/%s/$TAG/%%Y/%%m/%%d/%%H-%%M-%%S", prefix)
+			objects = prefix + "/*"
+		}
+		opts.Permissions = []*template.PermissionOpts{
+			{
+				Actions:   []string{"s3:PutObject"},
+				Resources: []string{fmt.Sprintf("arn:${AWS::Partition}:s3:::%s/%s", aws.StringValue(preset.S3.Bucket), objects)},
+			},
+		}
+	case preset.OpenSearch != nil:
+		search := preset.OpenSearch
+		optRegion, arnRegion := presetRegion(search.Region)
+		opts.Destination = map[string]string{
+			"Name":               "opensearch",
+			"Host":               aws.StringValue(search.Endpoint),
+			"Port":               "443",
+			"Index":              aws.StringValue(search.Index),
+			"tls":                "On",
+			"AWS_Auth":           "On",
+			"AWS_Region":         optRegion,
+			"Suppress_Type_Name": "On",
+		}
+		opts.Permissions = []*template.PermissionOpts{
+			{
+				Actions: []string{"es:ESHttpPost", "es:ESHttpPut"},
+				Resources: []string{fmt.Sprintf("arn:${AWS::Partition}:es:%s:${AWS::AccountId}:domain/%s/*",
+					arnRegion, aws.StringValue(search.Domain))},
+			},
+		}
+	case preset.Datadog != nil:
+		dd := preset.Datadog
+		site := defaultDatadogSite
+		if dd.Site != nil {
+			site = aws.StringValue(dd.Site)
+		}
+		opts.Destination = map[string]string{
+			"Name":     "datadog",
+			"Host":     fmt.Sprintf("http-intake.logs.%s", site),
+			"TLS":      "on",
+			"compress": "gzip",
+			"provider": "ecs",
+		}
+		if dd.Service != nil {
+			opts.Destination["dd_service"] = aws.StringValue(dd.Service)
+		}
+		if dd.Source != nil {
+			opts.Destination["dd_source"] = aws.StringValue(dd.Source)
+		}
+		addSecretOption("apikey", dd.APIKey)
+	case preset.Splunk != nil:
+		port := defaultSplunkPort
+		if preset.Splunk.Port != nil {
+			port = aws.IntValue(preset.Splunk.Port)
+		}
+		opts.Destination = map[string]string{
+			"Name": "splunk",
+			"Host": aws.StringValue(preset.Splunk.Host),
+			"Port": strconv.Itoa(port),
+			"TLS":  "On",
+		}
+		addSecretOption("Splunk_Token", preset.Splunk.Token)
+	}
 }
 
 func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rule {
@@ -596,9 +905,27 @@ func convertRDWSNetworkConfig(network manifest.RequestDrivenWebServiceNetworkCon
 	if string(*network.VPC.Placement) == string(manifest.PrivateSubnetPlacement) {
 		opts.SubnetsType = template.PrivateSubnetsPlacement
 	}
+	opts.SecurityGroups = network.VPC.SecurityGroups
 	return opts
 }
 
+func convertAppRunnerAutoscaling(scaling manifest.AppRunnerScalingConfig) *template.AppRunnerAutoscalingOpts {
+	if scaling.IsEmpty() {
+		return nil
+	}
+	return &template.AppRunnerAutoscalingOpts{
+		MaxConcurrency: scaling.MaxConcurrency,
+		MinSize:        scaling.MinSize,
+		MaxSize:        scaling.MaxSize,
+	}
+}
+
+func convertObservability(observability manifest.Observability) template.ObservabilityOpts {
+	return template.ObservabilityOpts{
+		Tracing: strings.ToUpper(aws.StringValue(observability.Tracing)),
+	}
+}
+
 func convertAlias(alias manifest.Alias) ([]string, error) {
 	out, err := alias.ToStringSlice()
 	if err != nil {
@@ -623,19 +950,23 @@ func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	return out, nil
 }
 
-func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc string) (*template.PublishOpts, error) {
-	if len(topics) == 0 {
+func convertPublish(p manifest.PublishConfig, accountID, region, app, env, svc string) (*template.PublishOpts, error) {
+	if len(p.Topics) == 0 && !aws.BoolValue(p.EventBus) {
 		return nil, nil
 	}
 	partition, err := partitions.Region(region).Partition()
 	if err != nil {
 		return nil, err
 	}
-	var publishers template.PublishOpts
+	publishers := template.PublishOpts{
+		EventBus: aws.BoolValue(p.EventBus),
+	}
 	// convert the topics to template Topics
-	for _, topic := range topics {
+	for _, topic := range p.Topics {
 		publishers.Topics = append(publishers.Topics, &template.Topic{
 			Name:      topic.Name,
+			FIFO:      aws.BoolValue(topic.FIFO),
+			KMSKey:    topic.KMSKey,
 			AccountID: accountID,
 			Partition: partition.ID(),
 			Region:    region,
@@ -649,7 +980,7 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 }
 
 func convertSubscribe(s manifest.SubscribeConfig, accountID, region, app, env, svc string) (*template.SubscribeOpts, error) {
-	if s.Topics == nil {
+	if s.Topics == nil && s.Events == nil {
 		return nil, nil
 	}
 	sqsEndpoint, err := endpoints.DefaultResolver().EndpointFor(endpoints.SqsServiceID, region)
@@ -658,26 +989,69 @@ func convertSubscribe(s manifest.SubscribeConfig, accountID, region, app, env, s
 	}
 	var subscriptions template.SubscribeOpts
 	for _, sb := range s.Topics {
-		ts := convertTopicSubscription(sb, sqsEndpoint.URL, accountID, app, env, svc)
+		ts, err := convertTopicSubscription(sb, sqsEndpoint.URL, accountID, app, env, svc)
+		if err != nil {
+			return nil, err
+		}
 		subscriptions.Topics = append(subscriptions.Topics, ts)
 	}
+	for _, e := range s.Events {
+		es, err := convertEventSubscription(e)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions.Events = append(subscriptions.Events, es)
+	}
 	subscriptions.Queue = convertQueue(s.Queue)
 	return &subscriptions, nil
 }
 
-func convertTopicSubscription(t manifest.TopicSubscription, url, accountID, app, env, svc string) *template.TopicSubscription {
+func convertTopicSubscription(t manifest.TopicSubscription, url, accountID, app, env, svc string) (*template.TopicSubscription, error) {
+	filterPolicy, err := compactJSON(t.FilterPolicy)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "filter_policy" of topic %s from service %s: %w`, aws.StringValue(t.Name), aws.StringValue(t.Service), err)
+	}
+	queue := convertQueue(t.Queue.Advanced)
 	if aws.BoolValue(t.Queue.Enabled) {
-		return &template.TopicSubscription{
-			Name:    t.Name,
-			Service: t.Service,
-			Queue:   &template.SQSQueue{},
-		}
+		queue = &template.SQSQueue{}
 	}
 	return &template.TopicSubscription{
-		Name:    t.Name,
-		Service: t.Service,
-		Queue:   convertQueue(t.Queue.Advanced),
+		Name:         t.Name,
+		Service:      t.Service,
+		Queue:        queue,
+		FIFO:         aws.BoolValue(t.FIFO),
+		FilterPolicy: filterPolicy,
+		RawDelivery:  aws.BoolValue(t.RawDelivery),
+	}, nil
+}
+
+func convertEventSubscription(e manifest.EventSubscription) (*template.EventSubscription, error) {
+	pattern, err := compactJSON(e.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "pattern" of event subscription %s: %w`, aws.StringValue(e.Name), err)
+	}
+	queue := convertQueue(e.Queue.Advanced)
+	if aws.BoolValue(e.Queue.Enabled) {
+		queue = &template.SQSQueue{}
+	}
+	return &template.EventSubscription{
+		Name:    e.Name,
+		Pattern: pattern,
+		Queue:   queue,
+	}, nil
+}
+
+// compactJSON compacts a JSON document, such as a filter policy or an event pattern, so that it can be rendered
+// on a single line in the template.
+func compactJSON(doc *string) (*string, error) {
+	if doc == nil {
+		return nil, nil
 	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(aws.StringValue(doc))); err != nil {
+		return nil, err
+	}
+	return aws.String(buf.String()), nil
 }
 
 func convertQueue(q manifest.SQSQueue) *template.SQSQueue {
@@ -788,6 +1162,9 @@ func convertSecrets(secrets map[string]manifest.Secret) map[string]template.Secr
 		var tplSecret template.Secret = template.SecretFromSSMOrARN(mftSecret.Value())
 		if mftSecret.IsSecretsManagerName() {
 			tplSecret = template.SecretFromSecretsManager(mftSecret.Value())
+			if key := mftSecret.SecretsManagerKey(); key != "" {
+				tplSecret = template.SecretFromSecretsManagerJSONKey(mftSecret.Value(), key)
+			}
 		}
 		m[name] = tplSecret
 	}
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -394,6 +395,62 @@ func Test_convertCapacityProviders(t *testing.T) {
 	}
 }
 
+func Test_convertCapacityProviderStrategies(t *testing.T) {
+	testCases := map[string]struct {
+		input    manifest.CapacityProviders
+		expected []*template.CapacityProviderStrategy
+	}{
+		"empty": {
+			input:    nil,
+			expected: nil,
+		},
+		"weighted Fargate and Fargate Spot": {
+			input: manifest.CapacityProviders{
+				{
+					Provider: aws.String("FARGATE"),
+					Weight:   aws.Int(1),
+					Base:     aws.Int(2),
+				},
+				{
+					Provider: aws.String("FARGATE_SPOT"),
+					Weight:   aws.Int(3),
+				},
+			},
+			expected: []*template.CapacityProviderStrategy{
+				{
+					Base:             aws.Int(2),
+					Weight:           aws.Int(1),
+					CapacityProvider: capacityProviderFargate,
+				},
+				{
+					Weight:           aws.Int(3),
+					CapacityProvider: capacityProviderFargateSpot,
+				},
+			},
+		},
+		"EC2 without weight": {
+			input: manifest.CapacityProviders{
+				{
+					Provider: aws.String("EC2"),
+					Base:     aws.Int(1),
+				},
+			},
+			expected: []*template.CapacityProviderStrategy{
+				{
+					Base:             aws.Int(1),
+					Weight:           aws.Int(0),
+					CapacityProvider: "EC2",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, convertCapacityProviderStrategies(tc.input))
+		})
+	}
+}
+
 func Test_convertAutoscaling(t *testing.T) {
 	var (
 		mockRange        = manifest.IntRangeBand("1-100")
@@ -402,6 +459,7 @@ func Test_convertAutoscaling(t *testing.T) {
 		mockResponseTime = 512 * time.Millisecond
 		mockCPU          = manifest.Percentage(70)
 		mockMem          = manifest.Percentage(80)
+		mockStepPeriod   = 30 * time.Second
 	)
 
 	testAcceptableLatency := 10 * time.Minute
@@ -487,6 +545,136 @@ func Test_convertAutoscaling(t *testing.T) {
 				},
 			},
 		},
+		"success with scheduled and step scaling": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Schedules: []manifest.ScheduledScaling{
+					{
+						Schedule: aws.String("0 8 * * 1-5"),
+						Min:      aws.Int(10),
+					},
+					{
+						Schedule: aws.String("cron(0 20 ? * MON-FRI *)"),
+						Timezone: aws.String("America/New_York"),
+						Min:      aws.Int(1),
+						Max:      aws.Int(1),
+					},
+				},
+				StepScaling: []manifest.StepScaling{
+					{
+						Metric: manifest.ScalingMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+							Statistic: aws.String("Maximum"),
+							Dimensions: map[string]string{
+								"QueueName": "jobs",
+							},
+						},
+						Comparison: aws.String("below"),
+						Threshold:  aws.Float64(10),
+						Period:     &mockStepPeriod,
+						Steps: []manifest.ScalingStep{
+							{
+								UpperBound: aws.Float64(0),
+								Adjustment: aws.Int(-1),
+							},
+						},
+					},
+					{
+						Metric: manifest.ScalingMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateAgeOfOldestMessage"),
+						},
+						Threshold: aws.Float64(300),
+						Steps: []manifest.ScalingStep{
+							{
+								LowerBound: aws.Float64(0),
+								UpperBound: aws.Float64(300),
+								Adjustment: aws.Int(2),
+							},
+							{
+								LowerBound: aws.Float64(300),
+								Adjustment: aws.Int(5),
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				Schedules: []*template.ScheduledScalingOpts{
+					{
+						Schedule:    "cron(0 8 ? * 2-6 *)",
+						MinCapacity: aws.Int(10),
+					},
+					{
+						Schedule:    "cron(0 20 ? * MON-FRI *)",
+						Timezone:    aws.String("America/New_York"),
+						MinCapacity: aws.Int(1),
+						MaxCapacity: aws.Int(1),
+					},
+				},
+				StepScaling: []*template.StepScalingOpts{
+					{
+						Namespace:  "AWS/SQS",
+						MetricName: "ApproximateNumberOfMessagesVisible",
+						Statistic:  "Maximum",
+						Dimensions: map[string]string{
+							"QueueName": "jobs",
+						},
+						ComparisonOperator: "LessThanOrEqualToThreshold",
+						Threshold:          10,
+						Period:             30,
+						EvaluationPeriods:  1,
+						Cooldown:           60,
+						Steps: []template.StepAdjustmentOpts{
+							{
+								UpperBound: aws.Float64(0),
+								Adjustment: -1,
+							},
+						},
+					},
+					{
+						Namespace:          "AWS/SQS",
+						MetricName:         "ApproximateAgeOfOldestMessage",
+						Statistic:          "Average",
+						ComparisonOperator: "GreaterThanOrEqualToThreshold",
+						Threshold:          300,
+						Period:             60,
+						EvaluationPeriods:  1,
+						Cooldown:           60,
+						Steps: []template.StepAdjustmentOpts{
+							{
+								LowerBound: aws.Float64(0),
+								UpperBound: aws.Float64(300),
+								Adjustment: 2,
+							},
+							{
+								LowerBound: aws.Float64(300),
+								Adjustment: 5,
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid scaling schedule": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Schedules: []manifest.ScheduledScaling{
+					{
+						Schedule: aws.String("@every 90s"),
+						Min:      aws.Int(1),
+					},
+				},
+			},
+			wantedErr: fmt.Errorf("convert scaling schedule @every 90s: parse fixed interval: duration must be a whole number of minutes or hours"),
+		},
 		"returns nil if spot specified": {
 			input: manifest.AdvancedCount{
 				Spot: aws.Int(5),
@@ -1163,6 +1351,388 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertPermissions(t *testing.T) {
+	testCases := map[string]struct {
+		inPermissions []manifest.Permission
+
+		wanted []*template.PermissionOpts
+	}{
+		"without permissions": {
+			wanted: nil,
+		},
+		"with resources and topics": {
+			inPermissions: []manifest.Permission{
+				{
+					Actions:   []string{"s3:GetObject"},
+					Resources: []string{"arn:aws:s3:::my-app-assets/*"},
+				},
+				{
+					Actions: []string{"sns:Publish"},
+					Topics: []manifest.TopicReference{
+						{
+							Name:    aws.String("orders"),
+							Service: aws.String("api"),
+						},
+						{
+							Name:    aws.String("payments"),
+							Service: aws.String("api"),
+							FIFO:    aws.Bool(true),
+						},
+					},
+				},
+			},
+			wanted: []*template.PermissionOpts{
+				{
+					Actions:   []string{"s3:GetObject"},
+					Resources: []string{"arn:aws:s3:::my-app-assets/*"},
+				},
+				{
+					Actions: []string{"sns:Publish"},
+					Resources: []string{
+						"arn:${AWS::Partition}:sns:${AWS::Region}:${AWS::AccountId}:${AppName}-${EnvName}-api-orders",
+						"arn:${AWS::Partition}:sns:${AWS::Region}:${AWS::AccountId}:${AppName}-${EnvName}-api-payments.fifo",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertPermissions(tc.inPermissions)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertLogging(t *testing.T) {
+	testCases := map[string]struct {
+		inLogging string
+		inRegion  string
+
+		wanted *template.LogConfigOpts
+	}{
+		"returns nil if logging is empty": {
+			inLogging: `{}`,
+		},
+		"passes through the destination options": {
+			inLogging: `
+destination:
+  Name: cloudwatch
+  region: us-west-2
+enableMetadata: false`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("false"),
+				Destination: map[string]string{
+					"Name":   "cloudwatch",
+					"region": "us-west-2",
+				},
+			},
+		},
+		"expands the cloudwatch preset in the stack region": {
+			inLogging: `
+preset:
+  cloudwatch:
+    logGroup: /copilot/api
+    retention: 7`,
+			inRegion: "us-west-2",
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":               "cloudwatch_logs",
+					"region":             "us-west-2",
+					"log_group_name":     "/copilot/api",
+					"log_stream_prefix":  "copilot/",
+					"auto_create_group":  "true",
+					"log_retention_days": "7",
+				},
+				Permissions: []*template.PermissionOpts{
+					{
+						Actions: []string{"logs:CreateLogGroup", "logs:CreateLogStream", "logs:DescribeLogStreams", "logs:PutLogEvents", "logs:PutRetentionPolicy"},
+						Resources: []string{
+							"arn:${AWS::Partition}:logs:${AWS::Region}:${AWS::AccountId}:log-group:/copilot/api",
+							"arn:${AWS::Partition}:logs:${AWS::Region}:${AWS::AccountId}:log-group:/copilot/api:*",
+						},
+					},
+				},
+			},
+		},
+		"expands the firehose preset in another region": {
+			inLogging: `
+preset:
+  firehose:
+    deliveryStream: logs
+    region: eu-west-1`,
+			inRegion: "us-west-2",
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":            "kinesis_firehose",
+					"region":          "eu-west-1",
+					"delivery_stream": "logs",
+				},
+				Permissions: []*template.PermissionOpts{
+					{
+						Actions:   []string{"firehose:PutRecordBatch"},
+						Resources: []string{"arn:${AWS::Partition}:firehose:eu-west-1:${AWS::AccountId}:deliverystream/logs"},
+					},
+				},
+			},
+		},
+		"expands the s3 preset with a key prefix": {
+			inLogging: `
+preset:
+  s3:
+    bucket: my-logs
+    keyPrefix: /api/`,
+			inRegion: "us-west-2",
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":          "s3",
+					"region":        "us-west-2",
+					"bucket":        "my-logs",
+					"s3_key_format": "/api/$TAG/%Y/%m/%d/%H-%M-%S",
+				},
+				Permissions: []*template.PermissionOpts{
+					{
+						Actions:   []string{"s3:PutObject"},
+						Resources: []string{"arn:${AWS::Partition}:s3:::my-logs/api/*"},
+					},
+				},
+			},
+		},
+		"expands the opensearch preset": {
+			inLogging: `
+preset:
+  opensearch:
+    domain: logs
+    endpoint: search-logs.us-west-2.es.amazonaws.com
+    index: api`,
+			inRegion: "us-west-2",
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":               "opensearch",
+					"Host":               "search-logs.us-west-2.es.amazonaws.com",
+					"Port":               "443",
+					"Index":              "api",
+					"tls":                "On",
+					"AWS_Auth":           "On",
+					"AWS_Region":         "us-west-2",
+					"Suppress_Type_Name": "On",
+				},
+				Permissions: []*template.PermissionOpts{
+					{
+						Actions:   []string{"es:ESHttpPost", "es:ESHttpPut"},
+						Resources: []string{"arn:${AWS::Partition}:es:${AWS::Region}:${AWS::AccountId}:domain/logs/*"},
+					},
+				},
+			},
+		},
+		"expands the datadog preset with the api key as a secret option": {
+			inLogging: `
+preset:
+  datadog:
+    apiKey:
+      secretsmanager: datadog/api-key
+    service: api
+    source: golang
+secretOptions:
+  foo: /copilot/foo`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":       "datadog",
+					"Host":       "http-intake.logs.datadoghq.com",
+					"TLS":        "on",
+					"compress":   "gzip",
+					"provider":   "ecs",
+					"dd_service": "api",
+					"dd_source":  "golang",
+				},
+				SecretOptions: map[string]template.Secret{
+					"foo":    template.SecretFromSSMOrARN("/copilot/foo"),
+					"apikey": template.SecretFromSecretsManager("datadog/api-key"),
+				},
+			},
+		},
+		"expands the splunk preset with the token as a secret option": {
+			inLogging: `
+preset:
+  splunk:
+    host: splunk.example.com
+    token: /copilot/splunk-token`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name": "splunk",
+					"Host": "splunk.example.com",
+					"Port": "8088",
+					"TLS":  "On",
+				},
+				SecretOptions: map[string]template.Secret{
+					"Splunk_Token": template.SecretFromSSMOrARN("/copilot/splunk-token"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var logging manifest.Logging
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inLogging), &logging))
+
+			got := convertLogging(logging, tc.inRegion)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertLogGroup(t *testing.T) {
+	testCases := map[string]struct {
+		inLogging   manifest.Logging
+		inEncrypted bool
+
+		wanted *template.LogGroupOpts
+	}{
+		"returns nil if the log group is not encrypted and has no filters": {
+			inLogging: manifest.Logging{
+				Retention: aws.Int(7),
+			},
+		},
+		"encrypts the log group with the environment key": {
+			inEncrypted: true,
+			wanted: &template.LogGroupOpts{
+				Encrypted: true,
+			},
+		},
+		"converts subscription and metric filters with defaults": {
+			inLogging: manifest.Logging{
+				SubscriptionFilters: []manifest.LogSubscriptionFilter{
+					{
+						Destination: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/siem"),
+					},
+					{
+						Destination: aws.String("arn:aws:lambda:us-west-2:123456789012:function:alert"),
+						Pattern:     aws.String(`{ $.level = "ERROR" }`),
+					},
+				},
+				MetricFilters: []manifest.LogMetricFilter{
+					{
+						Name:    aws.String("Errors"),
+						Pattern: aws.String(`"ERROR"`),
+					},
+					{
+						Name:         aws.String("Latency"),
+						Pattern:      aws.String(`{ $.latency = * }`),
+						Namespace:    aws.String("MyApp"),
+						Value:        aws.String("$.latency"),
+						DefaultValue: aws.Float64(0),
+						Unit:         aws.String("Milliseconds"),
+					},
+				},
+			},
+			wanted: &template.LogGroupOpts{
+				SubscriptionFilters: []*template.LogSubscriptionFilterOpts{
+					{
+						DestinationARN: "arn:aws:kinesis:us-west-2:123456789012:stream/siem",
+					},
+					{
+						DestinationARN: "arn:aws:lambda:us-west-2:123456789012:function:alert",
+						Pattern:        `{ $.level = "ERROR" }`,
+					},
+				},
+				MetricFilters: []*template.LogMetricFilterOpts{
+					{
+						MetricName: "Errors",
+						Namespace:  "${AppName}-${EnvName}-${WorkloadName}",
+						Pattern:    `"ERROR"`,
+						Value:      "1",
+					},
+					{
+						MetricName:   "Latency",
+						Namespace:    "MyApp",
+						Pattern:      `{ $.latency = * }`,
+						Value:        "$.latency",
+						DefaultValue: aws.Float64(0),
+						Unit:         aws.String("Milliseconds"),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertLogGroup(tc.inLogging, tc.inEncrypted)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertServiceConnect(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig       manifest.ServiceConnectBoolOrArgs
+		inDefaultAlias *string
+
+		wanted *template.ServiceConnectOpts
+	}{
+		"without service connect": {
+			inConfig:       manifest.ServiceConnectBoolOrArgs{},
+			inDefaultAlias: aws.String("api"),
+			wanted:         nil,
+		},
+		"service connect disabled": {
+			inConfig: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(false),
+			},
+			inDefaultAlias: aws.String("api"),
+			wanted:         nil,
+		},
+		"service connect enabled with the default alias": {
+			inConfig: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(true),
+			},
+			inDefaultAlias: aws.String("api"),
+			wanted: &template.ServiceConnectOpts{
+				Alias: aws.String("api"),
+			},
+		},
+		"service connect enabled with a custom alias": {
+			inConfig: manifest.ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: manifest.ServiceConnectArgs{
+					Alias: aws.String("orders"),
+				},
+			},
+			inDefaultAlias: aws.String("api"),
+			wanted: &template.ServiceConnectOpts{
+				Alias: aws.String("orders"),
+			},
+		},
+		"client only": {
+			inConfig: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(true),
+			},
+			wanted: &template.ServiceConnectOpts{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertServiceConnect(tc.inConfig, tc.inDefaultAlias)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1248,26 +1818,30 @@ func Test_convertPublish(t *testing.T) {
 	env := "testenv"
 	svc := "hello"
 	testCases := map[string]struct {
-		inTopics []manifest.Topic
+		inPublish manifest.PublishConfig
 
 		wanted      *template.PublishOpts
 		wantedError error
 	}{
 		"no manifest publishers should return nil": {
-			inTopics: nil,
-			wanted:   nil,
+			inPublish: manifest.PublishConfig{},
+			wanted:    nil,
 		},
 		"empty manifest publishers should return nil": {
-			inTopics: []manifest.Topic{},
-			wanted:   nil,
+			inPublish: manifest.PublishConfig{
+				Topics: []manifest.Topic{},
+			},
+			wanted: nil,
 		},
 		"valid publish": {
-			inTopics: []manifest.Topic{
-				{
-					Name: aws.String("topic1"),
-				},
-				{
-					Name: aws.String("topic2"),
+			inPublish: manifest.PublishConfig{
+				Topics: []manifest.Topic{
+					{
+						Name: aws.String("topic1"),
+					},
+					{
+						Name: aws.String("topic2"),
+					},
 				},
 			},
 			wanted: &template.PublishOpts{
@@ -1294,10 +1868,44 @@ func Test_convertPublish(t *testing.T) {
 				},
 			},
 		},
+		"fifo topic encrypted with a kms key": {
+			inPublish: manifest.PublishConfig{
+				Topics: []manifest.Topic{
+					{
+						Name:   aws.String("orders"),
+						FIFO:   aws.Bool(true),
+						KMSKey: aws.String("arn:aws:kms:us-west-2:123456789123:key/mykey"),
+					},
+				},
+			},
+			wanted: &template.PublishOpts{
+				Topics: []*template.Topic{
+					{
+						Name:      aws.String("orders"),
+						FIFO:      true,
+						KMSKey:    aws.String("arn:aws:kms:us-west-2:123456789123:key/mykey"),
+						AccountID: accountId,
+						Partition: partition,
+						Region:    region,
+						App:       app,
+						Env:       env,
+						Svc:       svc,
+					},
+				},
+			},
+		},
+		"event bus without topics": {
+			inPublish: manifest.PublishConfig{
+				EventBus: aws.Bool(true),
+			},
+			wanted: &template.PublishOpts{
+				EventBus: true,
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got, err := convertPublish(tc.inTopics, accountId, region, app, env, svc)
+			got, err := convertPublish(tc.inPublish, accountId, region, app, env, svc)
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
@@ -1317,7 +1925,8 @@ func Test_convertSubscribe(t *testing.T) {
 	testCases := map[string]struct {
 		inSubscribe manifest.SubscribeConfig
 
-		wanted *template.SubscribeOpts
+		wanted      *template.SubscribeOpts
+		wantedError error
 	}{
 		"empty subscription": {
 			inSubscribe: manifest.SubscribeConfig{},
@@ -1381,10 +1990,104 @@ func Test_convertSubscribe(t *testing.T) {
 				Queue: nil,
 			},
 		},
+		"valid subscribe to a fifo topic with a filter policy and raw message delivery": {
+			inSubscribe: manifest.SubscribeConfig{
+				Topics: []manifest.TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("svc"),
+						FIFO:    aws.Bool(true),
+						FilterPolicy: aws.String(`{
+  "store": ["example_corp"]
+}`),
+						RawDelivery: aws.Bool(true),
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:         aws.String("orders"),
+						Service:      aws.String("svc"),
+						FIFO:         true,
+						FilterPolicy: aws.String(`{"store":["example_corp"]}`),
+						RawDelivery:  true,
+					},
+				},
+			},
+		},
+		"error if the filter policy is not valid JSON": {
+			inSubscribe: manifest.SubscribeConfig{
+				Topics: []manifest.TopicSubscription{
+					{
+						Name:         aws.String("orders"),
+						Service:      aws.String("svc"),
+						FilterPolicy: aws.String(`{"store": [`),
+					},
+				},
+			},
+			wantedError: errors.New(`convert "filter_policy" of topic orders from service svc: unexpected end of JSON input`),
+		},
+		"valid subscribe to events": {
+			inSubscribe: manifest.SubscribeConfig{
+				Events: []manifest.EventSubscription{
+					{
+						Name: aws.String("orderPlaced"),
+						Pattern: aws.String(`{
+  "source": ["orders"],
+  "detail-type": ["OrderPlaced"]
+}`),
+					},
+					{
+						Name:    aws.String("paymentFailed"),
+						Pattern: aws.String(`{"source": ["payments"]}`),
+						Queue: manifest.SQSQueueOrBool{
+							Advanced: manifest.SQSQueue{
+								DeadLetter: manifest.DeadLetterQueue{
+									Tries: aws.Uint16(5),
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Events: []*template.EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"source":["orders"],"detail-type":["OrderPlaced"]}`),
+					},
+					{
+						Name:    aws.String("paymentFailed"),
+						Pattern: aws.String(`{"source":["payments"]}`),
+						Queue: &template.SQSQueue{
+							DeadLetter: &template.DeadLetterQueue{
+								Tries: aws.Uint16(5),
+							},
+						},
+					},
+				},
+			},
+		},
+		"error if the event pattern is not valid JSON": {
+			inSubscribe: manifest.SubscribeConfig{
+				Events: []manifest.EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"source": [`),
+					},
+				},
+			},
+			wantedError: errors.New(`convert "pattern" of event subscription orderPlaced: unexpected end of JSON input`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			got, err := convertSubscribe(tc.inSubscribe, accountId, region, app, env, svc)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
 			require.Equal(t, tc.wanted, got)
 			require.NoError(t, err)
 		})
@@ -34,11 +34,18 @@ type WorkerService struct {
 
 // NewWorkerService creates a new WorkerService stack from a manifest file.
 func NewWorkerService(mft *manifest.WorkerService, env, app string, rc RuntimeConfig) (*WorkerService, error) {
-	parser := template.New()
+	parser, err := newWorkloadParser(rc)
+	if err != nil {
+		return nil, err
+	}
 	addons, err := addon.New(aws.StringValue(mft.Name))
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
 	return &WorkerService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
@@ -52,6 +59,7 @@ func NewWorkerService(mft *manifest.WorkerService, env, app string, rc RuntimeCo
 			},
 			logRetention:        mft.Logging.Retention,
 			tc:                  mft.TaskConfig,
+			envAddons:           envAddons,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
 		manifest: mft,
@@ -82,6 +90,10 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	envAddonsOutputs, err := s.envAddonsOutputs()
+	if err != nil {
+		return "", err
+	}
 	sidecars, err := convertSidecar(s.manifest.Sidecars)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
@@ -100,6 +112,9 @@ func (s *WorkerService) Template() (string, error) {
 		desiredCountOnSpot = advancedCount.Spot
 		capacityProviders = advancedCount.Cps
 	}
+	if !s.manifest.Capacity.IsEmpty() {
+		capacityProviders = convertCapacityProviderStrategies(s.manifest.Capacity)
+	}
 	entrypoint, err := convertEntryPoint(s.manifest.EntryPoint)
 	if err != nil {
 		return "", err
@@ -112,7 +127,7 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
@@ -120,15 +135,19 @@ func (s *WorkerService) Template() (string, error) {
 		Variables:                      s.manifest.WorkerServiceConfig.Variables,
 		Secrets:                        convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
 		NestedStack:                    addonsOutputs,
+		EnvAddons:                      envAddonsOutputs,
 		AddonsExtraParams:              addonsParams,
 		Sidecars:                       sidecars,
 		Autoscaling:                    autoscaling,
 		CapacityProviders:              capacityProviders,
+		LaunchOnEC2:                    s.manifest.Capacity.UsesProvider(manifest.CapacityProviderEC2),
 		DesiredCountOnSpot:             desiredCountOnSpot,
 		ExecuteCommand:                 convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ServiceConnect:                 convertServiceConnect(s.manifest.Network.Connect, nil),
 		WorkloadType:                   manifest.WorkerServiceType,
 		HealthCheck:                    convertContainerHealthCheck(s.manifest.WorkerServiceConfig.ImageConfig.HealthCheck),
-		LogConfig:                      convertLogging(s.manifest.Logging),
+		LogConfig:                      convertLogging(s.manifest.Logging, s.rc.Region),
+		LogGroup:                       convertLogGroup(s.manifest.Logging, s.rc.EncryptLogs),
 		DockerLabels:                   s.manifest.ImageConfig.Image.DockerLabels,
 		DesiredCountLambda:             desiredCountLambda.String(),
 		EnvControllerLambda:            envControllerLambda.String(),
@@ -140,8 +159,12 @@ func (s *WorkerService) Template() (string, error) {
 		DependsOn:                      convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:           aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint:       s.rc.ServiceDiscoveryEndpoint,
+		PermissionsBoundary:            s.rc.PermissionsBoundary,
+		RolePath:                       s.rc.RolePath,
 		Subscribe:                      subscribe,
 		Publish:                        publishers,
+		Observability:                  convertObservability(s.manifest.Observability),
+		Permissions:                    convertPermissions(s.manifest.Permissions),
 		Platform:                       convertPlatform(s.manifest.Platform),
 	})
 	if err != nil {
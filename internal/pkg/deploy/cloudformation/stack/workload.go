@@ -6,6 +6,7 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -67,8 +68,14 @@ type RuntimeConfig struct {
 	EnvFileARN        string            // Optional. S3 object ARN for the env file.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the workload stack.
 
+	// The application metadata.
+	PermissionsBoundary string // Name of the IAM policy set as the permissions boundary of the roles in the workload stack.
+	RolePath            string // IAM path of the roles in the workload stack.
+	PartialOverrides    fs.FS  // Optional. Directory of CloudFormation partials that replace the embedded workload partials.
+
 	// The target environment metadata.
 	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
+	EncryptLogs              bool   // Whether the environment encrypts the log groups of its workloads with its KMS key.
 	AccountID                string
 	Region                   string
 }
@@ -95,11 +102,29 @@ func (i ECRImage) GetLocation() string {
 	return fmt.Sprintf("%s:%s", i.RepoURL, "latest")
 }
 
+// newWorkloadParser returns the parser for a workload's template, with the embedded partials
+// replaced by the application's partial overrides if there are any.
+func newWorkloadParser(rc RuntimeConfig) (*template.Template, error) {
+	parser := template.New()
+	if rc.PartialOverrides == nil {
+		return parser, nil
+	}
+	parser, err := parser.WithPartialOverrides(rc.PartialOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("apply template partial overrides: %w", err)
+	}
+	return parser, nil
+}
+
 type addons interface {
 	Template() (string, error)
 	Parameters() (string, error)
 }
 
+type envAddons interface {
+	Outputs(name string) ([]addon.Output, error)
+}
+
 type location interface {
 	GetLocation() string
 }
@@ -212,6 +237,27 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	}, nil
 }
 
+// envAddonsOutputs returns the outputs of the environment addons that the workload is granted access to.
+func (w *ecsWkld) envAddonsOutputs() (*template.WorkloadEnvAddonsOpts, error) {
+	if len(w.tc.Storage.EnvAddons) == 0 {
+		return nil, nil
+	}
+	var out []addon.Output
+	for _, name := range w.tc.Storage.EnvAddons {
+		outputs, err := w.envAddons.Outputs(name)
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of environment addon %s for %s: %w", name, w.name, err)
+		}
+		out = append(out, outputs...)
+	}
+	return &template.WorkloadEnvAddonsOpts{
+		VariableOutputs:      envVarOutputNames(out),
+		SecretOutputs:        secretOutputNames(out),
+		PolicyOutputs:        managedPolicyOutputNames(out),
+		SecurityGroupOutputs: securityGroupOutputNames(out),
+	}, nil
+}
+
 func (w *wkld) addonsParameters() (string, error) {
 	params, err := w.addons.Parameters()
 	if err != nil {
@@ -268,6 +314,7 @@ type ecsWkld struct {
 	*wkld
 	tc           manifest.TaskConfig
 	logRetention *int
+	envAddons    envAddons
 
 	// Overriden in unit tests.
 	taskDefOverrideFunc func(overrideRules []override.Rule, origTemp []byte) ([]byte, error)
@@ -4,11 +4,28 @@
 package stack
 
 import (
+	"errors"
 	"testing"
+	"testing/fstest"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
 )
 
+type mockEnvAddons struct {
+	outputs    map[string][]addon.Output
+	outputsErr error
+}
+
+func (m mockEnvAddons) Outputs(name string) ([]addon.Output, error) {
+	if m.outputsErr != nil {
+		return nil, m.outputsErr
+	}
+	return m.outputs[name], nil
+}
+
 func TestECRImage_GetLocation(t *testing.T) {
 	testCases := map[string]struct {
 		in ECRImage
@@ -44,3 +61,119 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWorkloadParser(t *testing.T) {
+	testCases := map[string]struct {
+		inPartialOverrides fstest.MapFS
+
+		wantedSidecars string
+		wantedErr      string
+	}{
+		"uses the embedded partials without overrides": {},
+		"error if the overrides are incompatible": {
+			inPartialOverrides: fstest.MapFS{
+				"partials.yml": {Data: []byte("version: v2.0.0")},
+			},
+			wantedErr: "apply template partial overrides: partial overrides target version v2.0.0 of the workload partials which is incompatible with the embedded version v1.2.0",
+		},
+		"layers the overrides over the embedded partials": {
+			inPartialOverrides: fstest.MapFS{
+				"partials.yml": {Data: []byte("version: v1.0.0")},
+				"sidecars.yml": {Data: []byte("sidecars override")},
+			},
+			wantedSidecars: "sidecars override",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rc := RuntimeConfig{}
+			if tc.inPartialOverrides != nil {
+				rc.PartialOverrides = tc.inPartialOverrides
+			}
+
+			parser, err := newWorkloadParser(rc)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			sidecars, err := parser.Read("workloads/partials/cf/sidecars.yml")
+			require.NoError(t, err)
+			if tc.wantedSidecars == "" {
+				embedded, err := template.New().Read("workloads/partials/cf/sidecars.yml")
+				require.NoError(t, err)
+				tc.wantedSidecars = embedded.String()
+			}
+			require.Equal(t, tc.wantedSidecars, sidecars.String())
+		})
+	}
+}
+
+func TestEcsWkld_envAddonsOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvAddons []string
+		mockOutputs mockEnvAddons
+
+		wanted    *template.WorkloadEnvAddonsOpts
+		wantedErr error
+	}{
+		"returns nil if the workload does not use environment addons": {},
+		"returns a wrapped error if the outputs of an addon cannot be retrieved": {
+			inEnvAddons: []string{"bucket"},
+			mockOutputs: mockEnvAddons{
+				outputsErr: errors.New("some error"),
+			},
+			wantedErr: errors.New("get outputs of environment addon bucket for api: some error"),
+		},
+		"returns the outputs of all the granted addons": {
+			inEnvAddons: []string{"bucket", "db"},
+			mockOutputs: mockEnvAddons{
+				outputs: map[string][]addon.Output{
+					"bucket": {
+						{Name: "bucketName"},
+						{Name: "bucketAccessPolicy", IsManagedPolicy: true},
+					},
+					"db": {
+						{Name: "dbSecret", IsSecret: true},
+						{Name: "dbSecurityGroup", IsSecurityGroup: true},
+					},
+				},
+			},
+			wanted: &template.WorkloadEnvAddonsOpts{
+				VariableOutputs:      []string{"bucketName", "dbSecurityGroup"},
+				SecretOutputs:        []string{"dbSecret"},
+				PolicyOutputs:        []string{"bucketAccessPolicy"},
+				SecurityGroupOutputs: []string{"dbSecurityGroup"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &ecsWkld{
+				wkld: &wkld{
+					name: "api",
+				},
+				tc: manifest.TaskConfig{
+					Storage: manifest.Storage{
+						EnvAddons: tc.inEnvAddons,
+					},
+				},
+				envAddons: tc.mockOutputs,
+			}
+
+			// WHEN
+			got, err := w.envAddonsOutputs()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -25,6 +25,10 @@ const (
 	ServiceTagKey = "copilot-service"
 	// TaskTagKey is tag key for Copilot task.
 	TaskTagKey = "copilot-task"
+	// PromotedFromEnvTagKey is tag key for the environment whose image was promoted to a Copilot svc.
+	PromotedFromEnvTagKey = "copilot-promoted-from-environment"
+	// PromotedImageDigestTagKey is tag key for the digest of the image promoted to a Copilot svc.
+	PromotedImageDigestTagKey = "copilot-promoted-image-digest"
 )
 
 const (
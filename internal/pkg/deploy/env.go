@@ -13,7 +13,7 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.8.0"
+	LatestEnvTemplateVersion = "v1.9.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -21,16 +21,28 @@ type CreateEnvironmentInput struct {
 	// The version of the environment template to create the stack. If empty, creates the legacy stack.
 	Version string
 
-	App                  AppInformation    // Information about the application that the environment belongs to, include app name, DNS name, the principal ARN of the account.
-	Name                 string            // Name of the environment, must be unique within an application.
-	Prod                 bool              // Whether or not this environment is a production environment.
-	AdditionalTags       map[string]string // AdditionalTags are labels applied to resources under the application.
-	ArtifactBucketARN    string            // ARN of the regional application bucket.
-	ArtifactBucketKeyARN string            // ARN of the KMS key used to encrypt the contents in the regional application bucket.
-	CustomResourcesURLs  map[string]string // Environment custom resource script S3 object URLs.
-	ImportVPCConfig      *config.ImportVPC // Optional configuration if users have an existing VPC.
-	AdjustVPCConfig      *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
-	Telemetry            *config.Telemetry // Optional observability and monitoring configuration.
+	App                  AppInformation      // Information about the application that the environment belongs to, include app name, DNS name, the principal ARN of the account.
+	Name                 string              // Name of the environment, must be unique within an application.
+	Prod                 bool                // Whether or not this environment is a production environment.
+	AdditionalTags       map[string]string   // AdditionalTags are labels applied to resources under the application.
+	ArtifactBucketARN    string              // ARN of the regional application bucket.
+	ArtifactBucketKeyARN string              // ARN of the KMS key used to encrypt the contents in the regional application bucket.
+	CustomResourcesURLs  map[string]string   // Environment custom resource script S3 object URLs.
+	ImportVPCConfig      *config.ImportVPC   // Optional configuration if users have an existing VPC.
+	AdjustVPCConfig      *config.AdjustVPC   // Optional configuration if users want to override default VPC configuration.
+	Telemetry            *config.Telemetry   // Optional observability and monitoring configuration.
+	EC2Capacity          *config.EC2Capacity // Optional Auto Scaling group capacity provider for the cluster.
+	ServiceConnect       bool                // Whether to use the service discovery namespace as the cluster's Service Connect namespace.
+
+	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
+}
+
+// CreateEnvAddonsInput holds the fields required to deploy the addons shared by the workloads in an environment.
+type CreateEnvAddonsInput struct {
+	App            string            // Name of the application that the environment belongs to.
+	Env            string            // Name of the environment.
+	Template       string            // Merged CloudFormation template of the environment addons.
+	AdditionalTags map[string]string // AdditionalTags are labels applied to resources under the application.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
@@ -62,6 +62,12 @@ type CreatePipelineInput struct {
 
 	// AdditionalTags are labels applied to resources under the application.
 	AdditionalTags map[string]string
+
+	// Name of the IAM policy set as the permissions boundary of the roles created for the pipeline.
+	PermissionsBoundary string
+
+	// IAM path of the roles created for the pipeline and of the environment roles that it assumes.
+	RolePath string
 }
 
 // Build represents CodeBuild project used in the CodePipeline
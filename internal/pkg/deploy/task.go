@@ -34,6 +34,9 @@ type CreateTaskResourcesInput struct {
 	App string
 	Env string
 
+	PermissionsBoundary string
+	RolePath            string
+
 	AdditionalTags map[string]string
 }
 
@@ -24,3 +24,19 @@ type DeleteWorkloadInput struct {
 	EnvName string // Name of the environment the service is deployed in.
 	AppName string // Name of the application the service belongs to.
 }
+
+// CreateGlobalServiceInput holds the fields required to route the alias that a service shares across regions
+// to the service's load balancer in one environment.
+type CreateGlobalServiceInput struct {
+	App                      string            // Name of the application the service belongs to.
+	Env                      string            // Name of the environment that serves the alias.
+	Name                     string            // Name of the service.
+	AppDomain                string            // DNS name of the application.
+	Alias                    string            // Alias shared by all the environments of the service.
+	Routing                  string            // Route 53 routing policy, either "latency" or "failover".
+	IsPrimary                bool              // Whether the environment serves the alias while it's healthy with "failover" routing.
+	Region                   string            // Region of the environment.
+	LoadBalancerDNSName      string            // DNS name of the environment's public load balancer.
+	LoadBalancerHostedZoneID string            // Hosted zone ID of the environment's public load balancer.
+	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources under the application.
+}
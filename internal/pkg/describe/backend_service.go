@@ -82,8 +82,10 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 
 	var configs []*ECSServiceConfig
 	var services []*ServiceDiscovery
+	var serviceConnects []*ServiceConnect
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var permissions []*taskPermission
 	for _, env := range environments {
 		err := d.initClients(env)
 		if err != nil {
@@ -130,6 +132,16 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.ecsServiceDescribers[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for service %s: %w", d.svc, err)
+		}
+		serviceConnects = appendServiceConnect(serviceConnects, svcOutputs[svcOutputServiceConnectEndpoint], env)
+		envPermissions, err := flattenPermissions(env, svcOutputs[svcOutputManifestPermissions])
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, envPermissions...)
 	}
 
 	resources := make(map[string][]*stack.Resource)
@@ -153,8 +165,10 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		App:              d.app,
 		Configurations:   configs,
 		ServiceDiscovery: services,
+		ServiceConnect:   serviceConnects,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Permissions:      permissions,
 		Resources:        resources,
 
 		environments: environments,
@@ -168,8 +182,10 @@ type backendSvcDesc struct {
 	App              string               `json:"application"`
 	Configurations   ecsConfigurations    `json:"configurations"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
+	ServiceConnect   serviceConnects      `json:"serviceConnect,omitempty"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
+	Permissions      taskPermissions      `json:"permissions,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string `json:"-"`
@@ -199,6 +215,11 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
+	if len(w.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		w.ServiceConnect.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
@@ -207,6 +228,11 @@ func (w *backendSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Permissions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPermissions\n\n"))
+		writer.Flush()
+		w.Permissions.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
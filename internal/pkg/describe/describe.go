@@ -4,6 +4,7 @@
 package describe
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -83,6 +84,41 @@ func flattenSecrets(envName string, secrets []*ecs.ContainerSecret) []*secret {
 	return out
 }
 
+// taskPermission contains serialized info of an IAM permission granted to the task role of a service.
+type taskPermission struct {
+	Environment string   `json:"environment"`
+	Actions     []string `json:"actions"`
+	Resources   []string `json:"resources"`
+}
+
+type taskPermissions []*taskPermission
+
+func (p taskPermissions) humanString(w io.Writer) {
+	headers := []string{"Environment", "Actions", "Resources"}
+	var rows [][]string
+	for _, perm := range p {
+		for _, resource := range perm.Resources {
+			rows = append(rows, []string{perm.Environment, strings.Join(perm.Actions, ", "), resource})
+		}
+	}
+	printTable(w, headers, rows)
+}
+
+// flattenPermissions parses the permissions output of a service stack deployed in an environment.
+func flattenPermissions(envName, permissionsOutput string) ([]*taskPermission, error) {
+	if permissionsOutput == "" {
+		return nil, nil
+	}
+	var perms []*taskPermission
+	if err := json.Unmarshal([]byte(permissionsOutput), &perms); err != nil {
+		return nil, fmt.Errorf("unmarshal permissions for environment %s: %w", envName, err)
+	}
+	for _, perm := range perms {
+		perm.Environment = envName
+	}
+	return perms, nil
+}
+
 func printTable(w io.Writer, headers []string, rows [][]string) {
 	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
@@ -86,7 +86,7 @@ func summarizeHTTPHealthForTasks(targetsHealth []taskTargetHealth) map[string][]
 	return out
 }
 
-func runningCapacityProvidersBreakDownByCount(tasks []ecs.TaskStatus) (fargate, spot, empty int) {
+func runningCapacityProvidersBreakDownByCount(tasks []ecs.TaskStatus) (fargate, spot, ec2, empty int) {
 	for _, t := range tasks {
 		if t.LastStatus != ecs.TaskStatusRunning {
 			continue
@@ -96,8 +96,11 @@ func runningCapacityProvidersBreakDownByCount(tasks []ecs.TaskStatus) (fargate,
 			fargate += 1
 		case ecs.TaskCapacityProviderFargateSpot:
 			spot += 1
-		default:
+		case "":
 			empty += 1
+		default:
+			// Any other capacity provider is backed by the environment's Auto Scaling group.
+			ec2 += 1
 		}
 	}
 	return
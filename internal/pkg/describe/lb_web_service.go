@@ -32,6 +32,8 @@ const (
 	svcStackResourceALBTargetGroupLogicalID = "TargetGroup"
 	svcStackResourceNLBTargetGroupLogicalID = "NLBTargetGroup"
 	svcOutputPublicNLBDNSName               = "PublicNetworkLoadBalancerDNSName"
+	svcOutputServiceConnectEndpoint         = "ServiceConnectEndpoint"
+	svcOutputManifestPermissions            = "ManifestPermissions"
 )
 
 type envDescriber interface {
@@ -103,8 +105,10 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var routes []*WebServiceRoute
 	var configs []*ECSServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
+	var serviceConnects []*ServiceConnect
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var permissions []*taskPermission
 	for _, env := range environments {
 		err := d.initClients(env)
 		if err != nil {
@@ -151,6 +155,16 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.ecsServiceDescribers[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for service %s: %w", d.svc, err)
+		}
+		serviceConnects = appendServiceConnect(serviceConnects, svcOutputs[svcOutputServiceConnectEndpoint], env)
+		envPermissions, err := flattenPermissions(env, svcOutputs[svcOutputManifestPermissions])
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, envPermissions...)
 	}
 	resources := make(map[string][]*stack.Resource)
 	if d.enableResources {
@@ -174,8 +188,10 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Configurations:   configs,
 		Routes:           routes,
 		ServiceDiscovery: serviceDiscoveries,
+		ServiceConnect:   serviceConnects,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Permissions:      permissions,
 		Resources:        resources,
 
 		environments: environments,
@@ -261,6 +277,23 @@ func (s serviceDiscoveries) humanString(w io.Writer) {
 	}
 }
 
+// ServiceConnect contains serialized Service Connect info for a service.
+type ServiceConnect struct {
+	Environment []string `json:"environment"`
+	Endpoint    string   `json:"endpoint"`
+}
+
+type serviceConnects []*ServiceConnect
+
+func (s serviceConnects) humanString(w io.Writer) {
+	headers := []string{"Environment", "Endpoint"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, sc := range s {
+		fmt.Fprintf(w, "  %s\t%s\n", strings.Join(sc.Environment, ", "), sc.Endpoint)
+	}
+}
+
 // webSvcDesc contains serialized parameters for a web service.
 type webSvcDesc struct {
 	Service          string               `json:"service"`
@@ -269,8 +302,10 @@ type webSvcDesc struct {
 	Configurations   ecsConfigurations    `json:"configurations"`
 	Routes           []*WebServiceRoute   `json:"routes"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
+	ServiceConnect   serviceConnects      `json:"serviceConnect,omitempty"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
+	Permissions      taskPermissions      `json:"permissions,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string
@@ -308,6 +343,11 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
+	if len(w.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		w.ServiceConnect.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
@@ -316,6 +356,11 @@ func (w *webSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Permissions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPermissions\n\n"))
+		writer.Flush()
+		w.Permissions.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -366,3 +411,19 @@ func appendServiceDiscovery(sds []*ServiceDiscovery, sd serviceDiscovery, env st
 	}
 	return sds
 }
+
+func appendServiceConnect(scs []*ServiceConnect, endpoint, env string) []*ServiceConnect {
+	if endpoint == "" {
+		return scs
+	}
+	for _, sc := range scs {
+		if sc.Endpoint == endpoint {
+			sc.Environment = append(sc.Environment, env)
+			return scs
+		}
+	}
+	return append(scs, &ServiceConnect{
+		Environment: []string{env},
+		Endpoint:    endpoint,
+	})
+}
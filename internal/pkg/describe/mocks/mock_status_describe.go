@@ -259,6 +259,21 @@ func (m *MockappRunnerServiceDescriber) EXPECT() *MockappRunnerServiceDescriberM
 	return m.recorder
 }
 
+// IsPrivate mocks base method.
+func (m *MockappRunnerServiceDescriber) IsPrivate() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivate")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPrivate indicates an expected call of IsPrivate.
+func (mr *MockappRunnerServiceDescriberMockRecorder) IsPrivate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivate", reflect.TypeOf((*MockappRunnerServiceDescriber)(nil).IsPrivate))
+}
+
 // Service mocks base method.
 func (m *MockappRunnerServiceDescriber) Service() (*apprunner.Service, error) {
 	m.ctrl.T.Helper()
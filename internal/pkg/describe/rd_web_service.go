@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -15,6 +16,11 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
+const (
+	appRunnerIngressPublic  = "public"
+	appRunnerIngressPrivate = "private"
+)
+
 // RDWebServiceDescriber retrieves information about a request-driven web service.
 type RDWebServiceDescriber struct {
 	app             string
@@ -63,7 +69,7 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	var routes []*WebServiceRoute
-	var configs []*ServiceConfig
+	var configs []*AppRunnerServiceConfig
 	var envVars envVars
 	resources := make(map[string][]*stack.Resource)
 	for _, env := range environments {
@@ -75,17 +81,32 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		if err != nil {
 			return nil, fmt.Errorf("retrieve service configuration: %w", err)
 		}
+		private, err := d.envSvcDescribers[env].IsPrivate()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service ingress: %w", err)
+		}
 		webServiceURI := formatAppRunnerUrl(service.ServiceURL)
 		routes = append(routes, &WebServiceRoute{
 			Environment: env,
 			URL:         webServiceURI,
 		})
-		configs = append(configs, &ServiceConfig{
-			Environment: env,
-			Port:        service.Port,
-			CPU:         service.CPU,
-			Memory:      service.Memory,
-		})
+		config := &AppRunnerServiceConfig{
+			ServiceConfig: &ServiceConfig{
+				Environment: env,
+				Port:        service.Port,
+				CPU:         service.CPU,
+				Memory:      service.Memory,
+			},
+			Ingress: appRunnerIngressPublic,
+		}
+		if private {
+			config.Ingress = appRunnerIngressPrivate
+		}
+		if service.AutoScaling != nil {
+			config.Concurrency = strconv.FormatInt(service.AutoScaling.MaxConcurrency, 10)
+			config.Instances = fmt.Sprintf("%d-%d", service.AutoScaling.MinSize, service.AutoScaling.MaxSize)
+		}
+		configs = append(configs, config)
 
 		for _, v := range service.EnvironmentVariables {
 			envVars = append(envVars, &envVar{
@@ -23,10 +23,10 @@ const humanStringWithResources = `About
 
 Configurations
 
-  Environment  CPU (vCPU)  Memory (MiB)  Port
-  -----------  ----------  ------------  ----
-  test         1           2048          80
-  prod         2           3072            "
+  Environment  CPU (vCPU)  Memory (MiB)  Port      Concurrency  Instances  Ingress
+  -----------  ----------  ------------  ----      -----------  ---------  -------
+  test         1           2048          80        100          1-25       public
+  prod         2           3072            "       50           2-10       private
 
 Routes
 
@@ -95,11 +95,22 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 				gomock.InOrder(
 					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
 					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{}, nil),
+					m.ecsSvcDescriber.EXPECT().IsPrivate().Return(false, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("retrieve service resources: some error"),
 		},
+		"return error if fail to retrieve service ingress": {
+			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{}, nil),
+					m.ecsSvcDescriber.EXPECT().IsPrivate().Return(false, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service ingress: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m apprunnerSvcDescriberMocks) {
@@ -117,7 +128,13 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 								Value: "test",
 							},
 						},
+						AutoScaling: &apprunner.AutoScalingConfiguration{
+							MaxConcurrency: 100,
+							MinSize:        1,
+							MaxSize:        25,
+						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().IsPrivate().Return(false, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
@@ -136,7 +153,13 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 								Value: "prod",
 							},
 						},
+						AutoScaling: &apprunner.AutoScalingConfiguration{
+							MaxConcurrency: 50,
+							MinSize:        2,
+							MaxSize:        10,
+						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().IsPrivate().Return(true, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
@@ -149,18 +172,28 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 				Service: testSvc,
 				Type:    "Request-Driven Web Service",
 				App:     testApp,
-				AppRunnerConfigurations: []*ServiceConfig{
+				AppRunnerConfigurations: []*AppRunnerServiceConfig{
 					{
-						CPU:         "1024",
-						Environment: "test",
-						Memory:      "2048",
-						Port:        "80",
+						ServiceConfig: &ServiceConfig{
+							CPU:         "1024",
+							Environment: "test",
+							Memory:      "2048",
+							Port:        "80",
+						},
+						Concurrency: "100",
+						Instances:   "1-25",
+						Ingress:     "public",
 					},
 					{
-						CPU:         "2048",
-						Environment: "prod",
-						Memory:      "3072",
-						Port:        "80",
+						ServiceConfig: &ServiceConfig{
+							CPU:         "2048",
+							Environment: "prod",
+							Memory:      "3072",
+							Port:        "80",
+						},
+						Concurrency: "50",
+						Instances:   "2-10",
+						Ingress:     "private",
 					},
 				},
 				Routes: []*WebServiceRoute{
@@ -248,23 +281,33 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 func TestRDWebServiceDesc_String(t *testing.T) {
 	t.Run("correct output including resources", func(t *testing.T) {
 		wantedHumanString := humanStringWithResources
-		wantedJSONString := "{\"service\":\"testsvc\",\"type\":\"Request-Driven Web Service\",\"application\":\"testapp\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"1024\",\"memory\":\"2048\"},{\"environment\":\"prod\",\"port\":\"80\",\"cpu\":\"2048\",\"memory\":\"3072\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com\"},{\"environment\":\"prod\",\"url\":\"https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::AppRunner::Service\",\"physicalID\":\"arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc\"}],\"test\":[{\"type\":\"AWS::AppRunner::Service\",\"physicalID\":\"arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc\"}]}}\n"
+		wantedJSONString := "{\"service\":\"testsvc\",\"type\":\"Request-Driven Web Service\",\"application\":\"testapp\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"1024\",\"memory\":\"2048\",\"concurrency\":\"100\",\"instances\":\"1-25\",\"ingress\":\"public\"},{\"environment\":\"prod\",\"port\":\"80\",\"cpu\":\"2048\",\"memory\":\"3072\",\"concurrency\":\"50\",\"instances\":\"2-10\",\"ingress\":\"private\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com\"},{\"environment\":\"prod\",\"url\":\"https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::AppRunner::Service\",\"physicalID\":\"arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc\"}],\"test\":[{\"type\":\"AWS::AppRunner::Service\",\"physicalID\":\"arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc\"}]}}\n"
 		svcDesc := &rdWebSvcDesc{
 			Service: "testsvc",
 			Type:    "Request-Driven Web Service",
 			App:     "testapp",
-			AppRunnerConfigurations: []*ServiceConfig{
+			AppRunnerConfigurations: []*AppRunnerServiceConfig{
 				{
-					CPU:         "1024",
-					Environment: "test",
-					Memory:      "2048",
-					Port:        "80",
+					ServiceConfig: &ServiceConfig{
+						CPU:         "1024",
+						Environment: "test",
+						Memory:      "2048",
+						Port:        "80",
+					},
+					Concurrency: "100",
+					Instances:   "1-25",
+					Ingress:     "public",
 				},
 				{
-					CPU:         "2048",
-					Environment: "prod",
-					Memory:      "3072",
-					Port:        "80",
+					ServiceConfig: &ServiceConfig{
+						CPU:         "2048",
+						Environment: "prod",
+						Memory:      "3072",
+						Port:        "80",
+					},
+					Concurrency: "50",
+					Instances:   "2-10",
+					Ingress:     "private",
 				},
 			},
 			Routes: []*WebServiceRoute{
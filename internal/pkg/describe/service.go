@@ -8,9 +8,11 @@ import (
 	"io"
 	"net/url"
 	"sort"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -28,7 +30,10 @@ const (
 	waitConditionHandle  = "AWS::CloudFormation::WaitConditionHandle"
 )
 
-const apprunnerServiceType = "AWS::AppRunner::Service"
+const (
+	apprunnerServiceType              = "AWS::AppRunner::Service"
+	apprunnerVpcIngressConnectionType = "AWS::AppRunner::VpcIngressConnection"
+)
 
 // ConfigStoreSvc wraps methods of config store.
 type ConfigStoreSvc interface {
@@ -48,6 +53,7 @@ type DeployedEnvServicesLister interface {
 
 type ecsClient interface {
 	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
 }
 
 type apprunnerClient interface {
@@ -74,6 +80,7 @@ type apprunnerDescriber interface {
 	Service() (*apprunner.Service, error)
 	ServiceARN() (string, error)
 	ServiceURL() (string, error)
+	IsPrivate() (bool, error)
 }
 
 // serviceStackDescriber provides base functionality for retrieving info about a service.
@@ -170,6 +177,35 @@ func (d *ECSServiceDescriber) Secrets() ([]*awsecs.ContainerSecret, error) {
 	return taskDefinition.Secrets(), nil
 }
 
+// Image returns the image URI of the service's main container, pinned to the digest of the image
+// that the running tasks of the current task definition pulled.
+func (d *ECSServiceDescriber) Image() (string, error) {
+	taskDefinition, err := d.ecsClient.TaskDefinition(d.app, d.env, d.service)
+	if err != nil {
+		return "", fmt.Errorf("describe task definition for service %s: %w", d.service, err)
+	}
+	image, err := taskDefinition.Image(d.service)
+	if err != nil {
+		return "", fmt.Errorf("get image of service %s: %w", d.service, err)
+	}
+	svc, err := d.ecsClient.DescribeService(d.app, d.env, d.service)
+	if err != nil {
+		return "", fmt.Errorf("describe service %s: %w", d.service, err)
+	}
+	// The tag in the task definition may have been pushed again since the tasks were started.
+	for _, task := range svc.Tasks {
+		if aws.StringValue(task.TaskDefinitionArn) != aws.StringValue(taskDefinition.TaskDefinitionArn) {
+			continue
+		}
+		for _, container := range task.Containers {
+			if aws.StringValue(container.Name) == d.service && aws.StringValue(container.ImageDigest) != "" {
+				return fmt.Sprintf("%s@%s", imageRepository(image), aws.StringValue(container.ImageDigest)), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no running task of service %s reports the digest of its image", d.service)
+}
+
 // ServiceStackResources returns the filtered service stack resources created by CloudFormation.
 func (d *serviceStackDescriber) ServiceStackResources() ([]*stack.Resource, error) {
 	svcResources, err := d.cfn.Resources()
@@ -239,6 +275,29 @@ func (d *AppRunnerServiceDescriber) Service() (*apprunner.Service, error) {
 	return service, nil
 }
 
+// IsPrivate returns true if the app runner service only accepts traffic from within the environment's VPC.
+func (d *AppRunnerServiceDescriber) IsPrivate() (bool, error) {
+	serviceStackResources, err := d.ServiceStackResources()
+	if err != nil {
+		return false, err
+	}
+	for _, resource := range serviceStackResources {
+		if resource.Type == apprunnerVpcIngressConnectionType {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Image returns the image URI that the app runner service runs.
+func (d *AppRunnerServiceDescriber) Image() (string, error) {
+	service, err := d.Service()
+	if err != nil {
+		return "", fmt.Errorf("retrieve service image: %w", err)
+	}
+	return service.ImageID, nil
+}
+
 // ServiceURL retrieves the app runner service URL.
 func (d *AppRunnerServiceDescriber) ServiceURL() (string, error) {
 	service, err := d.Service()
@@ -294,7 +353,16 @@ type ECSServiceConfig struct {
 	Tasks string `json:"tasks"`
 }
 
-type appRunnerConfigurations []*ServiceConfig
+// AppRunnerServiceConfig contains serialized configuration parameters for an App Runner service.
+type AppRunnerServiceConfig struct {
+	*ServiceConfig
+
+	Concurrency string `json:"concurrency"`
+	Instances   string `json:"instances"`
+	Ingress     string `json:"ingress"`
+}
+
+type appRunnerConfigurations []*AppRunnerServiceConfig
 
 type ecsConfigurations []*ECSServiceConfig
 
@@ -309,10 +377,10 @@ func (c ecsConfigurations) humanString(w io.Writer) {
 }
 
 func (c appRunnerConfigurations) humanString(w io.Writer) {
-	headers := []string{"Environment", "CPU (vCPU)", "Memory (MiB)", "Port"}
+	headers := []string{"Environment", "CPU (vCPU)", "Memory (MiB)", "Port", "Concurrency", "Instances", "Ingress"}
 	var rows [][]string
 	for _, config := range c {
-		rows = append(rows, []string{config.Environment, cpuToString(config.CPU), config.Memory, config.Port})
+		rows = append(rows, []string{config.Environment, cpuToString(config.CPU), config.Memory, config.Port, config.Concurrency, config.Instances, config.Ingress})
 	}
 
 	printTable(w, headers, rows)
@@ -361,3 +429,14 @@ func (e containerEnvVars) humanString(w io.Writer) {
 
 	printTable(w, headers, rows)
 }
+
+// imageRepository strips the tag or digest from the image URI.
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i != -1 {
+		return image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i]
+	}
+	return image
+}
@@ -15,6 +15,7 @@ import (
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	ecsclient "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -118,6 +119,173 @@ func TestServiceDescriber_EnvVars(t *testing.T) {
 	}
 }
 
+func TestServiceDescriber_Image(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testSvc = "svc"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks ecsSvcDescriberMocks)
+
+		wantedImage string
+		wantedError error
+	}{
+		"returns error if fails to get task definition": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().TaskDefinition(testApp, testEnv, testSvc).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe task definition for service svc: some error"),
+		},
+		"returns error if the main container is not in the task definition": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().TaskDefinition(testApp, testEnv, testSvc).Return(&ecs.TaskDefinition{
+					ContainerDefinitions: []*ecsapi.ContainerDefinition{
+						{
+							Name:  aws.String("nginx"),
+							Image: aws.String("nginx"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedError: errors.New("get image of service svc: container svc not found"),
+		},
+		"returns error if fails to describe the service": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().TaskDefinition(testApp, testEnv, testSvc).Return(&ecs.TaskDefinition{
+					ContainerDefinitions: []*ecsapi.ContainerDefinition{
+						{
+							Name:  aws.String("svc"),
+							Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/svc:v1"),
+						},
+					},
+				}, nil)
+				m.mockECSClient.EXPECT().DescribeService(testApp, testEnv, testSvc).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe service svc: some error"),
+		},
+		"returns error if no running task of the current task definition has the digest": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().TaskDefinition(testApp, testEnv, testSvc).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-svc:2"),
+					ContainerDefinitions: []*ecsapi.ContainerDefinition{
+						{
+							Name:  aws.String("svc"),
+							Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/svc:v1"),
+						},
+					},
+				}, nil)
+				m.mockECSClient.EXPECT().DescribeService(testApp, testEnv, testSvc).Return(&ecsclient.ServiceDesc{
+					Tasks: []*ecs.Task{
+						{
+							TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-svc:1"),
+							Containers: []*ecsapi.Container{
+								{
+									Name:        aws.String("svc"),
+									ImageDigest: aws.String("sha256:old"),
+								},
+							},
+						},
+						{
+							TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-svc:2"),
+							Containers: []*ecsapi.Container{
+								{
+									Name: aws.String("svc"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedError: errors.New("no running task of service svc reports the digest of its image"),
+		},
+		"get the image of the main container pinned to the digest of the running task": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().TaskDefinition(testApp, testEnv, testSvc).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-svc:2"),
+					ContainerDefinitions: []*ecsapi.ContainerDefinition{
+						{
+							Name:  aws.String("nginx"),
+							Image: aws.String("nginx"),
+						},
+						{
+							Name:  aws.String("svc"),
+							Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/svc:v1"),
+						},
+					},
+				}, nil)
+				m.mockECSClient.EXPECT().DescribeService(testApp, testEnv, testSvc).Return(&ecsclient.ServiceDesc{
+					Tasks: []*ecs.Task{
+						{
+							TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-svc:1"),
+							Containers: []*ecsapi.Container{
+								{
+									Name:        aws.String("svc"),
+									ImageDigest: aws.String("sha256:old"),
+								},
+							},
+						},
+						{
+							TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-svc:2"),
+							Containers: []*ecsapi.Container{
+								{
+									Name:        aws.String("nginx"),
+									ImageDigest: aws.String("sha256:nginx"),
+								},
+								{
+									Name:        aws.String("svc"),
+									ImageDigest: aws.String("sha256:abc"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedImage: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/svc@sha256:abc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsClient := mocks.NewMockecsClient(ctrl)
+			mocks := ecsSvcDescriberMocks{
+				mockECSClient: mockecsClient,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ECSServiceDescriber{
+				serviceStackDescriber: &serviceStackDescriber{
+					app:     testApp,
+					service: testSvc,
+					env:     testEnv,
+				},
+				ecsClient: mockecsClient,
+			}
+
+			// WHEN
+			actual, err := d.Image()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedImage, actual)
+			}
+		})
+	}
+}
+
 func TestServiceDescriber_Secrets(t *testing.T) {
 	const (
 		testApp = "phonetool"
@@ -383,3 +551,79 @@ func TestServiceDescriber_Platform(t *testing.T) {
 		})
 	}
 }
+
+func TestAppRunnerServiceDescriber_IsPrivate(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackDescriber)
+
+		wanted      bool
+		wantedError error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns true if the service has a VPC ingress connection": {
+			setupMocks: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::AppRunner::Service",
+						PhysicalID: "arn:aws:apprunner:us-west-2:1234567890:service/phonetool-test-api/1a2b3c",
+					},
+					{
+						Type:       "AWS::AppRunner::VpcIngressConnection",
+						PhysicalID: "arn:aws:apprunner:us-west-2:1234567890:vpcingressconnection/phonetool-test-api/4d5e6f",
+					},
+				}, nil)
+			},
+
+			wanted: true,
+		},
+		"returns false if the service is public": {
+			setupMocks: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::AppRunner::Service",
+						PhysicalID: "arn:aws:apprunner:us-west-2:1234567890:service/phonetool-test-api/1a2b3c",
+					},
+				}, nil)
+			},
+
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(mockCFN)
+
+			d := &AppRunnerServiceDescriber{
+				serviceStackDescriber: &serviceStackDescriber{
+					app:     "phonetool",
+					service: "api",
+					env:     "test",
+					cfn:     mockCFN,
+				},
+			}
+
+			// WHEN
+			actual, err := d.IsPrivate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
@@ -46,11 +46,13 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	Events                   []awsecs.ServiceEvent    `json:"events,omitempty"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
 type appRunnerServiceStatus struct {
 	Service   apprunner.Service
+	Private   bool
 	LogEvents []*cloudwatchlogs.Event
 }
 
@@ -71,6 +73,11 @@ func (s *ecsServiceStatus) JSONString() (string, error) {
 
 // JSONString returns the stringified appRunnerServiceStatus struct with json format.
 func (a *appRunnerServiceStatus) JSONString() (string, error) {
+	type autoScaling struct {
+		MaxConcurrency int64 `json:"maxConcurrency"`
+		MinSize        int64 `json:"minSize"`
+		MaxSize        int64 `json:"maxSize"`
+	}
 	data := struct {
 		ARN       string    `json:"arn"`
 		Status    string    `json:"status"`
@@ -79,6 +86,8 @@ func (a *appRunnerServiceStatus) JSONString() (string, error) {
 		Source    struct {
 			ImageID string `json:"imageId"`
 		} `json:"source"`
+		Ingress     string       `json:"ingress"`
+		AutoScaling *autoScaling `json:"autoScaling,omitempty"`
 	}{
 		ARN:       a.Service.ServiceARN,
 		Status:    a.Service.Status,
@@ -89,6 +98,14 @@ func (a *appRunnerServiceStatus) JSONString() (string, error) {
 		}{
 			ImageID: a.Service.ImageID,
 		},
+		Ingress: a.ingress(),
+	}
+	if a.Service.AutoScaling != nil {
+		data.AutoScaling = &autoScaling{
+			MaxConcurrency: a.Service.AutoScaling.MaxConcurrency,
+			MinSize:        a.Service.AutoScaling.MinSize,
+			MaxSize:        a.Service.AutoScaling.MaxSize,
+		}
 	}
 	b, err := json.Marshal(data)
 	if err != nil {
@@ -147,7 +164,16 @@ func (a *appRunnerServiceStatus) HumanString() string {
 		imageID = strings.SplitAfterN(imageID, "/", 2)[1] // strip the registry.
 	}
 	fmt.Fprintf(writer, "  %s\t%s\n", "Source", imageID)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Ingress", a.ingress())
 	writer.Flush()
+	if a.Service.AutoScaling != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAuto Scaling\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d\n", "Max Concurrency", a.Service.AutoScaling.MaxConcurrency)
+		fmt.Fprintf(writer, "  %s\t%d\n", "Min Size", a.Service.AutoScaling.MinSize)
+		fmt.Fprintf(writer, "  %s\t%d\n", "Max Size", a.Service.AutoScaling.MaxSize)
+		writer.Flush()
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nSystem Logs\n\n"))
 	writer.Flush()
 	lo, _ := time.LoadLocation("UTC")
@@ -159,6 +185,13 @@ func (a *appRunnerServiceStatus) HumanString() string {
 	return b.String()
 }
 
+func (a *appRunnerServiceStatus) ingress() string {
+	if a.Private {
+		return appRunnerIngressPrivate
+	}
+	return appRunnerIngressPublic
+}
+
 func (s *ecsServiceStatus) writeTaskSummary(writer io.Writer) {
 	// NOTE: all the `bar` need to be fully colored. Observe how all the second parameter for all `summaryBar` function
 	// is a list of strings that are colored (e.g. `[]string{color.Green.Sprint("■"), color.Grey.Sprint("□")}`)
@@ -326,7 +359,7 @@ func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 		return
 	}
 
-	fargate, spot, empty := runningCapacityProvidersBreakDownByCount(s.DesiredRunningTasks)
+	fargate, spot, ec2, empty := runningCapacityProvidersBreakDownByCount(s.DesiredRunningTasks)
 	data := []summarybar.Datum{
 		{
 			Value:          fargate + empty,
@@ -336,6 +369,10 @@ func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 			Value:          spot,
 			Representation: color.Grey.Sprintf("▓"),
 		},
+		{
+			Value:          ec2,
+			Representation: color.Grey.Sprintf("█"),
+		},
 	}
 	renderer := summarybar.New(data, summaryBarWidthConfig, summaryBarEmptyRepConfig)
 	fmt.Fprintf(writer, "  %s\t", "Capacity Provider")
@@ -349,6 +386,9 @@ func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 	if spot != 0 {
 		cpSummaries = append(cpSummaries, fmt.Sprintf("%d/%d on Fargate Spot", spot, s.Service.RunningCount))
 	}
+	if ec2 != 0 {
+		cpSummaries = append(cpSummaries, fmt.Sprintf("%d/%d on EC2", ec2, s.Service.RunningCount))
+	}
 	fmt.Fprintf(writer, "\t%s\n", strings.Join(cpSummaries, ", "))
 }
 
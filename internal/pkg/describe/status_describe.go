@@ -19,7 +19,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 )
 
-const fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+const (
+	fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+	maxServiceEventsToDisplay   = 5
+)
 
 type targetHealthGetter interface {
 	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
@@ -45,6 +48,7 @@ type serviceDescriber interface {
 
 type appRunnerServiceDescriber interface {
 	Service() (*apprunner.Service, error)
+	IsPrivate() (bool, error)
 }
 
 type autoscalingAlarmNamesGetter interface {
@@ -126,6 +130,14 @@ func NewAppRunnerStatusDescriber(opt *NewServiceStatusConfig) (*appRunnerStatusD
 
 // Describe returns status of an ECS service.
 func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
+	status, err := s.describe()
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (s *ecsStatusDescriber) describe() (*ecsServiceStatus, error) {
 	svcDesc, err := s.svcDescriber.DescribeService(s.app, s.env, s.svc)
 	if err != nil {
 		return nil, fmt.Errorf("get ECS service description for %s: %w", s.svc, err)
@@ -191,6 +203,7 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		Events:                   service.RecentEvents(maxServiceEventsToDisplay),
 	}, nil
 }
 
@@ -212,6 +225,10 @@ func (a *appRunnerStatusDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get AppRunner service description for App Runner service %s in environment %s: %w", a.svc, a.env, err)
 	}
+	private, err := a.svcDescriber.IsPrivate()
+	if err != nil {
+		return nil, fmt.Errorf("get ingress configuration for App Runner service %s in environment %s: %w", a.svc, a.env, err)
+	}
 	logGroupName := fmt.Sprintf(fmtAppRunnerSvcLogGroupName, svc.Name, svc.ID)
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
 		LogGroup: logGroupName,
@@ -223,6 +240,7 @@ func (a *appRunnerStatusDescriber) Describe() (HumanJSONStringer, error) {
 	}
 	return &appRunnerServiceStatus{
 		Service:   *svc,
+		Private:   private,
 		LogEvents: logEventsOutput.Events,
 	}, nil
 }
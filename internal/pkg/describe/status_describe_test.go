@@ -564,15 +564,27 @@ func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get AppRunner service description for App Runner service frontend in environment test: some error"),
 		},
+		"errors if failed to determine the ingress of a service": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.appRunnerSvcDescriber.EXPECT().Service().Return(&mockAppRunnerService, nil),
+					m.appRunnerSvcDescriber.EXPECT().IsPrivate().Return(false, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("get ingress configuration for App Runner service frontend in environment test: some error"),
+		},
 		"success": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
 				m.appRunnerSvcDescriber.EXPECT().Service().Return(&mockAppRunnerService, nil)
+				m.appRunnerSvcDescriber.EXPECT().IsPrivate().Return(true, nil)
 				m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{LogGroup: "/aws/apprunner/testapp-test-frontend/fc1098ac269245959ba78fd58bdd4bf/service", Limit: aws.Int64(10)}).Return(&cloudwatchlogs.LogEventsOutput{
 					Events: logEvents,
 				}, nil)
 			},
 			wantedContent: &appRunnerServiceStatus{
 				Service:   mockAppRunnerService,
+				Private:   true,
 				LogEvents: logEvents,
 			},
 		},
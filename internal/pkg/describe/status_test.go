@@ -510,6 +510,45 @@ Tasks
   44444444  ACTIVATING  -           -           FARGATE (Launch type)
 `,
 			json: `{"Service":{"desiredCount":4,"runningCount":3,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":[{"health":"UNKNOWN","id":"11111111111111111","images":[],"lastStatus":"RUNNING","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"","capacityProvider":"FARGATE_SPOT","taskDefinitionARN":""},{"health":"UNKNOWN","id":"22222222222222","images":[],"lastStatus":"RUNNING","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"","capacityProvider":"FARGATE","taskDefinitionARN":""},{"health":"UNKNOWN","id":"333333333333","images":[],"lastStatus":"RUNNING","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"","capacityProvider":"","taskDefinitionARN":""},{"health":"UNKNOWN","id":"444444444444","images":[],"lastStatus":"ACTIVATING","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"","capacityProvider":"","taskDefinitionARN":""}],"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null}
+`,
+		},
+		"while running on the EC2 capacity provider": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 2,
+					RunningCount: 2,
+					Status:       "ACTIVE",
+				},
+				DesiredRunningTasks: []awsecs.TaskStatus{
+					{
+						Health:           "UNKNOWN",
+						LastStatus:       "RUNNING",
+						ID:               "11111111111111111",
+						Images:           []awsecs.Image{},
+						CapacityProvider: "phonetool-test-EC2CapacityProvider-abcd",
+					},
+					{
+						Health:           "UNKNOWN",
+						LastStatus:       "RUNNING",
+						ID:               "22222222222222",
+						Images:           []awsecs.Image{},
+						CapacityProvider: "phonetool-test-EC2CapacityProvider-abcd",
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running            ██████████  2/2 desired tasks are running
+  Capacity Provider  ██████████  2/2 on EC2
+
+Tasks
+
+  ID        Status      Revision    Started At  Capacity
+  --        ------      --------    ----------  --------
+  11111111  RUNNING     -           -           phonetool-test-EC2CapacityProvider-abcd
+  22222222  RUNNING     -           -           phonetool-test-EC2CapacityProvider-abcd
+`,
+			json: `{"Service":{"desiredCount":2,"runningCount":2,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":[{"health":"UNKNOWN","id":"11111111111111111","images":[],"lastStatus":"RUNNING","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"","capacityProvider":"phonetool-test-EC2CapacityProvider-abcd","taskDefinitionARN":""},{"health":"UNKNOWN","id":"22222222222222","images":[],"lastStatus":"RUNNING","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"","capacityProvider":"phonetool-test-EC2CapacityProvider-abcd","taskDefinitionARN":""}],"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null}
 `,
 		},
 		"hide tasks section if there is no desired running task": {
@@ -599,12 +638,57 @@ Last deployment
   Updated At  2 months ago
   Service ID  frontend/8a2b343f658144d885e47d10adb4845e
   Source      hello
+  Ingress     public
+
+System Logs
+
+  2021-05-18T19:26:25Z  [AppRunner] Service creation started.
+`,
+			json: `{"arn":"arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e","status":"RUNNING","createdAt":"2020-01-01T00:00:00Z","updatedAt":"2020-03-01T00:00:00Z","source":{"imageId":"hello"},"ingress":"public"}` + "\n",
+		},
+		"private service with auto scaling": {
+			desc: &appRunnerServiceStatus{
+				Service: apprunner.Service{
+					Name:        "frontend",
+					ID:          "8a2b343f658144d885e47d10adb4845e",
+					ServiceARN:  "arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e",
+					Status:      "RUNNING",
+					DateCreated: createTime,
+					DateUpdated: updateTime,
+					ImageID:     "hello",
+					AutoScaling: &apprunner.AutoScalingConfiguration{
+						Name:           "frontend",
+						Revision:       2,
+						MaxConcurrency: 50,
+						MinSize:        2,
+						MaxSize:        10,
+					},
+				},
+				Private:   true,
+				LogEvents: logEvents,
+			},
+			human: `Service Status
+
+ Status RUNNING 
+
+Last deployment
+
+  Updated At  2 months ago
+  Service ID  frontend/8a2b343f658144d885e47d10adb4845e
+  Source      hello
+  Ingress     private
+
+Auto Scaling
+
+  Max Concurrency  50
+  Min Size         2
+  Max Size         10
 
 System Logs
 
   2021-05-18T19:26:25Z  [AppRunner] Service creation started.
 `,
-			json: `{"arn":"arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e","status":"RUNNING","createdAt":"2020-01-01T00:00:00Z","updatedAt":"2020-03-01T00:00:00Z","source":{"imageId":"hello"}}` + "\n",
+			json: `{"arn":"arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e","status":"RUNNING","createdAt":"2020-01-01T00:00:00Z","updatedAt":"2020-03-01T00:00:00Z","source":{"imageId":"hello"},"ingress":"private","autoScaling":{"maxConcurrency":50,"minSize":2,"maxSize":10}}` + "\n",
 		},
 	}
 
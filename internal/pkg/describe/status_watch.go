@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	maxTaskTransitionsToDisplay = 10
+	watchTimeFormat             = "15:04:05"
+)
+
+type ecsServiceStatusDescriber interface {
+	describe() (*ecsServiceStatus, error)
+}
+
+// taskTransition is a change in the last status of a task between two refreshes.
+type taskTransition struct {
+	at            time.Time
+	taskID        string
+	from          string // Empty if the task is new.
+	to            string
+	stoppedReason string
+}
+
+// ECSStatusWatcher periodically describes the status of an ECS service.
+// ECSStatusWatcher implements the progress.DynamicRenderer interface to render the latest status in place.
+type ECSStatusWatcher struct {
+	describer ecsServiceStatusDescriber
+	interval  time.Duration
+	now       func() time.Time
+
+	mu          sync.Mutex
+	status      *ecsServiceStatus
+	lastStatus  map[string]string // Last known status of each task by task ID.
+	transitions []taskTransition
+	refreshedAt time.Time
+	refreshErr  error
+
+	done chan struct{}
+}
+
+// NewECSStatusWatcher returns an ECSStatusWatcher that describes the status of the service every interval.
+func NewECSStatusWatcher(d *ecsStatusDescriber, interval time.Duration) *ECSStatusWatcher {
+	return &ECSStatusWatcher{
+		describer: d,
+		interval:  interval,
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+}
+
+// Watch refreshes the status of the service until ctx is canceled.
+// It returns an error only if the status can't be described the first time,
+// later failures are rendered so that a transient error does not stop the watch.
+func (w *ECSStatusWatcher) Watch(ctx context.Context) error {
+	defer close(w.done)
+	if err := w.refresh(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.interval):
+			_ = w.refresh()
+		}
+	}
+}
+
+// Done returns a channel that's closed when the watch stops.
+func (w *ECSStatusWatcher) Done() <-chan struct{} {
+	return w.done
+}
+
+// Render writes the latest status of the service followed by the recent task transitions and service events.
+func (w *ECSStatusWatcher) Render(out io.Writer) (numLines int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	buf := new(bytes.Buffer)
+	if w.status == nil {
+		fmt.Fprintln(buf, "Describing the status of the service...")
+		return w.flush(buf, out)
+	}
+	fmt.Fprintln(buf, color.Faint.Sprintf("Refreshed at %s every %s. Press Ctrl+C to stop.", w.refreshedAt.Format(watchTimeFormat), w.interval))
+	if w.refreshErr != nil {
+		fmt.Fprintln(buf, color.Red.Sprintf("Failed to refresh the status: %v", w.refreshErr))
+	}
+	fmt.Fprintln(buf)
+	buf.WriteString(w.status.HumanString())
+
+	writer := tabwriter.NewWriter(buf, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	if len(w.transitions) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nTask Transitions\n\n"))
+		writer.Flush()
+		for _, t := range w.transitions {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", t.at.Format(watchTimeFormat), shortTaskID(t.taskID), t.humanString())
+		}
+		writer.Flush()
+	}
+	if len(w.status.Events) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRecent Events\n\n"))
+		writer.Flush()
+		for _, event := range w.status.Events {
+			fmt.Fprintf(writer, "  %s\t%s\n", event.CreatedAt.Format(watchTimeFormat), event.Message)
+		}
+		writer.Flush()
+	}
+	return w.flush(buf, out)
+}
+
+func (w *ECSStatusWatcher) flush(buf *bytes.Buffer, out io.Writer) (int, error) {
+	numLines := strings.Count(buf.String(), "\n")
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, err
+	}
+	return numLines, nil
+}
+
+func (w *ECSStatusWatcher) refresh() error {
+	status, err := w.describer.describe()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err != nil {
+		w.refreshErr = err
+		return err
+	}
+	now := w.now()
+	w.recordTransitions(status, now)
+	w.status = status
+	w.refreshedAt = now
+	w.refreshErr = nil
+	return nil
+}
+
+func (w *ECSStatusWatcher) recordTransitions(status *ecsServiceStatus, at time.Time) {
+	isFirstRefresh := w.lastStatus == nil
+	current := make(map[string]string)
+	tasks := append(append([]awsecs.TaskStatus{}, status.DesiredRunningTasks...), status.StoppedTasks...)
+	for _, task := range tasks {
+		current[task.ID] = task.LastStatus
+		if isFirstRefresh {
+			continue
+		}
+		if prev, ok := w.lastStatus[task.ID]; !ok || prev != task.LastStatus {
+			w.transitions = append(w.transitions, taskTransition{
+				at:            at,
+				taskID:        task.ID,
+				from:          prev,
+				to:            task.LastStatus,
+				stoppedReason: task.StoppedReason,
+			})
+		}
+	}
+	if len(w.transitions) > maxTaskTransitionsToDisplay {
+		w.transitions = w.transitions[len(w.transitions)-maxTaskTransitionsToDisplay:]
+	}
+	w.lastStatus = current
+}
+
+func (t taskTransition) humanString() string {
+	from := t.from
+	if from == "" {
+		from = "NEW"
+	}
+	out := fmt.Sprintf("%s → %s", from, taskStatusColor(t.to))
+	if t.stoppedReason != "" && isStoppedTaskStatus(t.to) {
+		out = fmt.Sprintf("%s: %s", out, t.stoppedReason)
+	}
+	return out
+}
+
+func taskStatusColor(status string) string {
+	switch {
+	case status == "RUNNING":
+		return color.Green.Sprint(status)
+	case isStoppedTaskStatus(status):
+		return color.Red.Sprint(status)
+	default:
+		return color.Yellow.Sprint(status)
+	}
+}
+
+func isStoppedTaskStatus(status string) bool {
+	switch status {
+	case "DEACTIVATING", "STOPPING", "DEPROVISIONING", "STOPPED":
+		return true
+	default:
+		return false
+	}
+}
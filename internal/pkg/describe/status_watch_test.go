@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeECSServiceStatusDescriber struct {
+	statuses []*ecsServiceStatus
+	errs     []error
+	calls    int
+}
+
+func (d *fakeECSServiceStatusDescriber) describe() (*ecsServiceStatus, error) {
+	i := d.calls
+	d.calls++
+	if i < len(d.errs) && d.errs[i] != nil {
+		return nil, d.errs[i]
+	}
+	return d.statuses[i], nil
+}
+
+func TestECSStatusWatcher_Watch(t *testing.T) {
+	t.Run("returns an error if the status can't be described the first time", func(t *testing.T) {
+		w := &ECSStatusWatcher{
+			describer: &fakeECSServiceStatusDescriber{
+				errs: []error{errors.New("some error")},
+			},
+			now:  time.Now,
+			done: make(chan struct{}),
+		}
+
+		err := w.Watch(context.Background())
+
+		require.EqualError(t, err, "some error")
+		_, isOpen := <-w.Done()
+		require.False(t, isOpen)
+	})
+	t.Run("stops when the context is canceled", func(t *testing.T) {
+		w := &ECSStatusWatcher{
+			describer: &fakeECSServiceStatusDescriber{
+				statuses: []*ecsServiceStatus{{}},
+			},
+			interval: time.Hour,
+			now:      time.Now,
+			done:     make(chan struct{}),
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := w.Watch(ctx)
+
+		require.NoError(t, err)
+		_, isOpen := <-w.Done()
+		require.False(t, isOpen)
+	})
+}
+
+func TestECSStatusWatcher_Render(t *testing.T) {
+	mockTime := time.Date(2022, 3, 14, 15, 9, 26, 0, time.UTC)
+	w := &ECSStatusWatcher{
+		describer: &fakeECSServiceStatusDescriber{
+			statuses: []*ecsServiceStatus{
+				{
+					DesiredRunningTasks: []awsecs.TaskStatus{
+						{ID: "1111111111111111", LastStatus: "PROVISIONING"},
+						{ID: "2222222222222222", LastStatus: "RUNNING"},
+					},
+				},
+				nil,
+				{
+					DesiredRunningTasks: []awsecs.TaskStatus{
+						{ID: "1111111111111111", LastStatus: "RUNNING"},
+						{ID: "3333333333333333", LastStatus: "PENDING"},
+					},
+					StoppedTasks: []awsecs.TaskStatus{
+						{ID: "2222222222222222", LastStatus: "STOPPED", StoppedReason: "Task failed ELB health checks"},
+					},
+					Events: []awsecs.ServiceEvent{
+						{
+							CreatedAt: mockTime,
+							Message:   "(service my-svc) has started 1 tasks: (task 3333333333333333).",
+						},
+					},
+				},
+			},
+			errs: []error{nil, errors.New("some error")},
+		},
+		interval: 5 * time.Second,
+		now:      func() time.Time { return mockTime },
+		done:     make(chan struct{}),
+	}
+
+	// Before the first refresh.
+	var b strings.Builder
+	nl, err := w.Render(&b)
+	require.NoError(t, err)
+	require.Equal(t, 1, nl)
+	require.Equal(t, "Describing the status of the service...\n", b.String())
+
+	// The first refresh doesn't record any transition.
+	require.NoError(t, w.refresh())
+	b.Reset()
+	_, err = w.Render(&b)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(b.String(), "Refreshed at 15:09:26 every 5s. Press Ctrl+C to stop.\n\n"))
+	require.NotContains(t, b.String(), "Task Transitions")
+
+	// A failed refresh keeps the last status.
+	require.EqualError(t, w.refresh(), "some error")
+	b.Reset()
+	_, err = w.Render(&b)
+	require.NoError(t, err)
+	require.Contains(t, b.String(), "Failed to refresh the status: some error\n")
+
+	// Transitions are recorded between two refreshes.
+	require.NoError(t, w.refresh())
+	b.Reset()
+	nl, err = w.Render(&b)
+	require.NoError(t, err)
+	require.Equal(t, strings.Count(b.String(), "\n"), nl)
+	require.NotContains(t, b.String(), "Failed to refresh the status")
+	require.Contains(t, b.String(), `Task Transitions
+
+  15:09:26  11111111    PROVISIONING → RUNNING
+  15:09:26  33333333    NEW → PENDING
+  15:09:26  22222222    RUNNING → STOPPED: Task failed ELB health checks
+
+Recent Events
+
+  15:09:26  (service my-svc) has started 1 tasks: (task 3333333333333333).
+`)
+}
@@ -66,6 +66,7 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var configs []*ECSServiceConfig
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var permissions []*taskPermission
 	for _, env := range environments {
 		err := d.initClients(env)
 		if err != nil {
@@ -99,6 +100,15 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.svcStackDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for service %s: %w", d.svc, err)
+		}
+		envPermissions, err := flattenPermissions(env, svcOutputs[svcOutputManifestPermissions])
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, envPermissions...)
 	}
 
 	resources := make(map[string][]*stack.Resource)
@@ -123,6 +133,7 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Configurations: configs,
 		Variables:      envVars,
 		Secrets:        secrets,
+		Permissions:    permissions,
 		Resources:      resources,
 
 		environments: environments,
@@ -137,6 +148,7 @@ type workerSvcDesc struct {
 	Configurations ecsConfigurations    `json:"configurations"`
 	Variables      containerEnvVars     `json:"variables"`
 	Secrets        secrets              `json:"secrets,omitempty"`
+	Permissions    taskPermissions      `json:"permissions,omitempty"`
 	Resources      deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string `json:"-"`
@@ -171,6 +183,11 @@ func (w *workerSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Permissions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPermissions\n\n"))
+		writer.Flush()
+		w.Permissions.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
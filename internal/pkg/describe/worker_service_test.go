@@ -112,7 +112,27 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve secrets: some error"),
 		},
-		"success": {
+				"return error if fail to retrieve stack outputs": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.WorkloadTaskCountParamKey:  "1",
+						cfnstack.WorkloadTaskCPUParamKey:    "256",
+						cfnstack.WorkloadTaskMemoryParamKey: "512",
+					}, nil),
+					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+						OperatingSystem: "LINUX",
+						Architecture:    "X86_64",
+					}, nil),
+					m.ecsDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get stack outputs for service jobs: some error"),
+		},
+"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
@@ -142,6 +162,9 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ManifestPermissions": `[{"actions":["sqs:SendMessage"],"resources":["arn:aws:sqs:us-west-2:123456789012:orders"]}]`,
+					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -166,6 +189,7 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SECRET",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -185,6 +209,7 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsDescriber.EXPECT().Secrets().Return(
 						nil, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
@@ -281,6 +306,13 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 						ValueFrom:   "SECRET",
 					},
 				},
+				Permissions: []*taskPermission{
+					{
+						Environment: "test",
+						Actions:     []string{"sqs:SendMessage"},
+						Resources:   []string{"arn:aws:sqs:us-west-2:123456789012:orders"},
+					},
+				},
 				Resources: map[string][]*stack.Resource{
 					"test": {
 						{
@@ -380,6 +412,13 @@ Secrets
   A_SECRET               container  prod         parameter/SECRET
   GITHUB_WEBHOOK_SECRET    "        test         parameter/GH_WEBHOOK_SECRET
 
+Permissions
+
+  Environment  Actions                           Resources
+  -----------  -------                           ---------
+  test         sqs:SendMessage, sqs:GetQueueUrl  arn:aws:sqs:us-west-2:123456789012:orders
+    "            "                               arn:aws:sqs:us-west-2:123456789012:payments
+
 Resources
 
   test
@@ -388,7 +427,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Worker Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"-\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"-\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"A_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SECRET\"},{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Worker Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"-\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"-\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"A_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SECRET\"},{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"}],\"permissions\":[{\"environment\":\"test\",\"actions\":[\"sqs:SendMessage\",\"sqs:GetQueueUrl\"],\"resources\":[\"arn:aws:sqs:us-west-2:123456789012:orders\",\"arn:aws:sqs:us-west-2:123456789012:payments\"]}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -448,6 +487,13 @@ Resources
 					ValueFrom:   "SECRET",
 				},
 			}
+			permissions := []*taskPermission{
+				{
+					Environment: "test",
+					Actions:     []string{"sqs:SendMessage", "sqs:GetQueueUrl"},
+					Resources:   []string{"arn:aws:sqs:us-west-2:123456789012:orders", "arn:aws:sqs:us-west-2:123456789012:payments"},
+				},
+			}
 			resources := map[string][]*stack.Resource{
 				"test": {
 					{
@@ -469,6 +515,7 @@ Resources
 				App:            "my-app",
 				Variables:      envVars,
 				Secrets:        secrets,
+				Permissions:    permissions,
 				Resources:      resources,
 				environments:   []string{"test", "prod"},
 			}
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	c "github.com/fatih/color"
+)
+
+const shortTaskIDLength = 8
+
+// labelColors are the colors cycled through to distinguish the workloads in the aggregated logs.
+var labelColors = []*c.Color{color.HiCyan, color.Magenta, color.DullGreen, color.HiBlue, color.BoldFgYellow, color.DullBlue}
+
+// AggregatedClient retrieves and interleaves the logs of multiple workloads.
+type AggregatedClient struct {
+	workloads []*workloadLogs
+	labelEnvs bool // Whether the workloads span more than one environment.
+	w         io.Writer
+}
+
+type workloadLogs struct {
+	name   string
+	env    string
+	color  *c.Color
+	client *ServiceClient
+}
+
+// NewAggregatedClient returns an AggregatedClient for the workloads described by each config.
+func NewAggregatedClient(configs []*NewServiceLogsConfig) (*AggregatedClient, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("no workloads to retrieve logs from")
+	}
+	client := &AggregatedClient{
+		w: log.OutputWriter,
+	}
+	for i, config := range configs {
+		svcClient, err := NewServiceClient(config)
+		if err != nil {
+			return nil, fmt.Errorf("new logs client for %s: %w", config.Svc, err)
+		}
+		client.workloads = append(client.workloads, &workloadLogs{
+			name:   config.Svc,
+			env:    config.Env,
+			color:  labelColors[i%len(labelColors)],
+			client: svcClient,
+		})
+		if config.Env != configs[0].Env {
+			client.labelEnvs = true
+		}
+	}
+	return client, nil
+}
+
+// WriteLogEvents writes the logs of all the workloads in timestamp order.
+func (a *AggregatedClient) WriteLogEvents(opts WriteLogEventsOpts) error {
+	if opts.Query != "" {
+		return errors.New("cannot run a query against the logs of multiple workloads")
+	}
+	logEventsOpts := make([]cloudwatchlogs.LogEventsOpts, len(a.workloads))
+	for i, wkld := range a.workloads {
+		logEventsOpts[i] = wkld.client.logEventsOpts(opts)
+	}
+	for {
+		var events []*labeledEvent
+		var lastEventTimeSet bool
+		for i, wkld := range a.workloads {
+			out, err := wkld.client.eventsGetter.LogEvents(logEventsOpts[i])
+			if err != nil {
+				return fmt.Errorf("get log events for log group %s: %w", wkld.client.logGroupName, err)
+			}
+			for _, event := range out.Events {
+				events = append(events, &labeledEvent{
+					Workload: wkld.name,
+					Env:      wkld.env,
+					Event:    event,
+					color:    wkld.color,
+					labelEnv: a.labelEnvs,
+				})
+			}
+			if out.StreamLastEventTime != nil {
+				lastEventTimeSet = true
+				logEventsOpts[i].StreamLastEventTime = out.StreamLastEventTime
+				logEventsOpts[i].SeenEventIDs = out.SeenEventIDs
+			}
+		}
+		sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+		if limit := int(aws.Int64Value(opts.limit())); limit != 0 && len(events) > limit {
+			events = events[len(events)-limit:] // Only keep the last N events across all workloads.
+		}
+		if err := opts.OnEvents(a.w, labeledEventsToHumanJSONStringers(events)); err != nil {
+			return err
+		}
+		if !opts.Follow {
+			return nil
+		}
+		// for unit test.
+		if !lastEventTimeSet {
+			return nil
+		}
+		time.Sleep(cloudwatchlogs.SleepDuration)
+	}
+}
+
+// labeledEvent is a log event labeled with the workload that emitted it.
+type labeledEvent struct {
+	Workload string `json:"workload"`
+	Env      string `json:"environment,omitempty"`
+	*cloudwatchlogs.Event
+
+	color    *c.Color
+	labelEnv bool
+}
+
+// JSONString returns the stringified labeled log event with json format.
+func (e *labeledEvent) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal a log event: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the log event prefixed with a "workload/task" label,
+// or an "environment/workload/task" label if the logs span multiple environments.
+func (e *labeledEvent) HumanString() string {
+	return fmt.Sprintf("%s %s\n", e.color.Sprint(e.label()), e.ColoredMessage())
+}
+
+func (e *labeledEvent) label() string {
+	// Log stream names end with the ID of the task, or instance for App Runner services.
+	id := e.LogStreamName[strings.LastIndex(e.LogStreamName, "/")+1:]
+	if len(id) > shortTaskIDLength {
+		id = id[:shortTaskIDLength]
+	}
+	if e.labelEnv {
+		return fmt.Sprintf("%s/%s/%s", e.Env, e.Workload, id)
+	}
+	return fmt.Sprintf("%s/%s", e.Workload, id)
+}
+
+func labeledEventsToHumanJSONStringers(events []*labeledEvent) []HumanJSONStringer {
+	logStringers := make([]HumanJSONStringer, len(events))
+	for ind, event := range events {
+		logStringers[ind] = event
+	}
+	return logStringers
+}
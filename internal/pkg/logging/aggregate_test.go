@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregatedClient_WriteLogEvents(t *testing.T) {
+	mockCurrentTimestamp := time.Date(2020, 11, 23, 0, 0, 0, 0, time.UTC)
+	frontendEvents := []*cloudwatchlogs.Event{
+		{
+			LogStreamName: "copilot/frontend/2a3b4c5d6e7f",
+			Message:       "GET /api/orders",
+			Timestamp:     1,
+		},
+		{
+			LogStreamName: "copilot/frontend/2a3b4c5d6e7f",
+			Message:       "200 GET /api/orders",
+			Timestamp:     4,
+		},
+	}
+	apiEvents := []*cloudwatchlogs.Event{
+		{
+			LogStreamName: "copilot/api/9f8e7d6c5b4a",
+			Message:       "listing orders",
+			Timestamp:     2,
+		},
+		{
+			LogStreamName: "copilot/api/9f8e7d6c5b4a",
+			Message:       "ERROR order not found",
+			Timestamp:     3,
+		},
+	}
+	testCases := map[string]struct {
+		follow     bool
+		limit      *int64
+		jsonOutput bool
+		labelEnvs  bool
+		query      string
+		setupMocks func(frontend, api *mocks.MocklogGetter)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"returns error if a query is set": {
+			query:      "fields @message",
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {},
+
+			wantedError: errors.New("cannot run a query against the logs of multiple workloads"),
+		},
+		"returns error if fail to get log events": {
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get log events for log group /copilot/app-test-api: some error"),
+		},
+		"interleaves events in timestamp order": {
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, "/copilot/app-test-frontend", param.LogGroup)
+						require.Equal(t, aws.Int64(10), param.Limit)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{Events: frontendEvents}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, "/copilot/app-test-api", param.LogGroup)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{Events: apiEvents}, nil)
+			},
+
+			wantedContent: `frontend/2a3b4c5d GET /api/orders
+api/9f8e7d6c listing orders
+api/9f8e7d6c ERROR order not found
+frontend/2a3b4c5d 200 GET /api/orders
+`,
+		},
+		"labels events with their environment if the workloads span environments": {
+			labelEnvs: true,
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{Events: frontendEvents[:1]}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{Events: apiEvents[:1]}, nil)
+			},
+
+			wantedContent: `test/frontend/2a3b4c5d GET /api/orders
+prod/api/9f8e7d6c listing orders
+`,
+		},
+		"keeps the latest events across workloads with json output": {
+			limit:      aws.Int64(1),
+			jsonOutput: true,
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{Events: frontendEvents[:1]}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{Events: apiEvents}, nil)
+			},
+
+			wantedContent: "{\"workload\":\"api\",\"environment\":\"prod\",\"logStreamName\":\"copilot/api/9f8e7d6c5b4a\",\"ingestionTime\":0,\"message\":\"ERROR order not found\",\"timestamp\":3}\n",
+		},
+		"follows log events of each workload": {
+			follow: true,
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				gomock.InOrder(
+					frontend.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, aws.Int64(mockCurrentTimestamp.UnixMilli()), param.StartTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events:              frontendEvents[:1],
+							StreamLastEventTime: map[string]int64{"copilot/frontend/2a3b4c5d6e7f": 1},
+						}, nil),
+					frontend.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, map[string]int64{"copilot/frontend/2a3b4c5d6e7f": 1}, param.StreamLastEventTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{Events: frontendEvents[1:]}, nil),
+				)
+				gomock.InOrder(
+					api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil),
+					api.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Nil(t, param.StreamLastEventTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{}, nil),
+				)
+			},
+
+			wantedContent: `frontend/2a3b4c5d GET /api/orders
+frontend/2a3b4c5d 200 GET /api/orders
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFrontendGetter := mocks.NewMocklogGetter(ctrl)
+			mockAPIGetter := mocks.NewMocklogGetter(ctrl)
+			tc.setupMocks(mockFrontendGetter, mockAPIGetter)
+
+			now := func() time.Time {
+				return mockCurrentTimestamp
+			}
+			b := &bytes.Buffer{}
+			client := &AggregatedClient{
+				workloads: []*workloadLogs{
+					{
+						name:  "frontend",
+						env:   "test",
+						color: color.HiCyan,
+						client: &ServiceClient{
+							logGroupName:        "/copilot/app-test-frontend",
+							logStreamNamePrefix: "copilot/frontend",
+							eventsGetter:        mockFrontendGetter,
+							now:                 now,
+						},
+					},
+					{
+						name:  "api",
+						env:   "prod",
+						color: color.Magenta,
+						client: &ServiceClient{
+							logGroupName:        "/copilot/app-test-api",
+							logStreamNamePrefix: "copilot/api",
+							eventsGetter:        mockAPIGetter,
+							now:                 now,
+						},
+					},
+				},
+				labelEnvs: tc.labelEnvs,
+				w:         b,
+			}
+
+			// WHEN
+			logWriter := WriteHumanLogs
+			if tc.jsonOutput {
+				logWriter = WriteJSONLogs
+			}
+			err := client.WriteLogEvents(WriteLogEventsOpts{
+				Follow:   tc.follow,
+				Limit:    tc.limit,
+				Query:    tc.query,
+				OnEvents: logWriter,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 const (
 	defaultServiceLogsLimit = 10
+	defaultQueryTimeRange   = time.Hour
 
 	fmtSvclogGroupName    = "/copilot/%s-%s-%s"
 	fmtSvcLogStreamPrefix = "copilot/%s"
@@ -28,6 +29,7 @@ const (
 
 type logGetter interface {
 	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+	Query(opts cloudwatchlogs.QueryOpts) (*cloudwatchlogs.QueryResults, error)
 }
 
 // ServiceClient retrieves the logs of an Amazon ECS or AppRunner service.
@@ -47,6 +49,12 @@ type WriteLogEventsOpts struct {
 	StartTime *int64
 	EndTime   *int64
 	TaskIDs   []string
+	// Filter is a CloudWatch Logs filter pattern that log events must match.
+	Filter string
+	// Container is the name of the container to retrieve logs from. If empty, logs from all containers are retrieved.
+	Container string
+	// Query is a CloudWatch Logs Insights query to run against the log group instead of retrieving log events.
+	Query string
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 }
@@ -150,15 +158,13 @@ func newAppRunnerServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, erro
 
 // WriteLogEvents writes service logs.
 func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
-	logEventsOpts := cloudwatchlogs.LogEventsOpts{
-		LogGroup:  s.logGroupName,
-		Limit:     opts.limit(),
-		EndTime:   opts.EndTime,
-		StartTime: opts.startTime(s.now),
+	if opts.Container != "" && s.logStreamNamePrefix == "" {
+		return fmt.Errorf("cannot select container %s for logs in log group %s", opts.Container, s.logGroupName)
 	}
-	if opts.TaskIDs != nil {
-		logEventsOpts.LogStreams = s.logStreams(opts.TaskIDs)
+	if opts.Query != "" {
+		return s.writeQueryResults(opts)
 	}
+	logEventsOpts := s.logEventsOpts(opts)
 	for {
 		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
 		if err != nil {
@@ -175,13 +181,58 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 			return nil
 		}
 		logEventsOpts.StreamLastEventTime = logEventsOutput.StreamLastEventTime
+		logEventsOpts.SeenEventIDs = logEventsOutput.SeenEventIDs
 		time.Sleep(cloudwatchlogs.SleepDuration)
 	}
 }
 
-func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
+func (s *ServiceClient) logEventsOpts(opts WriteLogEventsOpts) cloudwatchlogs.LogEventsOpts {
+	logEventsOpts := cloudwatchlogs.LogEventsOpts{
+		LogGroup:      s.logGroupName,
+		Limit:         opts.limit(),
+		EndTime:       opts.EndTime,
+		StartTime:     opts.startTime(s.now),
+		FilterPattern: opts.Filter,
+	}
+	if opts.TaskIDs != nil || opts.Container != "" {
+		logEventsOpts.LogStreams = s.logStreams(opts.Container, opts.TaskIDs)
+	}
+	return logEventsOpts
+}
+
+func (s *ServiceClient) writeQueryResults(opts WriteLogEventsOpts) error {
+	endTime := s.now().UnixMilli()
+	if opts.EndTime != nil {
+		endTime = aws.Int64Value(opts.EndTime)
+	}
+	startTime := s.now().Add(-defaultQueryTimeRange).UnixMilli()
+	if opts.StartTime != nil {
+		startTime = aws.Int64Value(opts.StartTime)
+	}
+	results, err := s.eventsGetter.Query(cloudwatchlogs.QueryOpts{
+		LogGroup:  s.logGroupName,
+		Query:     opts.Query,
+		Limit:     opts.Limit,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return fmt.Errorf("query log group %s: %w", s.logGroupName, err)
+	}
+	return opts.OnEvents(s.w, []HumanJSONStringer{results})
+}
+
+func (s *ServiceClient) logStreams(container string, taskIDs []string) (logStreamName []string) {
+	prefix := s.logStreamNamePrefix
+	if container != "" {
+		prefix = fmt.Sprintf(fmtSvcLogStreamPrefix, container)
+	}
+	if len(taskIDs) == 0 {
+		// Log stream names are formatted as "copilot/<container>/<task ID>".
+		return []string{fmt.Sprintf("%s/", prefix)}
+	}
 	for _, taskID := range taskIDs {
-		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
+		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", prefix, taskID))
 	}
 	return
 }
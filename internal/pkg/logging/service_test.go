@@ -64,6 +64,9 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		startTime  *int64
 		jsonOutput bool
 		taskIDs    []string
+		container  string
+		filter     string
+		query      string
 		setupMocks func(mocks serviceLogsMocks)
 
 		wantedError   error
@@ -161,6 +164,65 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error"
 firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
 `,
 		},
+		"success with container and filter pattern": {
+			container: "nginx",
+			filter:    "ERROR",
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"copilot/nginx/"}, param.LogStreams)
+							require.Equal(t, "ERROR", param.FilterPattern)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: logEvents[1:2],
+						}, nil),
+				)
+			},
+
+			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
+`,
+		},
+		"success with container and task IDs": {
+			container: "nginx",
+			taskIDs:   []string{"mockTaskID1"},
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"copilot/nginx/mockTaskID1"}, param.LogStreams)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{}, nil),
+				)
+			},
+		},
+		"failed to run query": {
+			query: "fields @message",
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().Query(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("query log group mockLogGroup: some error"),
+		},
+		"success with query": {
+			query:      "fields @message",
+			jsonOutput: true,
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().Query(cloudwatchlogs.QueryOpts{
+					LogGroup:  mockLogGroupName,
+					Query:     "fields @message",
+					StartTime: mockCurrentTimestamp.Add(-time.Hour).UnixMilli(),
+					EndTime:   mockCurrentTimestamp.UnixMilli(),
+				}).Return(&cloudwatchlogs.QueryResults{
+					Fields: []string{"@message"},
+					Rows: []map[string]string{
+						{"@message": "hello"},
+					},
+				}, nil)
+			},
+
+			wantedContent: "{\"@message\":\"hello\"}\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -197,6 +259,9 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 				TaskIDs:   tc.taskIDs,
 				Limit:     tc.limit,
 				StartTime: tc.startTime,
+				Container: tc.container,
+				Filter:    tc.filter,
+				Query:     tc.query,
 				OnEvents:  logWriter,
 			})
 
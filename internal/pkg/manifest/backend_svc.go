@@ -32,7 +32,10 @@ type BackendServiceConfig struct {
 	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	Network          NetworkConfig             `yaml:"network"`
 	PublishConfig    PublishConfig             `yaml:"publish"`
+	Observability    Observability             `yaml:"observability"`
+	Permissions      []Permission              `yaml:"permissions"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Capacity         CapacityProviders         `yaml:"capacity_providers"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -105,6 +108,11 @@ func (s *BackendService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
 }
 
+// ContainerSecrets returns the secrets injected into the main container of the service.
+func (s *BackendService) ContainerSecrets() map[string]Secret {
+	return s.TaskConfig.Secrets
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -5,6 +5,8 @@
 package manifest
 
 import (
+	"regexp"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/imdario/mergo"
@@ -47,6 +49,8 @@ type ScheduledJobConfig struct {
 	JobFailureHandlerConfig `yaml:",inline"`
 	Network                 NetworkConfig  `yaml:"network"`
 	PublishConfig           PublishConfig  `yaml:"publish"`
+	Observability           Observability  `yaml:"observability"`
+	Permissions             []Permission   `yaml:"permissions"`
 	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
 }
 
@@ -55,6 +59,14 @@ type JobTriggerConfig struct {
 	Schedule *string `yaml:"schedule"`
 }
 
+var awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Validates that an expression is of the form rate(xyz) or cron(abc).
+
+// IsAWSScheduleExpression returns true if the schedule uses the rate(xyz) or cron(abc) syntax of AWS.
+// These expressions are passed through as-is and validated server-side.
+func IsAWSScheduleExpression(schedule string) bool {
+	return awsScheduleRegexp.MatchString(schedule)
+}
+
 // JobFailureHandlerConfig represents the error handling configuration for the job.
 type JobFailureHandlerConfig struct {
 	Timeout *string `yaml:"timeout"`
@@ -143,6 +155,11 @@ func (j *ScheduledJob) EnvFile() string {
 	return aws.StringValue(j.TaskConfig.EnvFile)
 }
 
+// ContainerSecrets returns the secrets injected into the main container of the job.
+func (j *ScheduledJob) ContainerSecrets() map[string]Secret {
+	return j.TaskConfig.Secrets
+}
+
 // newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
 func newDefaultScheduledJob() *ScheduledJob {
 	return &ScheduledJob{
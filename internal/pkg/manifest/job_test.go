@@ -423,3 +423,33 @@ func TestScheduledJob_Publish(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAWSScheduleExpression(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted bool
+	}{
+		"rate expression": {
+			in:     "rate(5 minutes)",
+			wanted: true,
+		},
+		"cron expression": {
+			in:     "cron(0 20 ? * MON-FRI *)",
+			wanted: true,
+		},
+		"standard cron expression": {
+			in:     "0 20 * * 1-5",
+			wanted: false,
+		},
+		"preset schedule": {
+			in:     "@daily",
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, IsAWSScheduleExpression(tc.in))
+		})
+	}
+}
@@ -29,6 +29,14 @@ const (
 	GRPCProtocol = "gRPC" // GRPCProtocol is the HTTP protocol version for gRPC.
 )
 
+// Routing policies for a global service.
+const (
+	GlobalRoutingLatency  = "latency"
+	GlobalRoutingFailover = "failover"
+)
+
+var globalRoutingPolicies = []string{GlobalRoutingLatency, GlobalRoutingFailover}
+
 var (
 	errUnmarshalHealthCheckArgs = errors.New("can't unmarshal healthcheck field into string or compose-style map")
 )
@@ -60,8 +68,12 @@ type LoadBalancedWebServiceConfig struct {
 	Sidecars         map[string]*SidecarConfig        `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	Network          NetworkConfig                    `yaml:"network"`
 	PublishConfig    PublishConfig                    `yaml:"publish"`
+	Observability    Observability                    `yaml:"observability"`
+	Permissions      []Permission                     `yaml:"permissions"`
 	TaskDefOverrides []OverrideRule                   `yaml:"taskdef_overrides"`
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
+	Global           GlobalServiceConfig              `yaml:"global"`
+	Capacity         CapacityProviders                `yaml:"capacity_providers"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -177,6 +189,11 @@ func (s *LoadBalancedWebService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
 }
 
+// ContainerSecrets returns the secrets injected into the main container of the service.
+func (s *LoadBalancedWebService) ContainerSecrets() map[string]Secret {
+	return s.TaskConfig.Secrets
+}
+
 // HasAliases returns true if the Load-Balanced Web Service uses aliases, either for ALB or NLB.
 func (s *LoadBalancedWebService) HasAliases() bool {
 	return !s.RoutingRule.Alias.IsEmpty() || !s.NLBConfig.Aliases.IsEmpty()
@@ -244,6 +261,16 @@ func (r *RoutingRuleConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the RoutingRuleConfigOrBool
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (r RoutingRuleConfigOrBool) MarshalYAML() (interface{}, error) {
+	if !r.RoutingRuleConfiguration.isEmpty() {
+		return r.RoutingRuleConfiguration, nil
+	}
+	return r.Enabled, nil
+}
+
 // RoutingRuleConfiguration holds the path to route requests to the service.
 type RoutingRuleConfiguration struct {
 	Path                *string                 `yaml:"path"`
@@ -289,6 +316,45 @@ func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
 		c.SSLPolicy == nil && c.Stickiness == nil && c.Aliases.IsEmpty()
 }
 
+// GlobalServiceConfig holds the configuration to serve a single alias from the service
+// deployed in environments across multiple regions.
+type GlobalServiceConfig struct {
+	Alias        *string  `yaml:"alias"`
+	Routing      *string  `yaml:"routing"`
+	Primary      *string  `yaml:"primary"`
+	Environments []string `yaml:"environments"`
+}
+
+// IsEmpty returns true if the global service configuration is not set.
+func (g *GlobalServiceConfig) IsEmpty() bool {
+	return g.Alias == nil && g.Routing == nil && g.Primary == nil && g.Environments == nil
+}
+
+// RoutingPolicy returns the Route 53 routing policy of the global service, defaulting to latency-based routing.
+func (g *GlobalServiceConfig) RoutingPolicy() string {
+	if g.Routing == nil {
+		return GlobalRoutingLatency
+	}
+	return aws.StringValue(g.Routing)
+}
+
+// PrimaryEnv returns the environment that serves the global alias while it's healthy with failover routing,
+// defaulting to the first environment.
+func (g *GlobalServiceConfig) PrimaryEnv() string {
+	if g.Primary != nil {
+		return aws.StringValue(g.Primary)
+	}
+	if len(g.Environments) == 0 {
+		return ""
+	}
+	return g.Environments[0]
+}
+
+// IncludesEnv returns true if the environment serves traffic for the global alias.
+func (g *GlobalServiceConfig) IncludesEnv(env string) bool {
+	return contains(env, g.Environments)
+}
+
 // IPNet represents an IP network string. For example: 10.1.0.0/16
 type IPNet string
 
@@ -311,6 +377,13 @@ func (e *Alias) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the Alias
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (e Alias) MarshalYAML() (interface{}, error) {
+	return marshalYAMLFromStringSliceOrString(stringSliceOrString(e)), nil
+}
+
 // ToStringSlice converts an Alias to a slice of string using shell-style rules.
 func (e *Alias) ToStringSlice() ([]string, error) {
 	out, err := toStringSlice((*stringSliceOrString)(e))
@@ -1587,3 +1587,34 @@ func TestAlias_ToString(t *testing.T) {
 		})
 	}
 }
+
+func TestGlobalServiceConfig_PrimaryEnv(t *testing.T) {
+	testCases := map[string]struct {
+		in     GlobalServiceConfig
+		wanted string
+	}{
+		"empty": {},
+		"defaults to the first environment": {
+			in: GlobalServiceConfig{
+				Environments: []string{"us", "eu"},
+			},
+			wanted: "us",
+		},
+		"configured primary": {
+			in: GlobalServiceConfig{
+				Primary:      stringP("eu"),
+				Environments: []string{"us", "eu"},
+			},
+			wanted: "eu",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := tc.in.PrimaryEnv()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
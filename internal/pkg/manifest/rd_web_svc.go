@@ -33,15 +33,7 @@ type RequestDrivenWebServiceConfig struct {
 	PublishConfig                     PublishConfig                        `yaml:"publish"`
 	Network                           RequestDrivenWebServiceNetworkConfig `yaml:"network"`
 	Observability                     Observability                        `yaml:"observability"`
-}
-
-// Observability holds configuration for observability to the service.
-type Observability struct {
-	Tracing *string `yaml:"tracing"`
-}
-
-func (o *Observability) isEmpty() bool {
-	return o.Tracing == nil
+	Scaling                           AppRunnerScalingConfig               `yaml:"scaling"`
 }
 
 // ImageWithPort represents a container image with an exposed port.
@@ -50,6 +42,14 @@ type ImageWithPort struct {
 	Port  *uint16 `yaml:"port"`
 }
 
+// MarshalYAML implements the yaml.Marshaler (v3) interface to marshal the inlined image fields.
+func (i ImageWithPort) MarshalYAML() (interface{}, error) {
+	return struct {
+		marshalableImage `yaml:",inline"`
+		Port             *uint16 `yaml:"port"`
+	}{marshalableImage(i.Image), i.Port}, nil
+}
+
 // RequestDrivenWebServiceNetworkConfig represents options for network connection to AWS resources for a Request-Driven Web Service.
 type RequestDrivenWebServiceNetworkConfig struct {
 	VPC rdwsVpcConfig `yaml:"vpc"`
@@ -64,17 +64,31 @@ func (c *RequestDrivenWebServiceNetworkConfig) IsEmpty() bool {
 type RequestDrivenWebServicePlacement Placement
 
 type rdwsVpcConfig struct {
-	Placement *RequestDrivenWebServicePlacement `yaml:"placement"`
+	Placement      *RequestDrivenWebServicePlacement `yaml:"placement"`
+	SecurityGroups []string                          `yaml:"security_groups"`
 }
 
 func (c *rdwsVpcConfig) isEmpty() bool {
-	return c.Placement == nil
+	return c.Placement == nil && c.SecurityGroups == nil
 }
 
 // RequestDrivenWebServiceHttpConfig represents options for configuring http.
 type RequestDrivenWebServiceHttpConfig struct {
 	HealthCheckConfiguration HealthCheckArgsOrString `yaml:"healthcheck"`
 	Alias                    *string                 `yaml:"alias"`
+	Private                  *bool                   `yaml:"private"`
+}
+
+// AppRunnerScalingConfig represents the auto scaling configuration of an App Runner service.
+type AppRunnerScalingConfig struct {
+	MaxConcurrency *int `yaml:"max_concurrency"`
+	MinSize        *int `yaml:"min_size"`
+	MaxSize        *int `yaml:"max_size"`
+}
+
+// IsEmpty returns true if none of the auto scaling fields are set.
+func (c *AppRunnerScalingConfig) IsEmpty() bool {
+	return c.MaxConcurrency == nil && c.MinSize == nil && c.MaxSize == nil
 }
 
 // AppRunnerInstanceConfig contains the instance configuration properties for an App Runner service.
@@ -18,11 +18,12 @@ var (
 type Storage struct {
 	Ephemeral *int               `yaml:"ephemeral"`
 	Volumes   map[string]*Volume `yaml:"volumes"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+	EnvAddons []string           `yaml:"env_addons"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *Storage) IsEmpty() bool {
-	return s.Ephemeral == nil && s.Volumes == nil
+	return s.Ephemeral == nil && s.Volumes == nil && s.EnvAddons == nil
 }
 
 // Volume is an abstraction which merges the MountPoint and Volumes concepts from the ECS Task Definition
@@ -112,6 +113,16 @@ func (e *EFSConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the EFSConfigOrBool
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (e EFSConfigOrBool) MarshalYAML() (interface{}, error) {
+	if !e.Advanced.IsEmpty() {
+		return e.Advanced, nil
+	}
+	return e.Enabled, nil
+}
+
 // UseManagedFS returns true if the user has specified EFS as a bool, or has only specified UID and GID.
 func (e *EFSConfigOrBool) UseManagedFS() bool {
 	// Respect explicitly enabled or disabled value first.
@@ -264,6 +264,11 @@ func TestStorage_IsEmpty(t *testing.T) {
 				},
 			},
 		},
+		"non empty storage with env addons": {
+			in: Storage{
+				EnvAddons: []string{"bucket"},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -81,6 +81,16 @@ func (r *Range) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the Range
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (r Range) MarshalYAML() (interface{}, error) {
+	if !r.RangeConfig.IsEmpty() {
+		return r.RangeConfig, nil
+	}
+	return r.Value, nil
+}
+
 // IntRangeBand is a number range with maximum and minimum values.
 type IntRangeBand string
 
@@ -141,7 +151,7 @@ func (c *Count) UnmarshalYAML(value *yaml.Node) error {
 	if c.AdvancedCount.Spot != nil && (c.AdvancedCount.hasAutoscaling()) {
 		return &errFieldMutualExclusive{
 			firstField:  "spot",
-			secondField: "range/cpu_percentage/memory_percentage/requests/response_time/queue_delay",
+			secondField: "range/cpu_percentage/memory_percentage/requests/response_time/queue_delay/schedules/step_scaling",
 		}
 	}
 
@@ -156,6 +166,16 @@ func (c *Count) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the Count
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (c Count) MarshalYAML() (interface{}, error) {
+	if !c.AdvancedCount.IsEmpty() {
+		return c.AdvancedCount, nil
+	}
+	return c.Value, nil
+}
+
 // IsEmpty returns whether Count is empty.
 func (c *Count) IsEmpty() bool {
 	return c.Value == nil && c.AdvancedCount.IsEmpty()
@@ -183,13 +203,15 @@ type Percentage int
 // AdvancedCount represents the configurable options for Auto Scaling as well as
 // Capacity configuration (spot).
 type AdvancedCount struct {
-	Spot         *int           `yaml:"spot"` // mutually exclusive with other fields
-	Range        Range          `yaml:"range"`
-	CPU          *Percentage    `yaml:"cpu_percentage"`
-	Memory       *Percentage    `yaml:"memory_percentage"`
-	Requests     *int           `yaml:"requests"`
-	ResponseTime *time.Duration `yaml:"response_time"`
-	QueueScaling QueueScaling   `yaml:"queue_delay"`
+	Spot         *int               `yaml:"spot"` // mutually exclusive with other fields
+	Range        Range              `yaml:"range"`
+	CPU          *Percentage        `yaml:"cpu_percentage"`
+	Memory       *Percentage        `yaml:"memory_percentage"`
+	Requests     *int               `yaml:"requests"`
+	ResponseTime *time.Duration     `yaml:"response_time"`
+	QueueScaling QueueScaling       `yaml:"queue_delay"`
+	Schedules    []ScheduledScaling `yaml:"schedules"`
+	StepScaling  []StepScaling      `yaml:"step_scaling"`
 
 	workloadType string
 }
@@ -197,7 +219,8 @@ type AdvancedCount struct {
 // IsEmpty returns whether AdvancedCount is empty.
 func (a *AdvancedCount) IsEmpty() bool {
 	return a.Range.IsEmpty() && a.CPU == nil && a.Memory == nil &&
-		a.Requests == nil && a.ResponseTime == nil && a.Spot == nil && a.QueueScaling.IsEmpty()
+		a.Requests == nil && a.ResponseTime == nil && a.Spot == nil && a.QueueScaling.IsEmpty() &&
+		len(a.Schedules) == 0 && len(a.StepScaling) == 0
 }
 
 // IgnoreRange returns whether desiredCount is specified on spot capacity
@@ -212,26 +235,27 @@ func (a *AdvancedCount) hasAutoscaling() bool {
 func (a *AdvancedCount) validScalingFields() []string {
 	switch a.workloadType {
 	case LoadBalancedWebServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "schedules", "step_scaling"}
 	case BackendServiceType:
-		return []string{"cpu_percentage", "memory_percentage"}
+		return []string{"cpu_percentage", "memory_percentage", "schedules", "step_scaling"}
 	case WorkerServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "queue_delay"}
+		return []string{"cpu_percentage", "memory_percentage", "queue_delay", "schedules", "step_scaling"}
 	default:
 		return nil
 	}
 }
 
 func (a *AdvancedCount) hasScalingFieldsSet() bool {
+	hasCustomScaling := len(a.Schedules) != 0 || len(a.StepScaling) != 0
 	switch a.workloadType {
 	case LoadBalancedWebServiceType:
-		return a.CPU != nil || a.Memory != nil || a.Requests != nil || a.ResponseTime != nil
+		return a.CPU != nil || a.Memory != nil || a.Requests != nil || a.ResponseTime != nil || hasCustomScaling
 	case BackendServiceType:
-		return a.CPU != nil || a.Memory != nil
+		return a.CPU != nil || a.Memory != nil || hasCustomScaling
 	case WorkerServiceType:
-		return a.CPU != nil || a.Memory != nil || !a.QueueScaling.IsEmpty()
+		return a.CPU != nil || a.Memory != nil || !a.QueueScaling.IsEmpty() || hasCustomScaling
 	default:
-		return a.CPU != nil || a.Memory != nil || a.Requests != nil || a.ResponseTime != nil || !a.QueueScaling.IsEmpty()
+		return a.CPU != nil || a.Memory != nil || a.Requests != nil || a.ResponseTime != nil || !a.QueueScaling.IsEmpty() || hasCustomScaling
 	}
 }
 
@@ -242,6 +266,8 @@ func (a *AdvancedCount) unsetAutoscaling() {
 	a.Requests = nil
 	a.ResponseTime = nil
 	a.QueueScaling = QueueScaling{}
+	a.Schedules = nil
+	a.StepScaling = nil
 }
 
 // QueueScaling represents the configuration to scale a service based on a SQS queue.
@@ -265,6 +291,58 @@ func (qs *QueueScaling) AcceptableBacklogPerTask() (int, error) {
 	return int(v), nil
 }
 
+// Comparison operators of a step scaling alarm.
+const (
+	StepScalingComparisonAbove = "above"
+	StepScalingComparisonBelow = "below"
+)
+
+var stepScalingComparisons = []string{StepScalingComparisonAbove, StepScalingComparisonBelow}
+
+// ScheduledScaling represents a scheduled action that changes the capacity bounds of the service.
+type ScheduledScaling struct {
+	Schedule *string `yaml:"schedule"` // Cron expression, preset, or AWS schedule expression, like the "schedule" of a Scheduled Job.
+	Timezone *string `yaml:"timezone"`
+	Min      *int    `yaml:"min"`
+	Max      *int    `yaml:"max"`
+}
+
+// StepScaling represents a scaling policy that adjusts the number of tasks in steps
+// whenever a CloudWatch alarm on an arbitrary metric goes off.
+type StepScaling struct {
+	Metric            ScalingMetric  `yaml:"metric"`
+	Comparison        *string        `yaml:"comparison"`
+	Threshold         *float64       `yaml:"threshold"`
+	Period            *time.Duration `yaml:"period"`
+	EvaluationPeriods *int           `yaml:"evaluation_periods"`
+	Cooldown          *time.Duration `yaml:"cooldown"`
+	Steps             []ScalingStep  `yaml:"steps"`
+}
+
+// ComparisonOperator returns the direction in which the metric breaches the threshold, defaults to "above".
+func (s *StepScaling) ComparisonOperator() string {
+	if s.Comparison == nil {
+		return StepScalingComparisonAbove
+	}
+	return aws.StringValue(s.Comparison)
+}
+
+// ScalingMetric identifies the CloudWatch metric that a step scaling alarm watches.
+type ScalingMetric struct {
+	Namespace  *string           `yaml:"namespace"`
+	Name       *string           `yaml:"name"`
+	Statistic  *string           `yaml:"statistic"`
+	Dimensions map[string]string `yaml:"dimensions"`
+}
+
+// ScalingStep represents an adjustment to the number of tasks when the metric is within
+// the bounds, relative to the threshold of the alarm.
+type ScalingStep struct {
+	LowerBound *float64 `yaml:"lower_bound"`
+	UpperBound *float64 `yaml:"upper_bound"`
+	Adjustment *int     `yaml:"adjustment"`
+}
+
 // IsTypeAService returns if manifest type is service.
 func IsTypeAService(t string) bool {
 	for _, serviceType := range ServiceTypes() {
@@ -326,6 +404,16 @@ func (hc *HealthCheckArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the HealthCheckArgsOrString
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (hc HealthCheckArgsOrString) MarshalYAML() (interface{}, error) {
+	if !hc.HealthCheckArgs.isEmpty() {
+		return hc.HealthCheckArgs, nil
+	}
+	return hc.HealthCheckPath, nil
+}
+
 // IsEmpty returns true if there are no health check configuration set.
 func (hc *HealthCheckArgsOrString) IsEmpty() bool {
 	if hc.HealthCheckPath != nil {
@@ -373,3 +461,37 @@ func ParsePortMapping(s *string) (port *string, protocol *string, err error) {
 		return nil, nil, fmt.Errorf("cannot parse port mapping from %s", *s)
 	}
 }
+
+// Capacity providers that tasks of a service can be placed on.
+const (
+	CapacityProviderFargate     = "FARGATE"
+	CapacityProviderFargateSpot = "FARGATE_SPOT"
+	CapacityProviderEC2         = "EC2" // The Auto Scaling group capacity provider of the environment.
+)
+
+var capacityProviders = []string{CapacityProviderFargate, CapacityProviderFargateSpot, CapacityProviderEC2}
+
+// CapacityProviderStrategy represents a capacity provider and its share of the service's tasks.
+type CapacityProviderStrategy struct {
+	Provider *string `yaml:"provider"`
+	Weight   *int    `yaml:"weight"`
+	Base     *int    `yaml:"base"`
+}
+
+// CapacityProviders is a list of weighted capacity provider strategies.
+type CapacityProviders []CapacityProviderStrategy
+
+// IsEmpty returns true if no capacity provider strategies are specified.
+func (c CapacityProviders) IsEmpty() bool {
+	return len(c) == 0
+}
+
+// UsesProvider returns true if one of the strategies places tasks on the given provider.
+func (c CapacityProviders) UsesProvider(provider string) bool {
+	for _, cp := range c {
+		if aws.StringValue(cp.Provider) == provider {
+			return true
+		}
+	}
+	return false
+}
@@ -481,7 +481,7 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 `),
 			wantedError: &errFieldMutualExclusive{
 				firstField:  "spot",
-				secondField: "range/cpu_percentage/memory_percentage/requests/response_time/queue_delay",
+				secondField: "range/cpu_percentage/memory_percentage/requests/response_time/queue_delay/schedules/step_scaling",
 			},
 		},
 		"Error if unmarshalable": {
@@ -28,7 +28,10 @@ var defaultTransformers = []mergo.Transformers{
 	efsVolumeConfigurationTransformer{},
 	sqsQueueOrBoolTransformer{},
 	routingRuleConfigOrBoolTransformer{},
+	serviceConnectBoolOrArgsTransformer{},
 	secretTransformer{},
+	loggingTransformer{},
+	loggingPresetTransformer{},
 }
 
 // See a complete list of `reflect.Kind` here: https://pkg.go.dev/reflect#Kind.
@@ -353,6 +356,31 @@ func (t routingRuleConfigOrBoolTransformer) Transformer(typ reflect.Type) func(d
 	}
 }
 
+type serviceConnectBoolOrArgsTransformer struct{}
+
+// Transformer returns custom merge logic for ServiceConnectBoolOrArgs's fields.
+func (t serviceConnectBoolOrArgsTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(ServiceConnectBoolOrArgs{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(ServiceConnectBoolOrArgs), src.Interface().(ServiceConnectBoolOrArgs)
+
+		if !srcStruct.ServiceConnectArgs.IsEmpty() {
+			dstStruct.EnableServiceConnect = nil
+		}
+
+		if srcStruct.EnableServiceConnect != nil {
+			dstStruct.ServiceConnectArgs = ServiceConnectArgs{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type secretTransformer struct{}
 
 // Transformer returns custom merge logic for Secret's fields.
@@ -378,6 +406,68 @@ func (t secretTransformer) Transformer(typ reflect.Type) func(dst, src reflect.V
 	}
 }
 
+type loggingTransformer struct{}
+
+// Transformer returns custom merge logic for Logging's fields.
+func (t loggingTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(Logging{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(Logging), src.Interface().(Logging)
+
+		if !srcStruct.Preset.IsEmpty() {
+			dstStruct.Destination = nil
+		}
+
+		if srcStruct.Destination != nil {
+			dstStruct.Preset = LoggingPreset{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
+type loggingPresetTransformer struct{}
+
+// Transformer returns custom merge logic for LoggingPreset's fields.
+func (t loggingPresetTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(LoggingPreset{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(LoggingPreset), src.Interface().(LoggingPreset)
+		if srcStruct.IsEmpty() {
+			return nil
+		}
+
+		// Only keep the destination that is specified in the source manifest.
+		merged := LoggingPreset{}
+		switch {
+		case srcStruct.CloudWatch != nil:
+			merged.CloudWatch = dstStruct.CloudWatch
+		case srcStruct.Firehose != nil:
+			merged.Firehose = dstStruct.Firehose
+		case srcStruct.S3 != nil:
+			merged.S3 = dstStruct.S3
+		case srcStruct.OpenSearch != nil:
+			merged.OpenSearch = dstStruct.OpenSearch
+		case srcStruct.Datadog != nil:
+			merged.Datadog = dstStruct.Datadog
+		case srcStruct.Splunk != nil:
+			merged.Splunk = dstStruct.Splunk
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(merged))
+		}
+		return nil
+	}
+}
+
 type basicTransformer struct{}
 
 // Transformer returns custom merge logic for volume's fields.
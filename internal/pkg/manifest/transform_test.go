@@ -820,6 +820,63 @@ func TestSQSQueueOrBoolTransformer_Transformer(t *testing.T) {
 	}
 }
 
+func TestServiceConnectBoolOrArgsTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(s *ServiceConnectBoolOrArgs)
+		override func(s *ServiceConnectBoolOrArgs)
+		wanted   func(s *ServiceConnectBoolOrArgs)
+	}{
+		"bool set to empty if config is not nil": {
+			original: func(s *ServiceConnectBoolOrArgs) {
+				s.EnableServiceConnect = aws.Bool(true)
+			},
+			override: func(s *ServiceConnectBoolOrArgs) {
+				s.ServiceConnectArgs = ServiceConnectArgs{
+					Alias: aws.String("api"),
+				}
+			},
+			wanted: func(s *ServiceConnectBoolOrArgs) {
+				s.ServiceConnectArgs = ServiceConnectArgs{
+					Alias: aws.String("api"),
+				}
+			},
+		},
+		"config set to empty if bool is not nil": {
+			original: func(s *ServiceConnectBoolOrArgs) {
+				s.ServiceConnectArgs = ServiceConnectArgs{
+					Alias: aws.String("api"),
+				}
+			},
+			override: func(s *ServiceConnectBoolOrArgs) {
+				s.EnableServiceConnect = aws.Bool(false)
+			},
+			wanted: func(s *ServiceConnectBoolOrArgs) {
+				s.EnableServiceConnect = aws.Bool(false)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted ServiceConnectBoolOrArgs
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(serviceConnectBoolOrArgsTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
 func TestRoutingRuleConfigOrBoolTransformer_Transformer(t *testing.T) {
 	testCases := map[string]struct {
 		original func(r *RoutingRuleConfigOrBool)
@@ -935,3 +992,133 @@ func TestSecretTransformer_Transformer(t *testing.T) {
 		})
 	}
 }
+
+func TestLoggingTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(l *Logging)
+		override func(l *Logging)
+		wanted   func(l *Logging)
+	}{
+		`"destination" set to empty when overriding with "preset"`: {
+			original: func(l *Logging) {
+				l.Destination = map[string]string{
+					"Name": "cloudwatch",
+				}
+			},
+			override: func(l *Logging) {
+				l.Preset.Firehose = &FirehosePreset{
+					DeliveryStream: aws.String("my-stream"),
+				}
+			},
+			wanted: func(l *Logging) {
+				l.Preset.Firehose = &FirehosePreset{
+					DeliveryStream: aws.String("my-stream"),
+				}
+			},
+		},
+		`"preset" set to empty when overriding with "destination"`: {
+			original: func(l *Logging) {
+				l.Preset.Firehose = &FirehosePreset{
+					DeliveryStream: aws.String("my-stream"),
+				}
+			},
+			override: func(l *Logging) {
+				l.Destination = map[string]string{
+					"Name": "cloudwatch",
+				}
+			},
+			wanted: func(l *Logging) {
+				l.Destination = map[string]string{
+					"Name": "cloudwatch",
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted Logging
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(loggingTransformer{}))
+			require.NoError(t, err)
+
+			require.NoError(t, err)
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
+func TestLoggingPresetTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(p *LoggingPreset)
+		override func(p *LoggingPreset)
+		wanted   func(p *LoggingPreset)
+	}{
+		`other destinations set to empty when overriding with "s3"`: {
+			original: func(p *LoggingPreset) {
+				p.CloudWatch = &CloudWatchLogsPreset{
+					LogGroup: aws.String("my-group"),
+				}
+			},
+			override: func(p *LoggingPreset) {
+				p.S3 = &S3LogsPreset{
+					Bucket: aws.String("my-bucket"),
+				}
+			},
+			wanted: func(p *LoggingPreset) {
+				p.S3 = &S3LogsPreset{
+					Bucket: aws.String("my-bucket"),
+				}
+			},
+		},
+		`same destination is merged`: {
+			original: func(p *LoggingPreset) {
+				p.CloudWatch = &CloudWatchLogsPreset{
+					LogGroup:  aws.String("my-group"),
+					Retention: aws.Int(7),
+				}
+			},
+			override: func(p *LoggingPreset) {
+				p.CloudWatch = &CloudWatchLogsPreset{
+					LogGroup: aws.String("prod-group"),
+				}
+			},
+			wanted: func(p *LoggingPreset) {
+				p.CloudWatch = &CloudWatchLogsPreset{
+					LogGroup:  aws.String("prod-group"),
+					Retention: aws.Int(7),
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted LoggingPreset
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(loggingPresetTransformer{}))
+			require.NoError(t, err)
+
+			require.NoError(t, err)
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
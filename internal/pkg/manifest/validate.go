@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -12,10 +13,15 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
+	_ "time/tzdata" // Embed the IANA time zone database so that "timezone" fields can be validated on any platform.
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/dustin/go-humanize/english"
+	"github.com/robfig/cron/v3"
 )
 
 const (
@@ -30,6 +36,9 @@ const (
 	ephemeralMaxValueGiB = 200
 
 	envFileExt = ".env"
+
+	// CloudWatch Logs allows at most two subscription filters per log group.
+	maxLogSubscriptionFilters = 2
 )
 
 const (
@@ -39,15 +48,22 @@ const (
 
 	// Tracing vendors.
 	awsXRAY = "awsxray"
+
+	// App Runner auto scaling limits.
+	appRunnerMinConcurrency = 1
+	appRunnerMaxConcurrency = 200
+	appRunnerMinSize        = 1
+	appRunnerMaxSize        = 25
 )
 
 var (
-	intRangeBandRegexp  = regexp.MustCompile(`^(\d+)-(\d+)$`)
-	volumesPathRegexp   = regexp.MustCompile(`^[a-zA-Z0-9\-\.\_/]+$`)
-	awsSNSTopicRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`)   // Validates that an expression contains only letters, numbers, underscores, and hyphens.
-	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`) // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
-	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
-	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
+	intRangeBandRegexp        = regexp.MustCompile(`^(\d+)-(\d+)$`)
+	volumesPathRegexp         = regexp.MustCompile(`^[a-zA-Z0-9\-\.\_/]+$`)
+	awsSNSTopicRegexp         = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`)                 // Validates that an expression contains only letters, numbers, underscores, and hyphens.
+	awsNameRegexp             = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`)               // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
+	punctuationRegExp         = regexp.MustCompile(`[\.\-]{2,}`)                       // Check for consecutive periods or dashes.
+	trailingPunctRegExp       = regexp.MustCompile(`[\-\.]$`)                          // Check for trailing dash or dot.
+	serviceConnectAliasRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9\-]*[a-z0-9])?$`) // Validates that an alias is a valid DNS label.
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
@@ -57,6 +73,12 @@ var (
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
 	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
+
+	scalingMetricStats   = []string{"Average", "Sum", "Minimum", "Maximum", "SampleCount"}
+	alarmPeriodsUnderMin = []time.Duration{10 * time.Second, 30 * time.Second}
+
+	logSubscriptionDestinationServices = []string{"kinesis", "firehose", "lambda", "logs"}
+	logSubscriptionDestinationTypes    = []string{"Kinesis data stream", "Kinesis Data Firehose delivery stream", "Lambda function", "CloudWatch Logs destination"}
 )
 
 // Validate returns nil if LoadBalancedWebService is configured correctly.
@@ -130,6 +152,14 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	if err = l.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = l.Observability.Validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	for ind, permission := range l.Permissions {
+		if err = permission.Validate(); err != nil {
+			return fmt.Errorf(`validate "permissions[%d]": %w`, ind, err)
+		}
+	}
 	for ind, taskDefOverride := range l.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -137,23 +167,40 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	}
 	if l.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
-			execEnabled: aws.BoolValue(l.ExecuteCommand.Enable),
-			efsVolumes:  l.Storage.Volumes,
+			execEnabled:           aws.BoolValue(l.ExecuteCommand.Enable),
+			efsVolumes:            l.Storage.Volumes,
+			ec2Enabled:            l.Capacity.UsesProvider(CapacityProviderEC2),
+			tracingEnabled:        !l.Observability.isEmpty(),
+			serviceConnectEnabled: l.Network.Connect.Enabled(),
 		}); err != nil {
 			return fmt.Errorf("validate Windows: %w", err)
 		}
 	}
 	if l.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
-			Spot:     l.Count.AdvancedCount.Spot,
-			SpotFrom: l.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			Spot:                 l.Count.AdvancedCount.Spot,
+			SpotFrom:             l.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			SpotCapacityProvider: l.Capacity.UsesProvider(CapacityProviderFargateSpot),
 		}); err != nil {
 			return fmt.Errorf("validate ARM: %w", err)
 		}
 	}
+	if err = validateCapacityProviders(validateCapacityProvidersOpts{
+		capacity:  l.Capacity,
+		count:     l.Count.AdvancedCount,
+		placement: l.Network.VPC.Placement,
+	}); err != nil {
+		return err
+	}
 	if err = l.NLBConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "nlb": %w`, err)
 	}
+	if err = l.Global.Validate(); err != nil {
+		return fmt.Errorf(`validate "global": %w`, err)
+	}
+	if !l.Global.IsEmpty() && l.RoutingRule.Disabled() {
+		return errors.New(`"global" requires "http" to be enabled`)
+	}
 	return nil
 }
 
@@ -203,6 +250,14 @@ func (b BackendServiceConfig) Validate() error {
 	if err = b.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = b.Observability.Validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	for ind, permission := range b.Permissions {
+		if err = permission.Validate(); err != nil {
+			return fmt.Errorf(`validate "permissions[%d]": %w`, ind, err)
+		}
+	}
 	for ind, taskDefOverride := range b.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -210,20 +265,31 @@ func (b BackendServiceConfig) Validate() error {
 	}
 	if b.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
-			execEnabled: aws.BoolValue(b.ExecuteCommand.Enable),
-			efsVolumes:  b.Storage.Volumes,
+			execEnabled:           aws.BoolValue(b.ExecuteCommand.Enable),
+			efsVolumes:            b.Storage.Volumes,
+			ec2Enabled:            b.Capacity.UsesProvider(CapacityProviderEC2),
+			tracingEnabled:        !b.Observability.isEmpty(),
+			serviceConnectEnabled: b.Network.Connect.Enabled(),
 		}); err != nil {
 			return fmt.Errorf("validate Windows: %w", err)
 		}
 	}
 	if b.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
-			Spot:     b.Count.AdvancedCount.Spot,
-			SpotFrom: b.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			Spot:                 b.Count.AdvancedCount.Spot,
+			SpotFrom:             b.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			SpotCapacityProvider: b.Capacity.UsesProvider(CapacityProviderFargateSpot),
 		}); err != nil {
 			return fmt.Errorf("validate ARM: %w", err)
 		}
 	}
+	if err = validateCapacityProviders(validateCapacityProvidersOpts{
+		capacity:  b.Capacity,
+		count:     b.Count.AdvancedCount,
+		placement: b.Network.VPC.Placement,
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -256,6 +322,9 @@ func (r RequestDrivenWebServiceConfig) Validate() error {
 	if err = r.Observability.Validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
+	if err = r.Scaling.Validate(); err != nil {
+		return fmt.Errorf(`validate "scaling": %w`, err)
+	}
 	return nil
 }
 
@@ -302,12 +371,23 @@ func (w WorkerServiceConfig) Validate() error {
 	if err = w.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if w.Network.Connect.Alias != nil {
+		return fmt.Errorf(`validate "network": "connect.alias" is not supported for %s because it does not expose a port`, WorkerServiceType)
+	}
 	if err = w.Subscribe.Validate(); err != nil {
 		return fmt.Errorf(`validate "subscribe": %w`, err)
 	}
 	if err = w.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = w.Observability.Validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	for ind, permission := range w.Permissions {
+		if err = permission.Validate(); err != nil {
+			return fmt.Errorf(`validate "permissions[%d]": %w`, ind, err)
+		}
+	}
 	for ind, taskDefOverride := range w.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -315,20 +395,31 @@ func (w WorkerServiceConfig) Validate() error {
 	}
 	if w.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
-			execEnabled: aws.BoolValue(w.ExecuteCommand.Enable),
-			efsVolumes:  w.Storage.Volumes,
+			execEnabled:           aws.BoolValue(w.ExecuteCommand.Enable),
+			efsVolumes:            w.Storage.Volumes,
+			ec2Enabled:            w.Capacity.UsesProvider(CapacityProviderEC2),
+			tracingEnabled:        !w.Observability.isEmpty(),
+			serviceConnectEnabled: w.Network.Connect.Enabled(),
 		}); err != nil {
 			return fmt.Errorf(`validate Windows: %w`, err)
 		}
 	}
 	if w.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
-			Spot:     w.Count.AdvancedCount.Spot,
-			SpotFrom: w.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			Spot:                 w.Count.AdvancedCount.Spot,
+			SpotFrom:             w.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			SpotCapacityProvider: w.Capacity.UsesProvider(CapacityProviderFargateSpot),
 		}); err != nil {
 			return fmt.Errorf("validate ARM: %w", err)
 		}
 	}
+	if err = validateCapacityProviders(validateCapacityProvidersOpts{
+		capacity:  w.Capacity,
+		count:     w.Count.AdvancedCount,
+		placement: w.Network.VPC.Placement,
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -375,6 +466,9 @@ func (s ScheduledJobConfig) Validate() error {
 	if err = s.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if !s.Network.Connect.IsEmpty() {
+		return fmt.Errorf(`validate "network": "connect" is not supported for %s`, ScheduledJobType)
+	}
 	if err = s.On.Validate(); err != nil {
 		return fmt.Errorf(`validate "on": %w`, err)
 	}
@@ -384,6 +478,14 @@ func (s ScheduledJobConfig) Validate() error {
 	if err = s.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = s.Observability.Validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	for ind, permission := range s.Permissions {
+		if err = permission.Validate(); err != nil {
+			return fmt.Errorf(`validate "permissions[%d]": %w`, ind, err)
+		}
+	}
 	for ind, taskDefOverride := range s.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -391,8 +493,9 @@ func (s ScheduledJobConfig) Validate() error {
 	}
 	if s.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
-			execEnabled: aws.BoolValue(s.ExecuteCommand.Enable),
-			efsVolumes:  s.Storage.Volumes,
+			execEnabled:    aws.BoolValue(s.ExecuteCommand.Enable),
+			efsVolumes:     s.Storage.Volumes,
+			tracingEnabled: !s.Observability.isEmpty(),
 		}); err != nil {
 			return fmt.Errorf(`validate Windows: %w`, err)
 		}
@@ -608,6 +711,43 @@ func (r RoutingRuleConfiguration) Validate() error {
 	return nil
 }
 
+// Validate returns nil if GlobalServiceConfig is configured correctly.
+func (g GlobalServiceConfig) Validate() error {
+	if g.IsEmpty() {
+		return nil
+	}
+	if g.Alias == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "alias",
+		}
+	}
+	if !contains(g.RoutingPolicy(), globalRoutingPolicies) {
+		return fmt.Errorf(`"routing" field value '%s' must be one of %s`, g.RoutingPolicy(), english.WordSeries(globalRoutingPolicies, "or"))
+	}
+	if len(g.Environments) < 2 {
+		return errors.New(`"environments" must contain at least two environments`)
+	}
+	if g.RoutingPolicy() == GlobalRoutingFailover && len(g.Environments) != 2 {
+		return fmt.Errorf(`"environments" must contain exactly a primary and a secondary environment if "routing" is %s`, GlobalRoutingFailover)
+	}
+	seen := make(map[string]bool)
+	for _, env := range g.Environments {
+		if seen[env] {
+			return fmt.Errorf(`environment %s is listed more than once in "environments"`, env)
+		}
+		seen[env] = true
+	}
+	if g.Primary != nil {
+		if g.RoutingPolicy() != GlobalRoutingFailover {
+			return fmt.Errorf(`"primary" can only be specified if "routing" is %s`, GlobalRoutingFailover)
+		}
+		if !seen[aws.StringValue(g.Primary)] {
+			return fmt.Errorf(`"primary" environment %s must be listed in "environments"`, aws.StringValue(g.Primary))
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if HealthCheckArgsOrString is configured correctly.
 func (h HealthCheckArgsOrString) Validate() error {
 	if h.IsEmpty() {
@@ -704,6 +844,11 @@ func (t TaskConfig) Validate() error {
 	if err = t.Storage.Validate(); err != nil {
 		return fmt.Errorf(`validate "storage": %w`, err)
 	}
+	for name, secret := range t.Secrets {
+		if err = secret.Validate(); err != nil {
+			return fmt.Errorf(`validate secret "%s": %w`, name, err)
+		}
+	}
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
@@ -814,6 +959,168 @@ func (a AdvancedCount) Validate() error {
 			return fmt.Errorf(`validate "memory_percentage": %w`, err)
 		}
 	}
+	for idx, schedule := range a.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf(`validate "schedules[%d]": %w`, idx, err)
+		}
+	}
+	for idx, step := range a.StepScaling {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf(`validate "step_scaling[%d]": %w`, idx, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if ScheduledScaling is configured correctly.
+func (s ScheduledScaling) Validate() error {
+	if s.Schedule == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "schedule",
+		}
+	}
+	// AWS schedule expressions are validated server-side, the rest must parse the same way as a Scheduled Job's "schedule".
+	if !IsAWSScheduleExpression(aws.StringValue(s.Schedule)) {
+		if _, err := cron.ParseStandard(aws.StringValue(s.Schedule)); err != nil {
+			return fmt.Errorf(`"schedule" is not valid cron, rate, or preset: %w`, err)
+		}
+	}
+	if s.Timezone != nil {
+		if _, err := time.LoadLocation(aws.StringValue(s.Timezone)); err != nil {
+			return fmt.Errorf(`"timezone" is not a valid IANA time zone: %w`, err)
+		}
+	}
+	if s.Min == nil && s.Max == nil {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"min", "max"},
+		}
+	}
+	if s.Min != nil && s.Max != nil && aws.IntValue(s.Min) > aws.IntValue(s.Max) {
+		return &errMinGreaterThanMax{
+			min: aws.IntValue(s.Min),
+			max: aws.IntValue(s.Max),
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if StepScaling is configured correctly.
+func (s StepScaling) Validate() error {
+	if err := s.Metric.Validate(); err != nil {
+		return fmt.Errorf(`validate "metric": %w`, err)
+	}
+	if !contains(s.ComparisonOperator(), stepScalingComparisons) {
+		return fmt.Errorf(`"comparison" field value '%s' must be one of %s`, s.ComparisonOperator(), english.WordSeries(stepScalingComparisons, "or"))
+	}
+	if s.Threshold == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "threshold",
+		}
+	}
+	if s.Period != nil {
+		period := *s.Period
+		if period%time.Minute != 0 && !containsDuration(period, alarmPeriodsUnderMin) {
+			return fmt.Errorf(`"period" must be 10s, 30s, or a multiple of 60s, got %s`, period)
+		}
+	}
+	if s.EvaluationPeriods != nil && aws.IntValue(s.EvaluationPeriods) < 1 {
+		return errors.New(`"evaluation_periods" must be at least 1`)
+	}
+	if len(s.Steps) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "steps",
+		}
+	}
+	for idx, step := range s.Steps {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf(`validate "steps[%d]": %w`, idx, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if ScalingMetric is configured correctly.
+func (m ScalingMetric) Validate() error {
+	if m.Namespace == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "namespace",
+		}
+	}
+	if m.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if m.Statistic != nil && !contains(aws.StringValue(m.Statistic), scalingMetricStats) {
+		return fmt.Errorf(`"statistic" field value '%s' must be one of %s`, aws.StringValue(m.Statistic), english.WordSeries(scalingMetricStats, "or"))
+	}
+	return nil
+}
+
+// Validate returns nil if ScalingStep is configured correctly.
+func (s ScalingStep) Validate() error {
+	if s.Adjustment == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "adjustment",
+		}
+	}
+	if s.LowerBound != nil && s.UpperBound != nil && aws.Float64Value(s.LowerBound) >= aws.Float64Value(s.UpperBound) {
+		return fmt.Errorf(`"lower_bound" %v must be less than "upper_bound" %v`, aws.Float64Value(s.LowerBound), aws.Float64Value(s.UpperBound))
+	}
+	return nil
+}
+
+// Validate returns nil if CapacityProviders is configured correctly.
+func (c CapacityProviders) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var hasWeight, hasBase bool
+	for idx, cp := range c {
+		if err := cp.Validate(); err != nil {
+			return fmt.Errorf(`validate "capacity_providers[%d]": %w`, idx, err)
+		}
+		provider := aws.StringValue(cp.Provider)
+		if seen[provider] {
+			return fmt.Errorf(`capacity provider %s is listed more than once in "capacity_providers"`, provider)
+		}
+		seen[provider] = true
+		if aws.IntValue(cp.Weight) > 0 {
+			hasWeight = true
+		}
+		if cp.Base != nil {
+			if hasBase {
+				return errors.New(`only one capacity provider in "capacity_providers" can have a "base"`)
+			}
+			hasBase = true
+		}
+	}
+	if !hasWeight {
+		return errors.New(`at least one capacity provider in "capacity_providers" must have a "weight" greater than 0`)
+	}
+	if c.UsesProvider(CapacityProviderEC2) && len(c) > 1 {
+		return fmt.Errorf(`capacity provider "%s" cannot be used together with Fargate capacity providers`, CapacityProviderEC2)
+	}
+	return nil
+}
+
+// Validate returns nil if CapacityProviderStrategy is configured correctly.
+func (c CapacityProviderStrategy) Validate() error {
+	if c.Provider == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "provider",
+		}
+	}
+	if !contains(aws.StringValue(c.Provider), capacityProviders) {
+		return fmt.Errorf(`"provider" field value '%s' must be one of %s`, aws.StringValue(c.Provider), english.WordSeries(capacityProviders, "or"))
+	}
+	if c.Weight != nil && aws.IntValue(c.Weight) < 0 {
+		return fmt.Errorf(`"weight" must be a non-negative number, got %d`, aws.IntValue(c.Weight))
+	}
+	if c.Base != nil && aws.IntValue(c.Base) < 0 {
+		return fmt.Errorf(`"base" must be a non-negative number, got %d`, aws.IntValue(c.Base))
+	}
 	return nil
 }
 
@@ -945,6 +1252,16 @@ func (s Storage) Validate() error {
 			hasManagedVolume = true
 		}
 	}
+	seen := make(map[string]struct{}, len(s.EnvAddons))
+	for i, name := range s.EnvAddons {
+		if name == "" {
+			return fmt.Errorf(`validate "env_addons[%d]": name cannot be empty`, i)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf(`validate "env_addons": addon %s is specified more than once`, name)
+		}
+		seen[name] = struct{}{}
+	}
 	return nil
 }
 
@@ -1032,9 +1349,193 @@ func (a AuthorizationConfig) Validate() error {
 
 // Validate returns nil if Logging is configured correctly.
 func (l Logging) Validate() error {
+	if len(l.SubscriptionFilters) > maxLogSubscriptionFilters {
+		return fmt.Errorf(`"subscriptionFilters" cannot have more than %d filters`, maxLogSubscriptionFilters)
+	}
+	for ind, filter := range l.SubscriptionFilters {
+		if err := filter.Validate(); err != nil {
+			return fmt.Errorf(`validate "subscriptionFilters[%d]": %w`, ind, err)
+		}
+	}
+	for ind, filter := range l.MetricFilters {
+		if err := filter.Validate(); err != nil {
+			return fmt.Errorf(`validate "metricFilters[%d]": %w`, ind, err)
+		}
+	}
 	if l.IsEmpty() {
 		return nil
 	}
+	if l.Preset.IsEmpty() {
+		return nil
+	}
+	if l.Destination != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "preset",
+			secondField: "destination",
+		}
+	}
+	if err := l.Preset.Validate(); err != nil {
+		return fmt.Errorf(`validate "preset": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if LogSubscriptionFilter is configured correctly.
+func (f LogSubscriptionFilter) Validate() error {
+	if f.Destination == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "destination",
+		}
+	}
+	parsed, err := arn.Parse(aws.StringValue(f.Destination))
+	if err != nil {
+		return fmt.Errorf(`"destination" must be an ARN: %w`, err)
+	}
+	isLogsDestination := parsed.Service == "logs" && strings.HasPrefix(parsed.Resource, "destination:")
+	if !contains(parsed.Service, logSubscriptionDestinationServices) || (parsed.Service == "logs" && !isLogsDestination) {
+		return fmt.Errorf(`"destination" must be the ARN of a %s`, english.WordSeries(logSubscriptionDestinationTypes, "or"))
+	}
+	return nil
+}
+
+// Validate returns nil if LogMetricFilter is configured correctly.
+func (f LogMetricFilter) Validate() error {
+	if aws.StringValue(f.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if f.Unit != nil && !contains(aws.StringValue(f.Unit), cloudwatch.StandardUnit_Values()) {
+		return fmt.Errorf(`"unit" field value '%s' must be a CloudWatch unit, like Count, Seconds or Bytes`, aws.StringValue(f.Unit))
+	}
+	return nil
+}
+
+// Validate returns nil if LoggingPreset is configured correctly.
+func (p LoggingPreset) Validate() error {
+	var specified int
+	for _, isSet := range []bool{p.CloudWatch != nil, p.Firehose != nil, p.S3 != nil,
+		p.OpenSearch != nil, p.Datadog != nil, p.Splunk != nil} {
+		if isSet {
+			specified++
+		}
+	}
+	if specified > 1 {
+		return errors.New(`must specify only one of "cloudwatch", "firehose", "s3", "opensearch", "datadog" or "splunk"`)
+	}
+	if p.CloudWatch != nil {
+		if err := p.CloudWatch.Validate(); err != nil {
+			return fmt.Errorf(`validate "cloudwatch": %w`, err)
+		}
+	}
+	if p.Firehose != nil {
+		if err := p.Firehose.Validate(); err != nil {
+			return fmt.Errorf(`validate "firehose": %w`, err)
+		}
+	}
+	if p.S3 != nil {
+		if err := p.S3.Validate(); err != nil {
+			return fmt.Errorf(`validate "s3": %w`, err)
+		}
+	}
+	if p.OpenSearch != nil {
+		if err := p.OpenSearch.Validate(); err != nil {
+			return fmt.Errorf(`validate "opensearch": %w`, err)
+		}
+	}
+	if p.Datadog != nil {
+		if err := p.Datadog.Validate(); err != nil {
+			return fmt.Errorf(`validate "datadog": %w`, err)
+		}
+	}
+	if p.Splunk != nil {
+		if err := p.Splunk.Validate(); err != nil {
+			return fmt.Errorf(`validate "splunk": %w`, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if CloudWatchLogsPreset is configured correctly.
+func (p CloudWatchLogsPreset) Validate() error {
+	if aws.StringValue(p.LogGroup) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "logGroup",
+		}
+	}
+	if p.Retention != nil && aws.IntValue(p.Retention) <= 0 {
+		return errors.New(`"retention" must be a positive number of days`)
+	}
+	return nil
+}
+
+// Validate returns nil if FirehosePreset is configured correctly.
+func (p FirehosePreset) Validate() error {
+	if aws.StringValue(p.DeliveryStream) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "deliveryStream",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if S3LogsPreset is configured correctly.
+func (p S3LogsPreset) Validate() error {
+	if aws.StringValue(p.Bucket) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "bucket",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if OpenSearchPreset is configured correctly.
+func (p OpenSearchPreset) Validate() error {
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"domain", p.Domain},
+		{"endpoint", p.Endpoint},
+		{"index", p.Index},
+	} {
+		if aws.StringValue(field.value) == "" {
+			return &errFieldMustBeSpecified{
+				missingField: field.name,
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if DatadogPreset is configured correctly.
+func (p DatadogPreset) Validate() error {
+	if p.APIKey.Value() == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "apiKey",
+		}
+	}
+	if err := p.APIKey.Validate(); err != nil {
+		return fmt.Errorf(`validate "apiKey": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if SplunkPreset is configured correctly.
+func (p SplunkPreset) Validate() error {
+	if aws.StringValue(p.Host) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "host",
+		}
+	}
+	if p.Token.Value() == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "token",
+		}
+	}
+	if err := p.Token.Validate(); err != nil {
+		return fmt.Errorf(`validate "token": %w`, err)
+	}
 	return nil
 }
 
@@ -1072,6 +1573,84 @@ func (n NetworkConfig) Validate() error {
 	if err := n.VPC.Validate(); err != nil {
 		return fmt.Errorf(`validate "vpc": %w`, err)
 	}
+	if err := n.Connect.Validate(); err != nil {
+		return fmt.Errorf(`validate "connect": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if Permission is configured correctly.
+func (p Permission) Validate() error {
+	if len(p.Actions) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "actions",
+		}
+	}
+	if len(p.Resources) == 0 && len(p.Topics) == 0 {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    []string{"resources", "topics"},
+			conditionalField: "actions",
+		}
+	}
+	var hasWildcardAction bool
+	for _, action := range p.Actions {
+		if action == "*" {
+			return errors.New(`action "*" grants access to every AWS API: list the actions that the task needs instead`)
+		}
+		parts := strings.SplitN(action, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf(`action %q must be of the form "service:Action"`, action)
+		}
+		if strings.Contains(action, "*") {
+			hasWildcardAction = true
+		}
+	}
+	for _, resource := range p.Resources {
+		if resource == "" {
+			return errors.New(`"resources" cannot contain an empty string`)
+		}
+		if resource == "*" && hasWildcardAction {
+			return errors.New(`"resources" cannot contain "*" when an action contains a wildcard`)
+		}
+	}
+	for ind, topic := range p.Topics {
+		if err := topic.Validate(); err != nil {
+			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if TopicReference is configured correctly.
+func (t TopicReference) Validate() error {
+	if err := validatePubSubName(aws.StringValue(t.Name)); err != nil {
+		return err
+	}
+	svcName := aws.StringValue(t.Service)
+	if svcName == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "service",
+		}
+	}
+	if !isValidSubSvcName(svcName) {
+		return fmt.Errorf("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
+	}
+	return nil
+}
+
+// Validate returns nil if ServiceConnectBoolOrArgs is configured correctly.
+func (s ServiceConnectBoolOrArgs) Validate() error {
+	return s.ServiceConnectArgs.Validate()
+}
+
+// Validate returns nil if ServiceConnectArgs is configured correctly.
+func (s ServiceConnectArgs) Validate() error {
+	if s.Alias == nil {
+		return nil
+	}
+	if !serviceConnectAliasRegexp.MatchString(aws.StringValue(s.Alias)) {
+		return fmt.Errorf(`"alias" %q must contain only lowercase letters, numbers, and hyphens, and must start and end with a letter or number`, aws.StringValue(s.Alias))
+	}
 	return nil
 }
 
@@ -1091,11 +1670,18 @@ func (v rdwsVpcConfig) Validate() error {
 	if v.isEmpty() {
 		return nil
 	}
-	if v.Placement != nil {
-		if err := v.Placement.Validate(); err != nil {
-			return fmt.Errorf(`validate "placement": %w`, err)
+	if v.Placement == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "placement",
+			conditionalFields: []string{"security_groups"},
 		}
 	}
+	if len(v.SecurityGroups) > 0 && string(*v.Placement) != string(PrivateSubnetPlacement) {
+		return fmt.Errorf(`"security_groups" requires "placement" to be "%s"`, PrivateSubnetPlacement)
+	}
+	if err := v.Placement.Validate(); err != nil {
+		return fmt.Errorf(`validate "placement": %w`, err)
+	}
 	return nil
 }
 
@@ -1156,9 +1742,50 @@ func (r AppRunnerInstanceConfig) Validate() error {
 
 // Validate returns nil if RequestDrivenWebServiceHttpConfig is configured correctly.
 func (r RequestDrivenWebServiceHttpConfig) Validate() error {
+	if aws.BoolValue(r.Private) && r.Alias != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "private",
+			secondField: "alias",
+		}
+	}
 	return r.HealthCheckConfiguration.Validate()
 }
 
+// Validate returns nil if AppRunnerScalingConfig is configured correctly.
+func (c AppRunnerScalingConfig) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if c.MaxConcurrency != nil {
+		if concurrency := aws.IntValue(c.MaxConcurrency); concurrency < appRunnerMinConcurrency || concurrency > appRunnerMaxConcurrency {
+			return fmt.Errorf(`"max_concurrency" must be between %d and %d, got %d`, appRunnerMinConcurrency, appRunnerMaxConcurrency, concurrency)
+		}
+	}
+	if err := validateAppRunnerSize("min_size", c.MinSize); err != nil {
+		return err
+	}
+	if err := validateAppRunnerSize("max_size", c.MaxSize); err != nil {
+		return err
+	}
+	if c.MinSize != nil && c.MaxSize != nil && aws.IntValue(c.MinSize) > aws.IntValue(c.MaxSize) {
+		return &errMinGreaterThanMax{
+			min: aws.IntValue(c.MinSize),
+			max: aws.IntValue(c.MaxSize),
+		}
+	}
+	return nil
+}
+
+func validateAppRunnerSize(field string, size *int) error {
+	if size == nil {
+		return nil
+	}
+	if aws.IntValue(size) < appRunnerMinSize || aws.IntValue(size) > appRunnerMaxSize {
+		return fmt.Errorf(`"%s" must be between %d and %d, got %d`, field, appRunnerMinSize, appRunnerMaxSize, aws.IntValue(size))
+	}
+	return nil
+}
+
 // Validate returns nil if Observability is configured correctly.
 func (o Observability) Validate() error {
 	if o.isEmpty() {
@@ -1202,7 +1829,15 @@ func (p PublishConfig) Validate() error {
 
 // Validate returns nil if Topic is configured correctly.
 func (t Topic) Validate() error {
-	return validatePubSubName(aws.StringValue(t.Name))
+	if err := validatePubSubName(aws.StringValue(t.Name)); err != nil {
+		return err
+	}
+	if t.KMSKey != nil {
+		if parsed, err := arn.Parse(aws.StringValue(t.KMSKey)); err != nil || parsed.Service != "kms" {
+			return fmt.Errorf(`"kms_key" must be the ARN of a KMS key`)
+		}
+	}
+	return nil
 }
 
 // Validate returns nil if SubscribeConfig is configured correctly.
@@ -1215,12 +1850,54 @@ func (s SubscribeConfig) Validate() error {
 			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
 		}
 	}
+	names := make(map[string]bool)
+	for ind, event := range s.Events {
+		if err := event.Validate(); err != nil {
+			return fmt.Errorf(`validate "events[%d]": %w`, ind, err)
+		}
+		name := aws.StringValue(event.Name)
+		if names[name] {
+			return fmt.Errorf(`validate "events[%d]": event subscription %q is specified more than once`, ind, name)
+		}
+		names[name] = true
+	}
+	if err := s.validateSharedQueue(); err != nil {
+		return err
+	}
 	if err := s.Queue.Validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
 	return nil
 }
 
+// validateSharedQueue returns an error if both FIFO and standard topics deliver messages to the default queue,
+// or if events are delivered to a default queue that is FIFO.
+func (s SubscribeConfig) validateSharedQueue() error {
+	var fifo, standard bool
+	for _, topic := range s.Topics {
+		if !topic.Queue.IsEmpty() {
+			continue
+		}
+		if aws.BoolValue(topic.FIFO) {
+			fifo = true
+		} else {
+			standard = true
+		}
+	}
+	if fifo && standard {
+		return fmt.Errorf(`validate "topics": FIFO and standard topics cannot share the default queue; set "queue" to create a dedicated queue for the topic`)
+	}
+	if !fifo {
+		return nil
+	}
+	for ind, event := range s.Events {
+		if event.Queue.IsEmpty() {
+			return fmt.Errorf(`validate "events[%d]": events cannot be delivered to the default queue shared with FIFO topics; set "queue" to create a dedicated queue for the event subscription`, ind)
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if TopicSubscription is configured correctly.
 func (t TopicSubscription) Validate() error {
 	if err := validatePubSubName(aws.StringValue(t.Name)); err != nil {
@@ -1238,6 +1915,30 @@ func (t TopicSubscription) Validate() error {
 	if err := t.Queue.Validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
+	if t.FilterPolicy != nil {
+		if err := validateJSONObject("filter_policy", aws.StringValue(t.FilterPolicy)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if EventSubscription is configured correctly.
+func (e EventSubscription) Validate() error {
+	if err := validatePubSubName(aws.StringValue(e.Name)); err != nil {
+		return err
+	}
+	if e.Pattern == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "pattern",
+		}
+	}
+	if err := validateJSONObject("pattern", aws.StringValue(e.Pattern)); err != nil {
+		return err
+	}
+	if err := e.Queue.Validate(); err != nil {
+		return fmt.Errorf(`validate "queue": %w`, err)
+	}
 	return nil
 }
 
@@ -1279,8 +1980,25 @@ func (r OverrideRule) Validate() error {
 	return nil
 }
 
-// Validate is a no-op for Secrets.
+// Validate returns nil if Secret is configured correctly.
 func (s Secret) Validate() error {
+	return s.fromSecretsManager.Validate()
+}
+
+// Validate returns nil if secretsManagerSecret is configured correctly.
+func (s secretsManagerSecret) Validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if s.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "secretsmanager",
+			conditionalFields: []string{"key"},
+		}
+	}
+	if s.Key != nil && aws.StringValue(s.Key) == "" {
+		return errors.New(`"key" cannot be empty`)
+	}
 	return nil
 }
 
@@ -1303,13 +2021,23 @@ type validateTargetContainerOpts struct {
 }
 
 type validateWindowsOpts struct {
-	execEnabled bool
-	efsVolumes  map[string]*Volume
+	execEnabled           bool
+	efsVolumes            map[string]*Volume
+	ec2Enabled            bool
+	tracingEnabled        bool
+	serviceConnectEnabled bool
 }
 
 type validateARMOpts struct {
-	Spot     *int
-	SpotFrom *int
+	Spot                 *int
+	SpotFrom             *int
+	SpotCapacityProvider bool
+}
+
+type validateCapacityProvidersOpts struct {
+	capacity  CapacityProviders
+	count     AdvancedCount
+	placement *Placement
 }
 
 func validateTargetContainer(opts validateTargetContainerOpts) error {
@@ -1433,6 +2161,18 @@ func validatePubSubName(name string) error {
 	return nil
 }
 
+// validateJSONObject returns an error if the value of the field is not a JSON object.
+func validateJSONObject(field, value string) error {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &obj); err != nil {
+		return fmt.Errorf(`%q must be a JSON object: %w`, field, err)
+	}
+	if obj == nil {
+		return fmt.Errorf(`%q must be a JSON object`, field)
+	}
+	return nil
+}
+
 func isValidSubSvcName(name string) bool {
 	if !awsNameRegexp.MatchString(name) {
 		return false
@@ -1457,16 +2197,59 @@ func validateWindows(opts validateWindowsOpts) error {
 			return errors.New(`'EFS' is not supported when deploying a Windows container`)
 		}
 	}
+	if opts.ec2Enabled {
+		return errors.New(`'EC2' capacity provider is not supported when deploying a Windows container`)
+	}
+	if opts.tracingEnabled {
+		return errors.New(`'observability.tracing' is not supported when deploying a Windows container`)
+	}
+	if opts.serviceConnectEnabled {
+		return errors.New(`'network.connect' is not supported when deploying a Windows container`)
+	}
 	return nil
 }
 
 func validateARM(opts validateARMOpts) error {
-	if opts.Spot != nil || opts.SpotFrom != nil {
+	if opts.Spot != nil || opts.SpotFrom != nil || opts.SpotCapacityProvider {
 		return errors.New(`'Fargate Spot' is not supported when deploying on ARM architecture`)
 	}
 	return nil
 }
 
+func validateCapacityProviders(opts validateCapacityProvidersOpts) error {
+	if opts.capacity.IsEmpty() {
+		return nil
+	}
+	if err := opts.capacity.Validate(); err != nil {
+		return err
+	}
+	if opts.count.Spot != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "count.spot",
+			secondField: "capacity_providers",
+		}
+	}
+	if opts.count.Range.RangeConfig.SpotFrom != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "count.range.spot_from",
+			secondField: "capacity_providers",
+		}
+	}
+	if opts.capacity.UsesProvider(CapacityProviderEC2) && (opts.placement == nil || *opts.placement != PrivateSubnetPlacement) {
+		return fmt.Errorf(`capacity provider "%s" requires "network.vpc.placement" to be "%s"`, CapacityProviderEC2, PrivateSubnetPlacement)
+	}
+	return nil
+}
+
+func containsDuration(d time.Duration, durations []time.Duration) bool {
+	for _, v := range durations {
+		if d == v {
+			return true
+		}
+	}
+	return false
+}
+
 func contains(name string, names []string) bool {
 	for _, n := range names {
 		if name == n {
@@ -82,7 +82,7 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -113,6 +113,40 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if fail to validate observability": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Observability: Observability{
+						Tracing: aws.String("unknown-vendor"),
+					},
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "observability": `,
+		},
+		"error if fail to validate permissions": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Permissions: []Permission{
+						{
+							Actions: []string{"s3:GetObject"},
+						},
+					},
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "permissions[0]": `,
+		},
 		"error if fail to validate taskdef override": {
 			lbConfig: LoadBalancedWebService{
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
@@ -373,7 +407,7 @@ func TestBackendService_Validate(t *testing.T) {
 				BackendServiceConfig: BackendServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -394,6 +428,30 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if fail to validate observability": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Observability: Observability{
+						Tracing: aws.String("unknown-vendor"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "observability": `,
+		},
+		"error if fail to validate permissions": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Permissions: []Permission{
+						{
+							Actions: []string{"s3:GetObject"},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "permissions[0]": `,
+		},
 		"error if fail to validate taskdef override": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -570,6 +628,45 @@ func TestRequestDrivenWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "observability": `,
 		},
+		"error if fail to validate scaling": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Location: stringP("mockLocation"),
+						},
+						Port: uint16P(80),
+					},
+					Scaling: AppRunnerScalingConfig{
+						MaxConcurrency: aws.Int(0),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "scaling": `,
+		},
+		"error if private is set along with alias": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Location: stringP("mockLocation"),
+						},
+						Port: uint16P(80),
+					},
+					RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+						Alias:   aws.String("api.example.com"),
+						Private: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": must specify one, not both, of "private" and "alias"`),
+		},
 		"error if name is not set": {
 			config: RequestDrivenWebService{
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
@@ -646,7 +743,7 @@ func TestWorkerService_Validate(t *testing.T) {
 				WorkerServiceConfig: WorkerServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -654,6 +751,21 @@ func TestWorkerService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "network": `,
 		},
+		"error if service connect alias is set": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Alias: aws.String("worker"),
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "network": "connect.alias" is not supported for Worker Service`,
+		},
 		"error if fail to validate subscribe": {
 			config: WorkerService{
 				WorkerServiceConfig: WorkerServiceConfig{
@@ -682,6 +794,30 @@ func TestWorkerService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if fail to validate observability": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Observability: Observability{
+						Tracing: aws.String("unknown-vendor"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "observability": `,
+		},
+		"error if fail to validate permissions": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Permissions: []Permission{
+						{
+							Actions: []string{"s3:GetObject"},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "permissions[0]": `,
+		},
 		"error if fail to validate taskdef override": {
 			config: WorkerService{
 				WorkerServiceConfig: WorkerServiceConfig{
@@ -817,7 +953,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -825,6 +961,19 @@ func TestScheduledJob_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "network": `,
 		},
+		"error if service connect is enabled": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							EnableServiceConnect: aws.Bool(true),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "network": "connect" is not supported for Scheduled Job`,
+		},
 		"error if fail to validate on": {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
@@ -850,6 +999,36 @@ func TestScheduledJob_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if fail to validate observability": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+					Observability: Observability{
+						Tracing: aws.String("unknown-vendor"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "observability": `,
+		},
+		"error if fail to validate permissions": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+					Permissions: []Permission{
+						{
+							Actions: []string{"s3:GetObject"},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "permissions[0]": `,
+		},
 		"error if fail to validate taskdef override": {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
@@ -1196,6 +1375,252 @@ func TestNetworkLoadBalancerConfiguration_Validate(t *testing.T) {
 	}
 }
 
+func TestGlobalServiceConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		global GlobalServiceConfig
+
+		wantedError error
+	}{
+		"success if empty": {
+			global: GlobalServiceConfig{},
+		},
+		"error if alias unspecified": {
+			global: GlobalServiceConfig{
+				Environments: []string{"us", "eu"},
+			},
+			wantedError: fmt.Errorf(`"alias" must be specified`),
+		},
+		"error if routing is not recognized": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Routing:      aws.String("weighted"),
+				Environments: []string{"us", "eu"},
+			},
+			wantedError: fmt.Errorf(`"routing" field value 'weighted' must be one of latency or failover`),
+		},
+		"error if fewer than two environments": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Environments: []string{"us"},
+			},
+			wantedError: fmt.Errorf(`"environments" must contain at least two environments`),
+		},
+		"error if failover has more than two environments": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Routing:      aws.String("failover"),
+				Environments: []string{"us", "eu", "ap"},
+			},
+			wantedError: fmt.Errorf(`"environments" must contain exactly a primary and a secondary environment if "routing" is failover`),
+		},
+		"error if an environment is duplicated": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Environments: []string{"us", "eu", "us"},
+			},
+			wantedError: fmt.Errorf(`environment us is listed more than once in "environments"`),
+		},
+		"success with latency routing by default": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Environments: []string{"us", "eu", "ap"},
+			},
+		},
+		"error if primary is specified with latency routing": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Primary:      aws.String("eu"),
+				Environments: []string{"us", "eu"},
+			},
+			wantedError: fmt.Errorf(`"primary" can only be specified if "routing" is failover`),
+		},
+		"error if primary is not one of the environments": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Routing:      aws.String("failover"),
+				Primary:      aws.String("ap"),
+				Environments: []string{"us", "eu"},
+			},
+			wantedError: fmt.Errorf(`"primary" environment ap must be listed in "environments"`),
+		},
+		"success with failover routing": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Routing:      aws.String("failover"),
+				Environments: []string{"us", "eu"},
+			},
+		},
+		"success with failover routing to a configured primary": {
+			global: GlobalServiceConfig{
+				Alias:        aws.String("api.phonetool.example.com"),
+				Routing:      aws.String("failover"),
+				Primary:      aws.String("eu"),
+				Environments: []string{"us", "eu"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.global.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestCapacityProviders_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in CapacityProviders
+
+		wantedError error
+	}{
+		"success if empty": {
+			in: CapacityProviders{},
+		},
+		"error if provider unspecified": {
+			in: CapacityProviders{
+				{Weight: aws.Int(1)},
+			},
+			wantedError: fmt.Errorf(`validate "capacity_providers[0]": "provider" must be specified`),
+		},
+		"error if provider is not recognized": {
+			in: CapacityProviders{
+				{Provider: aws.String("EXTERNAL"), Weight: aws.Int(1)},
+			},
+			wantedError: fmt.Errorf(`validate "capacity_providers[0]": "provider" field value 'EXTERNAL' must be one of FARGATE, FARGATE_SPOT or EC2`),
+		},
+		"error if weight is negative": {
+			in: CapacityProviders{
+				{Provider: aws.String("FARGATE"), Weight: aws.Int(-1)},
+			},
+			wantedError: fmt.Errorf(`validate "capacity_providers[0]": "weight" must be a non-negative number, got -1`),
+		},
+		"error if a provider is listed twice": {
+			in: CapacityProviders{
+				{Provider: aws.String("FARGATE"), Weight: aws.Int(1)},
+				{Provider: aws.String("FARGATE"), Weight: aws.Int(2)},
+			},
+			wantedError: fmt.Errorf(`capacity provider FARGATE is listed more than once in "capacity_providers"`),
+		},
+		"error if more than one base": {
+			in: CapacityProviders{
+				{Provider: aws.String("FARGATE"), Weight: aws.Int(1), Base: aws.Int(1)},
+				{Provider: aws.String("FARGATE_SPOT"), Weight: aws.Int(2), Base: aws.Int(2)},
+			},
+			wantedError: fmt.Errorf(`only one capacity provider in "capacity_providers" can have a "base"`),
+		},
+		"error if no provider has a weight": {
+			in: CapacityProviders{
+				{Provider: aws.String("FARGATE"), Base: aws.Int(1)},
+			},
+			wantedError: fmt.Errorf(`at least one capacity provider in "capacity_providers" must have a "weight" greater than 0`),
+		},
+		"error if EC2 is mixed with Fargate": {
+			in: CapacityProviders{
+				{Provider: aws.String("FARGATE"), Weight: aws.Int(1)},
+				{Provider: aws.String("EC2"), Weight: aws.Int(1)},
+			},
+			wantedError: fmt.Errorf(`capacity provider "EC2" cannot be used together with Fargate capacity providers`),
+		},
+		"success with weighted Fargate and Fargate Spot": {
+			in: CapacityProviders{
+				{Provider: aws.String("FARGATE"), Weight: aws.Int(1), Base: aws.Int(2)},
+				{Provider: aws.String("FARGATE_SPOT"), Weight: aws.Int(3)},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestValidateCapacityProviders(t *testing.T) {
+	privatePlacement := PrivateSubnetPlacement
+	publicPlacement := PublicSubnetPlacement
+	testCases := map[string]struct {
+		in          validateCapacityProvidersOpts
+		wantedError error
+	}{
+		"should return nil if no capacity providers": {
+			in: validateCapacityProvidersOpts{
+				count: AdvancedCount{
+					Spot: aws.Int(2),
+				},
+			},
+		},
+		"should return an error if count.spot is specified": {
+			in: validateCapacityProvidersOpts{
+				capacity: CapacityProviders{
+					{Provider: aws.String("FARGATE_SPOT"), Weight: aws.Int(1)},
+				},
+				count: AdvancedCount{
+					Spot: aws.Int(2),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "count.spot" and "capacity_providers"`),
+		},
+		"should return an error if count.range.spot_from is specified": {
+			in: validateCapacityProvidersOpts{
+				capacity: CapacityProviders{
+					{Provider: aws.String("FARGATE_SPOT"), Weight: aws.Int(1)},
+				},
+				count: AdvancedCount{
+					Range: Range{
+						RangeConfig: RangeConfig{
+							Min:      aws.Int(1),
+							Max:      aws.Int(10),
+							SpotFrom: aws.Int(3),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "count.range.spot_from" and "capacity_providers"`),
+		},
+		"should return an error if EC2 tasks are placed in public subnets": {
+			in: validateCapacityProvidersOpts{
+				capacity: CapacityProviders{
+					{Provider: aws.String("EC2"), Weight: aws.Int(1)},
+				},
+				placement: &publicPlacement,
+			},
+			wantedError: fmt.Errorf(`capacity provider "EC2" requires "network.vpc.placement" to be "private"`),
+		},
+		"should return nil if EC2 tasks are placed in private subnets": {
+			in: validateCapacityProvidersOpts{
+				capacity: CapacityProviders{
+					{Provider: aws.String("EC2"), Weight: aws.Int(1)},
+				},
+				placement: &privatePlacement,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateCapacityProviders(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIPNet_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     IPNet
@@ -1263,6 +1688,19 @@ func TestTaskConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "storage": `,
 		},
+		"error if fail to validate secrets": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"DB_PASSWORD": {
+						fromSecretsManager: secretsManagerSecret{
+							Name: aws.String("demo/test/mysql"),
+							Key:  aws.String(""),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate secret "DB_PASSWORD": "key" cannot be empty`),
+		},
 		"error if invalid env file": {
 			TaskConfig: TaskConfig{
 				EnvFile: aws.String("foo"),
@@ -1404,7 +1842,7 @@ func TestAdvancedCount_Validate(t *testing.T) {
 				CPU:          &mockPerc,
 				workloadType: LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time"`),
+			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time/schedules/step_scaling"`),
 		},
 		"error if fail to validate range": {
 			AdvancedCount: AdvancedCount{
@@ -1420,7 +1858,7 @@ func TestAdvancedCount_Validate(t *testing.T) {
 				Requests:     aws.Int(123),
 				workloadType: LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage, memory_percentage, requests or response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage, memory_percentage, requests, response_time, schedules or step_scaling" are specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
@@ -1429,7 +1867,7 @@ func TestAdvancedCount_Validate(t *testing.T) {
 				},
 				workloadType: LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "schedules" or "step_scaling" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
@@ -1438,7 +1876,7 @@ func TestAdvancedCount_Validate(t *testing.T) {
 				},
 				workloadType: BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage" or "memory_percentage" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "schedules" or "step_scaling" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
@@ -1447,21 +1885,21 @@ func TestAdvancedCount_Validate(t *testing.T) {
 				},
 				workloadType: WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "schedules" or "step_scaling" if "range" is specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Backend Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          &mockPerc,
 				workloadType: BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage or memory_percentage" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage, memory_percentage, schedules or step_scaling" are specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Worker Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          &mockPerc,
 				workloadType: WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage, memory_percentage or queue_delay" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage, memory_percentage, queue_delay, schedules or step_scaling" are specified`),
 		},
 		"wrap error from queue_delay on failure": {
 			AdvancedCount: AdvancedCount{
@@ -1480,6 +1918,36 @@ func TestAdvancedCount_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "queue_delay": `,
 		},
+		"error if a scheduled scaling action is invalid": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Schedule: aws.String("0 8 * * 1-5"),
+					},
+				},
+				workloadType: BackendServiceType,
+			},
+			wantedErrorMsgPrefix: `validate "schedules[0]": `,
+		},
+		"error if a step scaling policy is invalid": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				StepScaling: []StepScaling{
+					{
+						Metric: ScalingMetric{
+							Namespace: aws.String("AWS/SQS"),
+						},
+					},
+				},
+				workloadType: WorkerServiceType,
+			},
+			wantedErrorMsgPrefix: `validate "step_scaling[0]": `,
+		},
 		"error if CPU perc is not valid": {
 			AdvancedCount: AdvancedCount{
 				Range: Range{
@@ -1587,6 +2055,185 @@ func TestQueueScaling_Validate(t *testing.T) {
 	}
 }
 
+func TestScheduledScaling_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     ScheduledScaling
+		wanted error
+	}{
+		"should return an error if schedule is missing": {
+			in: ScheduledScaling{
+				Min: aws.Int(1),
+			},
+			wanted: errors.New(`"schedule" must be specified`),
+		},
+		"should return an error if schedule is not a valid cron expression": {
+			in: ScheduledScaling{
+				Schedule: aws.String("0 8 * *"),
+				Min:      aws.Int(1),
+			},
+			wanted: errors.New(`"schedule" is not valid cron, rate, or preset: expected exactly 5 fields, found 4: [0 8 * *]`),
+		},
+		"should return an error if neither min nor max is specified": {
+			in: ScheduledScaling{
+				Schedule: aws.String("@daily"),
+			},
+			wanted: errors.New(`must specify at least one of "min" or "max"`),
+		},
+		"should return an error if min is greater than max": {
+			in: ScheduledScaling{
+				Schedule: aws.String("0 8 * * 1-5"),
+				Min:      aws.Int(10),
+				Max:      aws.Int(5),
+			},
+			wanted: errors.New(`min value 10 cannot be greater than max value 5`),
+		},
+		"error if timezone is invalid": {
+			in: ScheduledScaling{
+				Schedule: aws.String("0 8 * * 1-5"),
+				Timezone: aws.String("America/Gotham"),
+				Max:      aws.Int(1),
+			},
+			wanted: errors.New(`"timezone" is not a valid IANA time zone: unknown time zone America/Gotham`),
+		},
+		"success with an AWS schedule expression": {
+			in: ScheduledScaling{
+				Schedule: aws.String("cron(0 20 ? * MON-FRI *)"),
+				Timezone: aws.String("America/New_York"),
+				Max:      aws.Int(1),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStepScaling_Validate(t *testing.T) {
+	validMetric := ScalingMetric{
+		Namespace: aws.String("AWS/SQS"),
+		Name:      aws.String("ApproximateAgeOfOldestMessage"),
+	}
+	validSteps := []ScalingStep{
+		{
+			LowerBound: aws.Float64(0),
+			Adjustment: aws.Int(2),
+		},
+	}
+	testCases := map[string]struct {
+		in     StepScaling
+		wanted error
+	}{
+		"should return an error if the metric name is missing": {
+			in: StepScaling{
+				Metric: ScalingMetric{
+					Namespace: aws.String("AWS/SQS"),
+				},
+			},
+			wanted: errors.New(`validate "metric": "name" must be specified`),
+		},
+		"should return an error if the statistic is invalid": {
+			in: StepScaling{
+				Metric: ScalingMetric{
+					Namespace: aws.String("AWS/SQS"),
+					Name:      aws.String("ApproximateAgeOfOldestMessage"),
+					Statistic: aws.String("p99"),
+				},
+			},
+			wanted: errors.New(`validate "metric": "statistic" field value 'p99' must be one of Average, Sum, Minimum, Maximum or SampleCount`),
+		},
+		"should return an error if the comparison is invalid": {
+			in: StepScaling{
+				Metric:     validMetric,
+				Comparison: aws.String("equal"),
+			},
+			wanted: errors.New(`"comparison" field value 'equal' must be one of above or below`),
+		},
+		"should return an error if threshold is missing": {
+			in: StepScaling{
+				Metric: validMetric,
+			},
+			wanted: errors.New(`"threshold" must be specified`),
+		},
+		"should return an error if period is not supported by CloudWatch": {
+			in: StepScaling{
+				Metric:    validMetric,
+				Threshold: aws.Float64(300),
+				Period:    durationp(45 * time.Second),
+			},
+			wanted: errors.New(`"period" must be 10s, 30s, or a multiple of 60s, got 45s`),
+		},
+		"should return an error if evaluation_periods is less than 1": {
+			in: StepScaling{
+				Metric:            validMetric,
+				Threshold:         aws.Float64(300),
+				EvaluationPeriods: aws.Int(0),
+			},
+			wanted: errors.New(`"evaluation_periods" must be at least 1`),
+		},
+		"should return an error if steps are missing": {
+			in: StepScaling{
+				Metric:    validMetric,
+				Threshold: aws.Float64(300),
+			},
+			wanted: errors.New(`"steps" must be specified`),
+		},
+		"should return an error if a step is missing its adjustment": {
+			in: StepScaling{
+				Metric:    validMetric,
+				Threshold: aws.Float64(300),
+				Steps: []ScalingStep{
+					{
+						LowerBound: aws.Float64(0),
+					},
+				},
+			},
+			wanted: errors.New(`validate "steps[0]": "adjustment" must be specified`),
+		},
+		"should return an error if a step's lower bound is not less than its upper bound": {
+			in: StepScaling{
+				Metric:    validMetric,
+				Threshold: aws.Float64(300),
+				Steps: []ScalingStep{
+					{
+						LowerBound: aws.Float64(100),
+						UpperBound: aws.Float64(100),
+						Adjustment: aws.Int(1),
+					},
+				},
+			},
+			wanted: errors.New(`validate "steps[0]": "lower_bound" 100 must be less than "upper_bound" 100`),
+		},
+		"success": {
+			in: StepScaling{
+				Metric:     validMetric,
+				Comparison: aws.String("above"),
+				Threshold:  aws.Float64(300),
+				Period:     durationp(30 * time.Second),
+				Steps:      validSteps,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIntRangeBand_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		IntRangeBand IntRangeBand
@@ -1696,6 +2343,18 @@ func TestStorage_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("cannot specify more than one managed volume per service"),
 		},
+		"error if an env addon name is empty": {
+			Storage: Storage{
+				EnvAddons: []string{"bucket", ""},
+			},
+			wantedError: fmt.Errorf(`validate "env_addons[1]": name cannot be empty`),
+		},
+		"error if an env addon is specified more than once": {
+			Storage: Storage{
+				EnvAddons: []string{"bucket", "bucket"},
+			},
+			wantedError: fmt.Errorf(`validate "env_addons": addon bucket is specified more than once`),
+		},
 		"valid": {
 			Storage: Storage{
 				Volumes: map[string]*Volume{
@@ -1875,6 +2534,211 @@ func TestSidecarConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestLogging_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     Logging
+		wanted error
+	}{
+		"should return nil if logging is empty": {
+			in: Logging{},
+		},
+		"should return an error if there are more than two subscription filters": {
+			in: Logging{
+				SubscriptionFilters: []LogSubscriptionFilter{
+					{Destination: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/siem")},
+					{Destination: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/audit")},
+					{Destination: aws.String("arn:aws:lambda:us-west-2:123456789012:function:alert")},
+				},
+			},
+			wanted: errors.New(`"subscriptionFilters" cannot have more than 2 filters`),
+		},
+		"should return an error if the subscription filter destination is missing": {
+			in: Logging{
+				SubscriptionFilters: []LogSubscriptionFilter{
+					{Pattern: aws.String("ERROR")},
+				},
+			},
+			wanted: errors.New(`validate "subscriptionFilters[0]": "destination" must be specified`),
+		},
+		"should return an error if the subscription filter destination is not supported": {
+			in: Logging{
+				SubscriptionFilters: []LogSubscriptionFilter{
+					{Destination: aws.String("arn:aws:sqs:us-west-2:123456789012:queue")},
+				},
+			},
+			wanted: errors.New(`validate "subscriptionFilters[0]": "destination" must be the ARN of a Kinesis data stream, Kinesis Data Firehose delivery stream, Lambda function or CloudWatch Logs destination`),
+		},
+		"should return an error if the subscription filter destination is a log group": {
+			in: Logging{
+				SubscriptionFilters: []LogSubscriptionFilter{
+					{Destination: aws.String("arn:aws:logs:us-west-2:123456789012:log-group:audit")},
+				},
+			},
+			wanted: errors.New(`validate "subscriptionFilters[0]": "destination" must be the ARN of a Kinesis data stream, Kinesis Data Firehose delivery stream, Lambda function or CloudWatch Logs destination`),
+		},
+		"should return nil if the subscription filter destination is a CloudWatch Logs destination": {
+			in: Logging{
+				SubscriptionFilters: []LogSubscriptionFilter{
+					{Destination: aws.String("arn:aws:logs:us-west-2:123456789012:destination:central-logging")},
+				},
+			},
+		},
+		"should return an error if the metric filter name is missing": {
+			in: Logging{
+				MetricFilters: []LogMetricFilter{
+					{Pattern: aws.String("ERROR")},
+				},
+			},
+			wanted: errors.New(`validate "metricFilters[0]": "name" must be specified`),
+		},
+		"should return an error if the metric filter unit is invalid": {
+			in: Logging{
+				MetricFilters: []LogMetricFilter{
+					{
+						Name: aws.String("Errors"),
+						Unit: aws.String("Apples"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "metricFilters[0]": "unit" field value 'Apples' must be a CloudWatch unit, like Count, Seconds or Bytes`),
+		},
+		"should return nil if the log group filters are configured correctly": {
+			in: Logging{
+				SubscriptionFilters: []LogSubscriptionFilter{
+					{
+						Destination: aws.String("arn:aws:lambda:us-west-2:123456789012:function:alert"),
+						Pattern:     aws.String(`{ $.level = "ERROR" }`),
+					},
+				},
+				MetricFilters: []LogMetricFilter{
+					{
+						Name:    aws.String("Errors"),
+						Pattern: aws.String(`"ERROR"`),
+						Unit:    aws.String("Count"),
+					},
+				},
+			},
+		},
+		"should return an error if both preset and destination are specified": {
+			in: Logging{
+				Destination: map[string]string{
+					"Name": "cloudwatch",
+				},
+				Preset: LoggingPreset{
+					S3: &S3LogsPreset{
+						Bucket: aws.String("my-bucket"),
+					},
+				},
+			},
+			wanted: errors.New(`must specify one, not both, of "preset" and "destination"`),
+		},
+		"should return an error if more than one preset is specified": {
+			in: Logging{
+				Preset: LoggingPreset{
+					S3: &S3LogsPreset{
+						Bucket: aws.String("my-bucket"),
+					},
+					Firehose: &FirehosePreset{
+						DeliveryStream: aws.String("my-stream"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": must specify only one of "cloudwatch", "firehose", "s3", "opensearch", "datadog" or "splunk"`),
+		},
+		"should return an error if the cloudwatch log group is missing": {
+			in: Logging{
+				Preset: LoggingPreset{
+					CloudWatch: &CloudWatchLogsPreset{
+						Retention: aws.Int(7),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "cloudwatch": "logGroup" must be specified`),
+		},
+		"should return an error if the cloudwatch retention is not positive": {
+			in: Logging{
+				Preset: LoggingPreset{
+					CloudWatch: &CloudWatchLogsPreset{
+						LogGroup:  aws.String("my-group"),
+						Retention: aws.Int(0),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "cloudwatch": "retention" must be a positive number of days`),
+		},
+		"should return an error if the firehose delivery stream is missing": {
+			in: Logging{
+				Preset: LoggingPreset{
+					Firehose: &FirehosePreset{},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "firehose": "deliveryStream" must be specified`),
+		},
+		"should return an error if the s3 bucket is missing": {
+			in: Logging{
+				Preset: LoggingPreset{
+					S3: &S3LogsPreset{
+						KeyPrefix: aws.String("logs"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "s3": "bucket" must be specified`),
+		},
+		"should return an error if the opensearch index is missing": {
+			in: Logging{
+				Preset: LoggingPreset{
+					OpenSearch: &OpenSearchPreset{
+						Domain:   aws.String("my-domain"),
+						Endpoint: aws.String("search-my-domain.us-west-2.es.amazonaws.com"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "opensearch": "index" must be specified`),
+		},
+		"should return an error if the datadog api key is missing": {
+			in: Logging{
+				Preset: LoggingPreset{
+					Datadog: &DatadogPreset{
+						Service: aws.String("api"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "datadog": "apiKey" must be specified`),
+		},
+		"should return an error if the splunk token is missing": {
+			in: Logging{
+				Preset: LoggingPreset{
+					Splunk: &SplunkPreset{
+						Host: aws.String("splunk.example.com"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "preset": validate "splunk": "token" must be specified`),
+		},
+		"should return nil if the splunk preset is configured correctly": {
+			in: Logging{
+				Preset: LoggingPreset{
+					Splunk: &SplunkPreset{
+						Host:  aws.String("splunk.example.com"),
+						Token: Secret{from: aws.String("/splunk/token")},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSidecarMountPoint_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     SidecarMountPoint
@@ -1941,6 +2805,25 @@ func TestNetworkConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "vpc": `,
 		},
+		"error if alias is not a valid DNS label": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Alias: aws.String("My_API"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": "alias" "My_API" must contain only lowercase letters`,
+		},
+		"valid service connect alias": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Alias: aws.String("api-v2"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1995,6 +2878,25 @@ func TestRdwsVpcConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "placement": `,
 		},
+		"error if security groups are specified without placement": {
+			config: rdwsVpcConfig{
+				SecurityGroups: []string{"sg-1234"},
+			},
+			wantedErrorPrefix: `"placement" must be specified if "security_groups" is specified`,
+		},
+		"error if security groups are specified with a public placement": {
+			config: rdwsVpcConfig{
+				Placement:      (*RequestDrivenWebServicePlacement)(aws.String("public")),
+				SecurityGroups: []string{"sg-1234"},
+			},
+			wantedErrorPrefix: `"security_groups" requires "placement" to be "private"`,
+		},
+		"success with security groups": {
+			config: rdwsVpcConfig{
+				Placement:      (*RequestDrivenWebServicePlacement)(aws.String("private")),
+				SecurityGroups: []string{"sg-1234"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2157,6 +3059,94 @@ func TestAppRunnerInstanceConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestPermission_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		config Permission
+
+		wantedError error
+	}{
+		"error if actions are missing": {
+			config: Permission{
+				Resources: []string{"arn:aws:s3:::my-bucket/*"},
+			},
+			wantedError: errors.New(`"actions" must be specified`),
+		},
+		"error if both resources and topics are missing": {
+			config: Permission{
+				Actions: []string{"s3:GetObject"},
+			},
+			wantedError: errors.New(`must specify at least one of "resources" or "topics" if "actions" is specified`),
+		},
+		"error if an action grants every api": {
+			config: Permission{
+				Actions:   []string{"*"},
+				Resources: []string{"arn:aws:s3:::my-bucket/*"},
+			},
+			wantedError: errors.New(`action "*" grants access to every AWS API: list the actions that the task needs instead`),
+		},
+		"error if an action is not prefixed with a service": {
+			config: Permission{
+				Actions:   []string{"GetObject"},
+				Resources: []string{"arn:aws:s3:::my-bucket/*"},
+			},
+			wantedError: errors.New(`action "GetObject" must be of the form "service:Action"`),
+		},
+		"error if a wildcard action is granted on every resource": {
+			config: Permission{
+				Actions:   []string{"s3:Get*"},
+				Resources: []string{"*"},
+			},
+			wantedError: errors.New(`"resources" cannot contain "*" when an action contains a wildcard`),
+		},
+		"error if a topic is invalid": {
+			config: Permission{
+				Actions: []string{"sns:Publish"},
+				Topics: []TopicReference{
+					{
+						Name: aws.String("orders"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "topics[0]": "service" must be specified`),
+		},
+		"ok with a wildcard action on specific resources": {
+			config: Permission{
+				Actions:   []string{"s3:Get*"},
+				Resources: []string{"arn:aws:s3:::${COPILOT_APPLICATION_NAME}-assets/*"},
+			},
+		},
+		"ok with a specific action on every resource": {
+			config: Permission{
+				Actions:   []string{"ec2:DescribeInstances"},
+				Resources: []string{"*"},
+			},
+		},
+		"ok with topics": {
+			config: Permission{
+				Actions: []string{"sns:Publish"},
+				Topics: []TopicReference{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestObservability_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		config            Observability
@@ -2191,6 +3181,60 @@ func TestObservability_Validate(t *testing.T) {
 	}
 }
 
+func TestAppRunnerScalingConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		config AppRunnerScalingConfig
+		wanted error
+	}{
+		"error if max_concurrency is out of range": {
+			config: AppRunnerScalingConfig{
+				MaxConcurrency: aws.Int(201),
+			},
+			wanted: errors.New(`"max_concurrency" must be between 1 and 200, got 201`),
+		},
+		"error if min_size is out of range": {
+			config: AppRunnerScalingConfig{
+				MinSize: aws.Int(0),
+			},
+			wanted: errors.New(`"min_size" must be between 1 and 25, got 0`),
+		},
+		"error if max_size is out of range": {
+			config: AppRunnerScalingConfig{
+				MaxSize: aws.Int(26),
+			},
+			wanted: errors.New(`"max_size" must be between 1 and 25, got 26`),
+		},
+		"error if min_size is greater than max_size": {
+			config: AppRunnerScalingConfig{
+				MinSize: aws.Int(5),
+				MaxSize: aws.Int(2),
+			},
+			wanted: errors.New(`min value 5 cannot be greater than max value 2`),
+		},
+		"ok if scaling is empty": {
+			config: AppRunnerScalingConfig{},
+		},
+		"ok with all fields": {
+			config: AppRunnerScalingConfig{
+				MaxConcurrency: aws.Int(50),
+				MinSize:        aws.Int(2),
+				MaxSize:        aws.Int(10),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, gotErr, tc.wanted.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestJobTriggerConfig_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     *JobTriggerConfig
@@ -2257,6 +3301,27 @@ func TestTopic_Validate(t *testing.T) {
 			},
 			wanted: errors.New(`"name" can only contain letters, numbers, underscores, and hypthens`),
 		},
+		"should return an error if kms key is not an ARN": {
+			in: Topic{
+				Name:   aws.String("orders"),
+				KMSKey: aws.String("alias/my-key"),
+			},
+			wanted: errors.New(`"kms_key" must be the ARN of a KMS key`),
+		},
+		"should return an error if kms key is not the ARN of a KMS key": {
+			in: Topic{
+				Name:   aws.String("orders"),
+				KMSKey: aws.String("arn:aws:sns:us-west-2:123456789012:orders"),
+			},
+			wanted: errors.New(`"kms_key" must be the ARN of a KMS key`),
+		},
+		"success with a fifo topic encrypted by a kms key": {
+			in: Topic{
+				Name:   aws.String("orders"),
+				FIFO:   aws.Bool(true),
+				KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2287,6 +3352,103 @@ func TestSubscribeConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "topics[0]": `,
 		},
+		"error if fifo and standard topics share the default queue": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+					},
+					{
+						Name:    aws.String("events"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "topics": FIFO and standard topics cannot share the default queue`,
+		},
+		"success if fifo topics have dedicated queues": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+						Queue: SQSQueueOrBool{
+							Enabled: aws.Bool(true),
+						},
+					},
+					{
+						Name:    aws.String("events"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+		},
+		"error if fail to validate events": {
+			config: SubscribeConfig{
+				Events: []EventSubscription{
+					{
+						Name: aws.String("orderPlaced"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "events[0]": "pattern" must be specified`,
+		},
+		"error if an event subscription is specified more than once": {
+			config: SubscribeConfig{
+				Events: []EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"source": ["orders"]}`),
+					},
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"source": ["payments"]}`),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "events[1]": event subscription "orderPlaced" is specified more than once`,
+		},
+		"error if events share the default queue with fifo topics": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+					},
+				},
+				Events: []EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"source": ["orders"]}`),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "events[0]": events cannot be delivered to the default queue shared with FIFO topics`,
+		},
+		"success if events have a dedicated queue next to fifo topics": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+					},
+				},
+				Events: []EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"source": ["orders"]}`),
+						Queue: SQSQueueOrBool{
+							Enabled: aws.Bool(true),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2323,6 +3485,82 @@ func TestTopicSubscription_Validate(t *testing.T) {
 			},
 			wanted: errors.New("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen"),
 		},
+		"should return an error if filter policy is not valid JSON": {
+			in: TopicSubscription{
+				Name:         aws.String("mockTopic"),
+				Service:      aws.String("mockservice"),
+				FilterPolicy: aws.String(`{"store": [`),
+			},
+			wanted: errors.New(`"filter_policy" must be a JSON object: unexpected end of JSON input`),
+		},
+		"should return an error if filter policy is not a JSON object": {
+			in: TopicSubscription{
+				Name:         aws.String("mockTopic"),
+				Service:      aws.String("mockservice"),
+				FilterPolicy: aws.String(`null`),
+			},
+			wanted: errors.New(`"filter_policy" must be a JSON object`),
+		},
+		"success with a filter policy and raw message delivery": {
+			in: TopicSubscription{
+				Name:         aws.String("mockTopic"),
+				Service:      aws.String("mockservice"),
+				FilterPolicy: aws.String(`{"store": ["example_corp"], "price_usd": [{"numeric": [">=", 100]}]}`),
+				RawDelivery:  aws.Bool(true),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventSubscription_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     EventSubscription
+		wanted error
+	}{
+		"should return an error if name is empty": {
+			in:     EventSubscription{},
+			wanted: errors.New(`"name" must be specified`),
+		},
+		"should return an error if pattern is empty": {
+			in: EventSubscription{
+				Name: aws.String("orderPlaced"),
+			},
+			wanted: errors.New(`"pattern" must be specified`),
+		},
+		"should return an error if pattern is not valid JSON": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Pattern: aws.String(`{"source": [`),
+			},
+			wanted: errors.New(`"pattern" must be a JSON object: unexpected end of JSON input`),
+		},
+		"should return an error if pattern is not a JSON object": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Pattern: aws.String(`["orders"]`),
+			},
+			wanted: errors.New(`"pattern" must be a JSON object: json: cannot unmarshal array into Go value of type map[string]interface {}`),
+		},
+		"success with a dedicated queue": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Pattern: aws.String(`{"source": ["orders"], "detail-type": ["OrderPlaced"]}`),
+				Queue: SQSQueueOrBool{
+					Enabled: aws.Bool(true),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2583,6 +3821,24 @@ func TestValidateWindows(t *testing.T) {
 			},
 			wantedError: errors.New(`'EFS' is not supported when deploying a Windows container`),
 		},
+		"error if EC2 capacity provider specified": {
+			in: validateWindowsOpts{
+				ec2Enabled: true,
+			},
+			wantedError: errors.New(`'EC2' capacity provider is not supported when deploying a Windows container`),
+		},
+		"error if tracing is enabled": {
+			in: validateWindowsOpts{
+				tracingEnabled: true,
+			},
+			wantedError: errors.New(`'observability.tracing' is not supported when deploying a Windows container`),
+		},
+		"error if Service Connect is enabled": {
+			in: validateWindowsOpts{
+				serviceConnectEnabled: true,
+			},
+			wantedError: errors.New(`'network.connect' is not supported when deploying a Windows container`),
+		},
 		"should return nil if neither efs nor exec specified": {
 			in: validateWindowsOpts{
 				execEnabled: false,
@@ -2620,6 +3876,12 @@ func TestValidateARM(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`'Fargate Spot' is not supported when deploying on ARM architecture`),
 		},
+		"should return an error if the Fargate Spot capacity provider is specified": {
+			in: validateARMOpts{
+				SpotCapacityProvider: true,
+			},
+			wantedError: fmt.Errorf(`'Fargate Spot' is not supported when deploying on ARM architecture`),
+		},
 		"should return nil if Spot not specified": {
 			in: validateARMOpts{
 				Spot: nil,
@@ -45,25 +45,40 @@ type WorkerServiceConfig struct {
 	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	Subscribe        SubscribeConfig           `yaml:"subscribe"`
 	PublishConfig    PublishConfig             `yaml:"publish"`
+	Observability    Observability             `yaml:"observability"`
+	Permissions      []Permission              `yaml:"permissions"`
 	Network          NetworkConfig             `yaml:"network"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Capacity         CapacityProviders         `yaml:"capacity_providers"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
 type SubscribeConfig struct {
 	Topics []TopicSubscription `yaml:"topics"`
+	Events []EventSubscription `yaml:"events"`
 	Queue  SQSQueue            `yaml:"queue"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *SubscribeConfig) IsEmpty() bool {
-	return s.Topics == nil && s.Queue.IsEmpty()
+	return s.Topics == nil && s.Events == nil && s.Queue.IsEmpty()
 }
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
 type TopicSubscription struct {
+	Name         *string        `yaml:"name"`
+	Service      *string        `yaml:"service"`
+	Queue        SQSQueueOrBool `yaml:"queue"`
+	FIFO         *bool          `yaml:"fifo"`
+	FilterPolicy *string        `yaml:"filter_policy"`
+	RawDelivery  *bool          `yaml:"raw_delivery"`
+}
+
+// EventSubscription represents the configurable options for setting up an EventBridge rule
+// on the environment's event bus.
+type EventSubscription struct {
 	Name    *string        `yaml:"name"`
-	Service *string        `yaml:"service"`
+	Pattern *string        `yaml:"pattern"`
 	Queue   SQSQueueOrBool `yaml:"queue"`
 }
 
@@ -100,6 +115,16 @@ func (q *SQSQueueOrBool) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the SQSQueueOrBool
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (q SQSQueueOrBool) MarshalYAML() (interface{}, error) {
+	if !q.Advanced.IsEmpty() {
+		return q.Advanced, nil
+	}
+	return q.Enabled, nil
+}
+
 // SQSQueue represents the configurable options for setting up a SQS Queue.
 type SQSQueue struct {
 	Retention  *time.Duration  `yaml:"retention"`
@@ -181,6 +206,11 @@ func (s *WorkerService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
 }
 
+// ContainerSecrets returns the secrets injected into the main container of the service.
+func (s *WorkerService) ContainerSecrets() map[string]Secret {
+	return s.TaskConfig.Secrets
+}
+
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
 // receives messages from.
 func (s *WorkerService) Subscriptions() []TopicSubscription {
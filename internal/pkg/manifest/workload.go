@@ -44,6 +44,7 @@ var (
 	errUnmarshalEntryPoint = errors.New(`unable to unmarshal "entrypoint" into string or slice of strings`)
 	errUnmarshalAlias      = errors.New(`unable to unmarshal "alias" into string or slice of strings`)
 	errUnmarshalCommand    = errors.New(`unable to unmarshal "command" into string or slice of strings`)
+	errUnmarshalConnect    = errors.New(`unable to unmarshal "connect" field into boolean or Service Connect configuration`)
 )
 
 // WorkloadTypes returns the list of all manifest types.
@@ -134,6 +135,11 @@ func (i *Image) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// marshalableImage is an Image without the custom unmarshaling logic.
+// yaml.v3 skips inlined fields that implement yaml.Unmarshaler when marshaling, so the types that
+// inline an Image marshal it as a marshalableImage instead.
+type marshalableImage Image
+
 // GetLocation returns the location of the image.
 func (i Image) GetLocation() string {
 	return aws.StringValue(i.Location)
@@ -235,6 +241,13 @@ func (e *EntryPointOverride) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the EntryPointOverride
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (e EntryPointOverride) MarshalYAML() (interface{}, error) {
+	return marshalYAMLFromStringSliceOrString(stringSliceOrString(e)), nil
+}
+
 // ToStringSlice converts an EntryPointOverride to a slice of string using shell-style rules.
 func (e *EntryPointOverride) ToStringSlice() ([]string, error) {
 	out, err := toStringSlice((*stringSliceOrString)(e))
@@ -254,6 +267,13 @@ func (c *CommandOverride) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the CommandOverride
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (c CommandOverride) MarshalYAML() (interface{}, error) {
+	return marshalYAMLFromStringSliceOrString(stringSliceOrString(c)), nil
+}
+
 // ToStringSlice converts an CommandOverride to a slice of string using shell-style rules.
 func (c *CommandOverride) ToStringSlice() ([]string, error) {
 	out, err := toStringSlice((*stringSliceOrString)(c))
@@ -287,6 +307,16 @@ func unmarshalYAMLToStringSliceOrString(s *stringSliceOrString, value *yaml.Node
 	return value.Decode(&s.String)
 }
 
+func marshalYAMLFromStringSliceOrString(s stringSliceOrString) interface{} {
+	if s.String != nil {
+		return s.String
+	}
+	if s.StringSlice != nil {
+		return s.StringSlice
+	}
+	return nil
+}
+
 func toStringSlice(s *stringSliceOrString) ([]string, error) {
 	if s.StringSlice != nil {
 		return s.StringSlice, nil
@@ -343,6 +373,16 @@ func (b *BuildArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the BuildArgsOrString
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (b BuildArgsOrString) MarshalYAML() (interface{}, error) {
+	if !b.BuildArgs.isEmpty() {
+		return b.BuildArgs, nil
+	}
+	return b.BuildString, nil
+}
+
 // DockerBuildArgs represents the options specifiable under the "build" field
 // of Docker Compose services. For more information, see:
 // https://docs.docker.com/compose/compose-file/#build
@@ -363,22 +403,49 @@ func (b *DockerBuildArgs) isEmpty() bool {
 
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
-	Topics []Topic `yaml:"topics"`
+	Topics   []Topic `yaml:"topics"`
+	EventBus *bool   `yaml:"event_bus"`
 }
 
 // Topic represents the configurable options for setting up a SNS Topic.
 type Topic struct {
-	Name *string `yaml:"name"`
+	Name   *string `yaml:"name"`
+	FIFO   *bool   `yaml:"fifo"`
+	KMSKey *string `yaml:"kms_key"`
+}
+
+// Observability holds configuration for observability to the service.
+type Observability struct {
+	Tracing *string `yaml:"tracing"`
+}
+
+func (o *Observability) isEmpty() bool {
+	return o.Tracing == nil
+}
+
+// Permission represents an IAM policy statement that allows the task role to call AWS APIs.
+type Permission struct {
+	Actions   []string         `yaml:"actions"`
+	Resources []string         `yaml:"resources"`
+	Topics    []TopicReference `yaml:"topics"`
+}
+
+// TopicReference refers to an SNS topic published by a workload in the same environment.
+type TopicReference struct {
+	Name    *string `yaml:"name"`
+	Service *string `yaml:"service"`
+	FIFO    *bool   `yaml:"fifo"`
 }
 
 // NetworkConfig represents options for network connection to AWS resources within a VPC.
 type NetworkConfig struct {
-	VPC vpcConfig `yaml:"vpc"`
+	VPC     vpcConfig                `yaml:"vpc"`
+	Connect ServiceConnectBoolOrArgs `yaml:"connect"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (c *NetworkConfig) IsEmpty() bool {
-	return c.VPC.isEmpty()
+	return c.VPC.isEmpty() && c.Connect.IsEmpty()
 }
 
 // UnmarshalYAML ensures that a NetworkConfig always defaults to public subnets.
@@ -402,6 +469,67 @@ func (c *NetworkConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// ServiceConnectBoolOrArgs represents ECS Service Connect configuration.
+type ServiceConnectBoolOrArgs struct {
+	EnableServiceConnect *bool
+	ServiceConnectArgs
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (s *ServiceConnectBoolOrArgs) IsEmpty() bool {
+	return s.EnableServiceConnect == nil && s.ServiceConnectArgs.IsEmpty()
+}
+
+// Enabled returns whether the workload joins the environment's Service Connect namespace.
+func (s *ServiceConnectBoolOrArgs) Enabled() bool {
+	return aws.BoolValue(s.EnableServiceConnect) || !s.ServiceConnectArgs.IsEmpty()
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the ServiceConnectBoolOrArgs
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (s *ServiceConnectBoolOrArgs) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&s.ServiceConnectArgs); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !s.ServiceConnectArgs.IsEmpty() {
+		// Unmarshaled successfully to s.ServiceConnectArgs, reset s.EnableServiceConnect, and return.
+		s.EnableServiceConnect = nil
+		return nil
+	}
+
+	if err := value.Decode(&s.EnableServiceConnect); err != nil {
+		return errUnmarshalConnect
+	}
+	return nil
+}
+
+// MarshalYAML overrides the default YAML marshaling logic for the ServiceConnectBoolOrArgs
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (s ServiceConnectBoolOrArgs) MarshalYAML() (interface{}, error) {
+	if !s.ServiceConnectArgs.IsEmpty() {
+		return s.ServiceConnectArgs, nil
+	}
+	return s.EnableServiceConnect, nil
+}
+
+// ServiceConnectArgs includes the advanced configuration for ECS Service Connect.
+type ServiceConnectArgs struct {
+	Alias *string `yaml:"alias"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (s *ServiceConnectArgs) IsEmpty() bool {
+	return s.Alias == nil
+}
+
 // Placement represents where to place tasks (public or private subnets).
 type Placement string
 
@@ -445,6 +573,16 @@ func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the PlatformArgsOrString
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (p PlatformArgsOrString) MarshalYAML() (interface{}, error) {
+	if !p.PlatformArgs.isEmpty() {
+		return p.PlatformArgs, nil
+	}
+	return p.PlatformString, nil
+}
+
 // OS returns the operating system family.
 func (p *PlatformArgsOrString) OS() string {
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
@@ -86,6 +86,40 @@ type ImageWithOptionalPort struct {
 	Port  *uint16 `yaml:"port"`
 }
 
+// MarshalYAML implements the yaml.Marshaler (v3) interface to marshal the inlined image fields.
+func (i ImageWithHealthcheck) MarshalYAML() (interface{}, error) {
+	return struct {
+		marshalableImage `yaml:",inline"`
+		HealthCheck      ContainerHealthCheck `yaml:"healthcheck"`
+	}{marshalableImage(i.Image), i.HealthCheck}, nil
+}
+
+// MarshalYAML implements the yaml.Marshaler (v3) interface to marshal the inlined image fields.
+func (i ImageWithPortAndHealthcheck) MarshalYAML() (interface{}, error) {
+	return struct {
+		marshalableImage `yaml:",inline"`
+		Port             *uint16              `yaml:"port"`
+		HealthCheck      ContainerHealthCheck `yaml:"healthcheck"`
+	}{marshalableImage(i.Image), i.Port, i.HealthCheck}, nil
+}
+
+// MarshalYAML implements the yaml.Marshaler (v3) interface to marshal the inlined image fields.
+func (i ImageWithHealthcheckAndOptionalPort) MarshalYAML() (interface{}, error) {
+	return struct {
+		marshalableImage `yaml:",inline"`
+		Port             *uint16              `yaml:"port"`
+		HealthCheck      ContainerHealthCheck `yaml:"healthcheck"`
+	}{marshalableImage(i.Image), i.Port, i.HealthCheck}, nil
+}
+
+// MarshalYAML implements the yaml.Marshaler (v3) interface to marshal the inlined image fields.
+func (i ImageWithOptionalPort) MarshalYAML() (interface{}, error) {
+	return struct {
+		marshalableImage `yaml:",inline"`
+		Port             *uint16 `yaml:"port"`
+	}{marshalableImage(i.Image), i.Port}, nil
+}
+
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
 	CPU            *int                 `yaml:"cpu"`
@@ -136,15 +170,24 @@ func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
 			return err
 		}
 	}
-	if !s.fromSecretsManager.IsEmpty() { // Successfully unmarshaled to a secretsmanager name.
+	if s.fromSecretsManager.Name != nil { // Successfully unmarshaled to a secretsmanager name.
 		return nil
 	}
+	s.fromSecretsManager = secretsManagerSecret{}
 	if err := value.Decode(&s.from); err != nil { // Otherwise, try decoding the simple form.
 		return errors.New(`cannot marshal "secret" field to a string or "secretsmanager" object`)
 	}
 	return nil
 }
 
+// MarshalYAML implements the yaml.Marshaler (v3) interface to marshal the secret back to the form that it was specified in.
+func (s Secret) MarshalYAML() (interface{}, error) {
+	if s.IsSecretsManagerName() {
+		return s.fromSecretsManager, nil
+	}
+	return s.from, nil
+}
+
 // IsSecretsManagerName returns true if the secret refers to the name of a secret stored in SecretsManager.
 func (s *Secret) IsSecretsManagerName() bool {
 	return !s.fromSecretsManager.IsEmpty()
@@ -158,19 +201,28 @@ func (s *Secret) Value() string {
 	return aws.StringValue(s.from)
 }
 
+// SecretsManagerKey returns the JSON key to select from a SecretsManager secret, or an empty string to use the whole secret.
+func (s *Secret) SecretsManagerKey() string {
+	return aws.StringValue(s.fromSecretsManager.Key)
+}
+
 // secretsManagerSecret represents the name of a secret stored in SecretsManager.
 type secretsManagerSecret struct {
 	Name *string `yaml:"secretsmanager"`
+	Key  *string `yaml:"key"` // Optional. The key of the value to select from a JSON secret.
 }
 
 // IsEmpty returns true if all the fields in secretsManagerSecret have the zero value.
 func (s secretsManagerSecret) IsEmpty() bool {
-	return s.Name == nil
+	return s.Name == nil && s.Key == nil
 }
 
-// Logging holds configuration for Firelens to route your logs.
+// Logging holds configuration for the log group of the workload and for Firelens to route your logs.
 type Logging struct {
-	Retention      *int              `yaml:"retention"`
+	Retention           *int                    `yaml:"retention"`
+	SubscriptionFilters []LogSubscriptionFilter `yaml:"subscriptionFilters"`
+	MetricFilters       []LogMetricFilter       `yaml:"metricFilters"`
+
 	Image          *string           `yaml:"image"`
 	Destination    map[string]string `yaml:"destination,flow"`
 	EnableMetadata *bool             `yaml:"enableMetadata"`
@@ -178,12 +230,15 @@ type Logging struct {
 	ConfigFile     *string           `yaml:"configFilePath"`
 	Variables      map[string]string `yaml:"variables"`
 	Secrets        map[string]Secret `yaml:"secrets"`
+	Preset         LoggingPreset     `yaml:"preset"`
 }
 
-// IsEmpty returns empty if the struct has all zero members.
+// IsEmpty returns true if Firelens is not configured.
+// The retention and the filters of the log group are configured independently from Firelens.
 func (lc *Logging) IsEmpty() bool {
 	return lc.Image == nil && lc.Destination == nil && lc.EnableMetadata == nil &&
-		lc.SecretOptions == nil && lc.ConfigFile == nil && lc.Variables == nil && lc.Secrets == nil
+		lc.SecretOptions == nil && lc.ConfigFile == nil && lc.Variables == nil && lc.Secrets == nil &&
+		lc.Preset.IsEmpty()
 }
 
 // LogImage returns the default Fluent Bit image if not otherwise configured.
@@ -203,6 +258,84 @@ func (lc *Logging) GetEnableMetadata() *string {
 	return aws.String(strconv.FormatBool(*lc.EnableMetadata))
 }
 
+// LogSubscriptionFilter forwards the log events of the workload's log group that match the pattern
+// to a Kinesis data stream, a Kinesis Data Firehose delivery stream, or a Lambda function.
+type LogSubscriptionFilter struct {
+	Destination *string `yaml:"destination"` // ARN of the destination.
+	Pattern     *string `yaml:"pattern"`
+}
+
+// LogMetricFilter publishes a CloudWatch metric from the log events of the workload's log group that match the pattern.
+type LogMetricFilter struct {
+	Name         *string  `yaml:"name"`
+	Pattern      *string  `yaml:"pattern"`
+	Namespace    *string  `yaml:"namespace"`
+	Value        *string  `yaml:"value"`
+	DefaultValue *float64 `yaml:"defaultValue"`
+	Unit         *string  `yaml:"unit"`
+}
+
+// LoggingPreset holds a named log destination that is expanded into the Fluent Bit output options
+// and the task role permissions needed to route logs to it. Only one destination can be specified.
+type LoggingPreset struct {
+	CloudWatch *CloudWatchLogsPreset `yaml:"cloudwatch"`
+	Firehose   *FirehosePreset       `yaml:"firehose"`
+	S3         *S3LogsPreset         `yaml:"s3"`
+	OpenSearch *OpenSearchPreset     `yaml:"opensearch"`
+	Datadog    *DatadogPreset        `yaml:"datadog"`
+	Splunk     *SplunkPreset         `yaml:"splunk"`
+}
+
+// IsEmpty returns true if no logging preset is specified.
+func (p *LoggingPreset) IsEmpty() bool {
+	return p.CloudWatch == nil && p.Firehose == nil && p.S3 == nil &&
+		p.OpenSearch == nil && p.Datadog == nil && p.Splunk == nil
+}
+
+// CloudWatchLogsPreset routes logs to a custom CloudWatch log group.
+type CloudWatchLogsPreset struct {
+	LogGroup     *string `yaml:"logGroup"`
+	StreamPrefix *string `yaml:"streamPrefix"`
+	Retention    *int    `yaml:"retention"`
+	Region       *string `yaml:"region"`
+}
+
+// FirehosePreset routes logs to a Kinesis Data Firehose delivery stream.
+type FirehosePreset struct {
+	DeliveryStream *string `yaml:"deliveryStream"`
+	Region         *string `yaml:"region"`
+}
+
+// S3LogsPreset routes logs to an S3 bucket.
+type S3LogsPreset struct {
+	Bucket    *string `yaml:"bucket"`
+	KeyPrefix *string `yaml:"keyPrefix"`
+	Region    *string `yaml:"region"`
+}
+
+// OpenSearchPreset routes logs to an index of an Amazon OpenSearch Service domain.
+type OpenSearchPreset struct {
+	Domain   *string `yaml:"domain"`
+	Endpoint *string `yaml:"endpoint"`
+	Index    *string `yaml:"index"`
+	Region   *string `yaml:"region"`
+}
+
+// DatadogPreset routes logs to the Datadog HTTP intake endpoint.
+type DatadogPreset struct {
+	APIKey  Secret  `yaml:"apiKey"`
+	Site    *string `yaml:"site"`
+	Service *string `yaml:"service"`
+	Source  *string `yaml:"source"`
+}
+
+// SplunkPreset routes logs to a Splunk HTTP Event Collector.
+type SplunkPreset struct {
+	Host  *string `yaml:"host"`
+	Port  *int    `yaml:"port"`
+	Token Secret  `yaml:"token"`
+}
+
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
 	Port          *string              `yaml:"port"`
@@ -254,6 +387,16 @@ func (e *ExecuteCommand) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML overrides the default YAML marshaling logic for the ExecuteCommand
+// struct, marshaling it back to the form that it was specified in.
+// This method implements the yaml.Marshaler (v3) interface.
+func (e ExecuteCommand) MarshalYAML() (interface{}, error) {
+	if !e.Config.IsEmpty() {
+		return e.Config, nil
+	}
+	return e.Enable, nil
+}
+
 // ExecuteCommandConfig represents the configuration for ECS Execute Command.
 type ExecuteCommandConfig struct {
 	Enable *bool `yaml:"enable"`
@@ -101,6 +101,11 @@ func TestSecret_UnmarshalYAML(t *testing.T) {
 			in:     "secretsmanager: aes128-1a2b3c",
 			wanted: Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("aes128-1a2b3c")}},
 		},
+		"should be able to unmarshal a key of a SecretsManager JSON secret": {
+			in: `secretsmanager: demo/test/mysql
+key: password`,
+			wanted: Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/test/mysql"), Key: aws.String("password")}},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -163,6 +168,29 @@ func TestSecret_Value(t *testing.T) {
 	}
 }
 
+func TestSecret_SecretsManagerKey(t *testing.T) {
+	testCases := map[string]struct {
+		in     Secret
+		wanted string
+	}{
+		"should return an empty string if the secret is just a string": {
+			in: Secret{from: aws.String("/github/token")},
+		},
+		"should return an empty string if no key is selected": {
+			in: Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("aes128-1a2b3c")}},
+		},
+		"should return the key selected from a SecretsManager JSON secret": {
+			in:     Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/test/mysql"), Key: aws.String("password")}},
+			wanted: "password",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.SecretsManagerKey())
+		})
+	}
+}
+
 func TestSecretsManagerSecret_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		in     secretsManagerSecret
@@ -198,6 +226,15 @@ func TestLogging_IsEmpty(t *testing.T) {
 				},
 			},
 		},
+		"logging with only a preset": {
+			in: Logging{
+				Preset: LoggingPreset{
+					S3: &S3LogsPreset{
+						Bucket: aws.String("my-bucket"),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -602,6 +602,13 @@ func TestNetworkConfig_IsEmpty(t *testing.T) {
 				},
 			},
 		},
+		"non empty service connect config": {
+			in: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					EnableServiceConnect: aws.Bool(true),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -648,6 +655,44 @@ network:
 				},
 			},
 		},
+		"unmarshals successfully for service connect enabled with a boolean": {
+			data: `
+network:
+  connect: true
+`,
+			wantedConfig: &NetworkConfig{
+				VPC: vpcConfig{
+					Placement: &PublicSubnetPlacement,
+				},
+				Connect: ServiceConnectBoolOrArgs{
+					EnableServiceConnect: aws.Bool(true),
+				},
+			},
+		},
+		"unmarshals successfully for service connect with an alias": {
+			data: `
+network:
+  connect:
+    alias: api
+`,
+			wantedConfig: &NetworkConfig{
+				VPC: vpcConfig{
+					Placement: &PublicSubnetPlacement,
+				},
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Alias: aws.String("api"),
+					},
+				},
+			},
+		},
+		"returns error if connect is not a boolean or a map": {
+			data: `
+network:
+  connect: api
+`,
+			wantedErr: errUnmarshalConnect,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -770,3 +815,181 @@ topics: abc
 		})
 	}
 }
+
+func TestWorkloadManifest_MarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedContent []string
+	}{
+		"load balanced web service": {
+			in: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build:
+    dockerfile: ./frontend/Dockerfile
+    args:
+      GO_VERSION: "1.18"
+  port: 80
+  depends_on:
+    nginx: start
+http:
+  path: '/'
+  healthcheck: '/_healthz'
+  alias: ['example.com', 'v1.example.com']
+entrypoint: "/bin/sh -c"
+command: ["echo", "hello"]
+cpu: 256
+memory: 512
+platform: linux/arm64
+count:
+  range:
+    min: 1
+    max: 10
+    spot_from: 2
+  cpu_percentage: 70
+exec:
+  enable: true
+secrets:
+  GITHUB_TOKEN: GITHUB_TOKEN
+  DB:
+    secretsmanager: 'demo/test/mysql'
+    key: password
+network:
+  connect:
+    alias: frontend
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx
+    port: 80
+`,
+			wantedContent: []string{
+				"dockerfile: ./frontend/Dockerfile",
+				"healthcheck: /_healthz",
+				"alias: [example.com, v1.example.com]",
+				"entrypoint: /bin/sh -c",
+				"platform: linux/arm64",
+				"spot_from: 2",
+				"cpu_percentage: 70",
+				"secretsmanager: demo/test/mysql",
+				"alias: frontend",
+				"image: public.ecr.aws/nginx/nginx",
+			},
+		},
+		"backend service": {
+			in: `
+name: api
+type: Backend Service
+image:
+  location: 123456789012.dkr.ecr.us-west-2.amazonaws.com/api:latest
+  port: 8080
+  healthcheck:
+    command: ["CMD-SHELL", "curl -f http://localhost:8080 || exit 1"]
+count: 2
+exec: true
+network:
+  connect: true
+storage:
+  volumes:
+    efs:
+      path: /etc/data
+      efs: true
+`,
+			wantedContent: []string{
+				"location: '123456789012.dkr.ecr.us-west-2.amazonaws.com/api:latest'",
+				"port: 8080",
+				"count: 2",
+				"exec: true",
+				"connect: true",
+				"efs: true",
+			},
+		},
+		"worker service": {
+			in: `
+name: processor
+type: Worker Service
+image:
+  build: ./processor/Dockerfile
+subscribe:
+  topics:
+    - name: orders
+      service: api
+      queue: true
+  queue:
+    retention: 96h
+platform:
+  osfamily: linux
+  architecture: x86_64
+count:
+  range: 1-5
+  queue_delay:
+    acceptable_latency: 10m
+    msg_processing_time: 250ms
+`,
+			wantedContent: []string{
+				"build: ./processor/Dockerfile",
+				"queue: true",
+				"retention: 96h0m0s",
+				"osfamily: linux",
+				"range: 1-5",
+				"acceptable_latency: 10m0s",
+			},
+		},
+		"request-driven web service": {
+			in: `
+name: web
+type: Request-Driven Web Service
+image:
+  build: ./web/Dockerfile
+  port: 80
+http:
+  healthcheck:
+    path: '/'
+    healthy_threshold: 3
+`,
+			wantedContent: []string{
+				"build: ./web/Dockerfile",
+				"port: 80",
+				"healthy_threshold: 3",
+			},
+		},
+		"scheduled job": {
+			in: `
+name: report
+type: Scheduled Job
+image:
+  build: ./report/Dockerfile
+on:
+  schedule: "@daily"
+command: ./run.sh
+`,
+			wantedContent: []string{
+				"build: ./report/Dockerfile",
+				"schedule: '@daily'",
+				"command: ./run.sh",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := UnmarshalWorkload([]byte(tc.in))
+			require.NoError(t, err)
+
+			// WHEN
+			out, err := yaml.Marshal(mft)
+			require.NoError(t, err)
+
+			// THEN
+			for _, wanted := range tc.wantedContent {
+				require.Contains(t, string(out), wanted)
+			}
+			roundTripped, err := UnmarshalWorkload(out)
+			require.NoError(t, err)
+			roundTrippedOut, err := yaml.Marshal(roundTripped)
+			require.NoError(t, err)
+			require.Equal(t, string(out), string(roundTrippedOut), "marshaled manifest should unmarshal to the same manifest")
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy evaluates organization-wide rules against workload manifests and CloudFormation templates.
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severities of a rule.
+const (
+	SeverityWarn  = "warn"
+	SeverityBlock = "block"
+)
+
+// Targets that a rule can be evaluated against.
+const (
+	TargetManifest = "manifest"
+	TargetTemplate = "template"
+)
+
+const manifestTypeKey = "type"
+
+// Policies is a set of rules that workloads are checked against before they're deployed.
+type Policies struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Parse unmarshals and validates the policy rules in dat.
+func Parse(dat []byte) (*Policies, error) {
+	var p Policies
+	if err := yaml.Unmarshal(dat, &p); err != nil {
+		return nil, fmt.Errorf("unmarshal policies: %w", err)
+	}
+	names := make(map[string]bool)
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("validate rule %d: %w", i, err)
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("rule name %q is used more than once", rule.Name)
+		}
+		names[rule.Name] = true
+		if rule.Severity == "" {
+			rule.Severity = SeverityBlock
+		}
+	}
+	return &p, nil
+}
+
+// Merge adds the rules of other to the policies.
+// A rule of other can't reuse the name of an existing rule, so that it can't be mistaken for the original rule.
+func (p *Policies) Merge(other *Policies) error {
+	names := make(map[string]bool)
+	for _, rule := range p.Rules {
+		names[rule.Name] = true
+	}
+	for _, rule := range other.Rules {
+		if names[rule.Name] {
+			return fmt.Errorf("rule name %q is used more than once", rule.Name)
+		}
+	}
+	p.Rules = append(p.Rules, other.Rules...)
+	return nil
+}
+
+// Input holds the workload content that the rules are evaluated against.
+type Input struct {
+	Environment string // Name of the environment that the workload is deployed to.
+	Manifest    []byte // Workload manifest with the environment overrides applied.
+	Template    []byte // Rendered CloudFormation template of the workload.
+}
+
+// Violation is a rule that a workload fails.
+type Violation struct {
+	Rule     string
+	Severity string
+	Message  string
+}
+
+// Evaluate returns the rules that the workload fails.
+func (p *Policies) Evaluate(in Input) ([]Violation, error) {
+	mft, err := unmarshalDocument(in.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	tpl, err := unmarshalDocument(in.Template)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	var wkldType string
+	if nodes := find(mft, []pathSegment{{key: manifestTypeKey}}); len(nodes) == 1 {
+		wkldType = nodes[0].Value
+	}
+
+	var violations []Violation
+	for _, rule := range p.Rules {
+		if !rule.appliesTo(in.Environment, wkldType) {
+			continue
+		}
+		doc := mft
+		if rule.Target == TargetTemplate {
+			doc = tpl
+		}
+		if rule.Condition.isSatisfiedBy(find(doc, rule.segments)) {
+			continue
+		}
+		msg := rule.Message
+		if msg == "" {
+			msg = rule.Condition.describe(rule.Path)
+		}
+		violations = append(violations, Violation{
+			Rule:     rule.Name,
+			Severity: rule.Severity,
+			Message:  msg,
+		})
+	}
+	return violations, nil
+}
+
+// ErrViolations occurs when a workload fails rules that block its deployment.
+type ErrViolations struct {
+	Violations []Violation
+}
+
+func (e *ErrViolations) Error() string {
+	failures := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		failures[i] = fmt.Sprintf("%s: %s", v.Rule, v.Message)
+	}
+	return fmt.Sprintf("blocked by policy rules: %s", strings.Join(failures, "; "))
+}
+
+func unmarshalDocument(dat []byte) (*yaml.Node, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(dat, &node); err != nil {
+		return nil, err
+	}
+	if node.Kind == yaml.DocumentNode && len(node.Content) != 0 {
+		return node.Content[0], nil
+	}
+	return &node, nil
+}
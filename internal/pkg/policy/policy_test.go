@@ -0,0 +1,363 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedRules []string
+		wantedErr   string
+	}{
+		"error if the policies are not valid YAML": {
+			in:        "rules: {",
+			wantedErr: "unmarshal policies: yaml: line 1: did not find expected node content",
+		},
+		"error if a rule has no name": {
+			in: `
+rules:
+  - target: manifest
+    path: memory
+    max: 8192`,
+			wantedErr: `validate rule 0: "name" must be specified`,
+		},
+		"error if the severity is invalid": {
+			in: `
+rules:
+  - name: max-memory
+    severity: error
+    target: manifest
+    path: memory
+    max: 8192`,
+			wantedErr: `validate rule 0: "severity" of rule max-memory must be one of "warn" or "block"`,
+		},
+		"error if the target is invalid": {
+			in: `
+rules:
+  - name: max-memory
+    target: task
+    path: memory
+    max: 8192`,
+			wantedErr: `validate rule 0: "target" of rule max-memory must be one of "manifest" or "template"`,
+		},
+		"error if the path is invalid": {
+			in: `
+rules:
+  - name: alarms
+    target: template
+    path: Resources..Type
+    includes: AWS::CloudWatch::Alarm`,
+			wantedErr: `validate rule 0: "path" of rule alarms: invalid path segment "": segments must be of the form "key", "*", "array[0]" or "array[*]"`,
+		},
+		"error if there is no condition": {
+			in: `
+rules:
+  - name: max-memory
+    target: manifest
+    path: memory`,
+			wantedErr: `validate rule 0: condition of rule max-memory: exactly one of "exists", "equals", "not_equals", "one_of", "matches", "min", "max" or "includes" must be specified`,
+		},
+		"error if there are multiple conditions": {
+			in: `
+rules:
+  - name: memory
+    target: manifest
+    path: memory
+    min: 512
+    max: 8192`,
+			wantedErr: `validate rule 0: condition of rule memory: exactly one of "exists", "equals", "not_equals", "one_of", "matches", "min", "max" or "includes" must be specified`,
+		},
+		"error if the regular expression is invalid": {
+			in: `
+rules:
+  - name: ecr-images
+    target: manifest
+    path: image.location
+    matches: "(["`,
+			wantedErr: "validate rule 0: condition of rule ecr-images: parse \"matches\": error parsing regexp: missing closing ]: `[`",
+		},
+		"error if rule names are duplicated": {
+			in: `
+rules:
+  - name: memory
+    target: manifest
+    path: memory
+    max: 8192
+  - name: memory
+    target: manifest
+    path: memory
+    min: 512`,
+			wantedErr: `rule name "memory" is used more than once`,
+		},
+		"parses valid rules": {
+			in: `
+rules:
+  - name: max-memory
+    target: manifest
+    path: memory
+    max: 8192
+  - name: alarms
+    severity: warn
+    target: template
+    path: Resources.*.Type
+    includes: AWS::CloudWatch::Alarm`,
+			wantedRules: []string{"max-memory", "alarms"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			policies, err := Parse([]byte(tc.in))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, rule := range policies.Rules {
+				names = append(names, rule.Name)
+			}
+			require.Equal(t, tc.wantedRules, names)
+			require.Equal(t, SeverityBlock, policies.Rules[0].Severity, "severity should default to block")
+		})
+	}
+}
+
+func TestPolicies_Evaluate(t *testing.T) {
+	const (
+		mockManifest = `
+name: api
+type: Load Balanced Web Service
+image:
+  location: 123456789012.dkr.ecr.us-west-2.amazonaws.com/api:latest
+memory: 16384
+http:
+  path: '/'
+count: null
+storage:
+  volumes: {}
+  ephemeral: null
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx
+  firelens:
+    image: 123456789012.dkr.ecr.us-west-2.amazonaws.com/firelens
+`
+		mockTemplate = `
+Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: !Ref WorkloadName
+          Memory: 512
+        - Name: nginx
+          Memory: 1024
+  Service:
+    Type: AWS::ECS::Service
+`
+	)
+	testCases := map[string]struct {
+		inPolicies string
+		inEnv      string
+
+		wantedViolations []Violation
+		wantedErr        string
+	}{
+		"error if the template is not valid YAML": {
+			inPolicies: "rules: []",
+			wantedErr:  "unmarshal template: yaml: line 1: did not find expected node content",
+		},
+		"returns the rules that the manifest fails": {
+			inPolicies: `
+rules:
+  - name: max-memory
+    message: Tasks can't use more than 8 GB of memory.
+    target: manifest
+    path: memory
+    max: 8192
+  - name: ecr-images
+    severity: warn
+    target: manifest
+    path: sidecars.*.image
+    matches: '^123456789012\.dkr\.ecr\.'
+  - name: main-ecr-image
+    target: manifest
+    path: image.location
+    matches: '^123456789012\.dkr\.ecr\.'
+  - name: no-public-services
+    environments: [prod]
+    target: manifest
+    path: type
+    not_equals: Load Balanced Web Service
+  - name: no-count
+    target: manifest
+    path: count
+    exists: false
+  - name: no-storage
+    target: manifest
+    path: storage
+    exists: false`,
+			inEnv: "test",
+			wantedViolations: []Violation{
+				{
+					Rule:     "max-memory",
+					Severity: SeverityBlock,
+					Message:  "Tasks can't use more than 8 GB of memory.",
+				},
+				{
+					Rule:     "ecr-images",
+					Severity: SeverityWarn,
+					Message:  `sidecars.*.image must match "^123456789012\\.dkr\\.ecr\\."`,
+				},
+			},
+		},
+		"applies rules only to their environments and workload types": {
+			inPolicies: `
+rules:
+  - name: no-public-services
+    environments: [prod]
+    target: manifest
+    path: type
+    not_equals: Load Balanced Web Service
+  - name: workers-only
+    workload_types: [Worker Service]
+    target: manifest
+    path: http
+    exists: false`,
+			inEnv: "prod",
+			wantedViolations: []Violation{
+				{
+					Rule:     "no-public-services",
+					Severity: SeverityBlock,
+					Message:  `type must not be "Load Balanced Web Service"`,
+				},
+			},
+		},
+		"returns the rules that the template fails": {
+			inPolicies: `
+rules:
+  - name: alarms
+    target: template
+    path: Resources.*.Type
+    includes: AWS::CloudWatch::Alarm
+  - name: container-memory
+    target: template
+    path: Resources.TaskDefinition.Properties.ContainerDefinitions[*].Memory
+    max: 1024
+  - name: main-container-memory
+    target: template
+    path: Resources.TaskDefinition.Properties.ContainerDefinitions[0].Memory
+    min: 1024
+  - name: service
+    target: template
+    path: Resources.Service.Type
+    one_of: [AWS::ECS::Service]`,
+			wantedViolations: []Violation{
+				{
+					Rule:     "alarms",
+					Severity: SeverityBlock,
+					Message:  `Resources.*.Type must include "AWS::CloudWatch::Alarm"`,
+				},
+				{
+					Rule:     "main-container-memory",
+					Severity: SeverityBlock,
+					Message:  "Resources.TaskDefinition.Properties.ContainerDefinitions[0].Memory must be at least 1024",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			policies, err := Parse([]byte(tc.inPolicies))
+			require.NoError(t, err)
+			tpl := mockTemplate
+			if tc.wantedErr != "" {
+				tpl = "{"
+			}
+
+			// WHEN
+			violations, err := policies.Evaluate(Input{
+				Environment: tc.inEnv,
+				Manifest:    []byte(mockManifest),
+				Template:    []byte(tpl),
+			})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedViolations, violations)
+		})
+	}
+}
+
+func TestPolicies_Merge(t *testing.T) {
+	testCases := map[string]struct {
+		in    *Policies
+		other *Policies
+
+		wantedRules []string
+		wantedErr   string
+	}{
+		"error if a rule name is used by both policies": {
+			in: &Policies{
+				Rules: []Rule{{Name: "max-memory"}},
+			},
+			other: &Policies{
+				Rules: []Rule{{Name: "alarms"}, {Name: "max-memory"}},
+			},
+			wantedErr: `rule name "max-memory" is used more than once`,
+		},
+		"appends the rules of the other policies": {
+			in: &Policies{
+				Rules: []Rule{{Name: "max-memory"}},
+			},
+			other: &Policies{
+				Rules: []Rule{{Name: "alarms"}},
+			},
+			wantedRules: []string{"max-memory", "alarms"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Merge(tc.other)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, rule := range tc.in.Rules {
+				names = append(names, rule.Name)
+			}
+			require.Equal(t, tc.wantedRules, names)
+		})
+	}
+}
+
+func TestErrViolations_Error(t *testing.T) {
+	err := &ErrViolations{
+		Violations: []Violation{
+			{Rule: "max-memory", Message: "memory must be at most 8192"},
+			{Rule: "alarms", Message: "every service must have alarms"},
+		},
+	}
+	require.EqualError(t, err, "blocked by policy rules: max-memory: memory must be at most 8192; alarms: every service must have alarms")
+}
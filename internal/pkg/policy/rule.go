@@ -0,0 +1,293 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	pathSegmentSeparator = "."
+	wildcardSymbol       = "*"
+	nullTag              = "!!null"
+)
+
+var (
+	// pathSegmentRegexp checks for a map key or a sequence reference.
+	// For example: Resources, *, ContainerDefinitions[0], or ContainerDefinitions[*].
+	pathSegmentRegexp = regexp.MustCompile(`^([a-zA-Z0-9_:-]+|\*)(\[(\d+|\*)\])?$`)
+)
+
+// Rule is a check that warns about or blocks the deployment of the workloads that fail it.
+type Rule struct {
+	Name          string   `yaml:"name"`
+	Message       string   `yaml:"message"`        // Optional. Defaults to a description of the condition.
+	Severity      string   `yaml:"severity"`       // Optional. Either "warn" or "block". Defaults to "block".
+	Target        string   `yaml:"target"`         // Either "manifest" or "template".
+	Environments  []string `yaml:"environments"`   // Optional. Defaults to all environments.
+	WorkloadTypes []string `yaml:"workload_types"` // Optional. Defaults to all workload types.
+	Path          string   `yaml:"path"`           // Example: "Resources.*.Properties.ContainerDefinitions[*].Image".
+	Condition     `yaml:",inline"`
+
+	segments []pathSegment
+}
+
+// Condition is the check that the values selected by a rule's path must pass.
+// Exactly one field must be set.
+type Condition struct {
+	Exists    *bool    `yaml:"exists"`     // The path selects at least one value, or none if false.
+	Equals    *string  `yaml:"equals"`     // Every selected value is equal to the string.
+	NotEquals *string  `yaml:"not_equals"` // No selected value is equal to the string.
+	OneOf     []string `yaml:"one_of"`     // Every selected value is one of the strings.
+	Matches   *string  `yaml:"matches"`    // Every selected value matches the regular expression.
+	Min       *float64 `yaml:"min"`        // Every selected value is a number greater than or equal to the minimum.
+	Max       *float64 `yaml:"max"`        // Every selected value is a number less than or equal to the maximum.
+	Includes  *string  `yaml:"includes"`   // At least one selected value is equal to the string.
+
+	matches *regexp.Regexp
+}
+
+func (r *Rule) validate() error {
+	if r.Name == "" {
+		return errors.New(`"name" must be specified`)
+	}
+	switch r.Severity {
+	case "", SeverityWarn, SeverityBlock:
+	default:
+		return fmt.Errorf(`"severity" of rule %s must be one of %q or %q`, r.Name, SeverityWarn, SeverityBlock)
+	}
+	switch r.Target {
+	case TargetManifest, TargetTemplate:
+	default:
+		return fmt.Errorf(`"target" of rule %s must be one of %q or %q`, r.Name, TargetManifest, TargetTemplate)
+	}
+	segments, err := parsePath(r.Path)
+	if err != nil {
+		return fmt.Errorf(`"path" of rule %s: %w`, r.Name, err)
+	}
+	r.segments = segments
+	if err := r.Condition.validate(); err != nil {
+		return fmt.Errorf("condition of rule %s: %w", r.Name, err)
+	}
+	return nil
+}
+
+func (r *Rule) appliesTo(env, wkldType string) bool {
+	return (len(r.Environments) == 0 || contains(r.Environments, env)) &&
+		(len(r.WorkloadTypes) == 0 || contains(r.WorkloadTypes, wkldType))
+}
+
+func (c *Condition) validate() error {
+	var set int
+	for _, isSet := range []bool{
+		c.Exists != nil, c.Equals != nil, c.NotEquals != nil, c.OneOf != nil,
+		c.Matches != nil, c.Min != nil, c.Max != nil, c.Includes != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New(`exactly one of "exists", "equals", "not_equals", "one_of", "matches", "min", "max" or "includes" must be specified`)
+	}
+	if c.Matches != nil {
+		re, err := regexp.Compile(*c.Matches)
+		if err != nil {
+			return fmt.Errorf(`parse "matches": %w`, err)
+		}
+		c.matches = re
+	}
+	return nil
+}
+
+func (c *Condition) isSatisfiedBy(nodes []*yaml.Node) bool {
+	switch {
+	case c.Exists != nil:
+		return (len(nodes) != 0) == *c.Exists
+	case c.Includes != nil:
+		for _, node := range nodes {
+			if node.Kind == yaml.ScalarNode && node.Value == *c.Includes {
+				return true
+			}
+		}
+		return false
+	}
+	for _, node := range nodes {
+		if !c.isSatisfiedByValue(node) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Condition) isSatisfiedByValue(node *yaml.Node) bool {
+	if c.NotEquals != nil {
+		return node.Kind != yaml.ScalarNode || node.Value != *c.NotEquals
+	}
+	if node.Kind != yaml.ScalarNode {
+		return false
+	}
+	switch {
+	case c.Equals != nil:
+		return node.Value == *c.Equals
+	case c.OneOf != nil:
+		return contains(c.OneOf, node.Value)
+	case c.Matches != nil:
+		return c.matches.MatchString(node.Value)
+	}
+	num, err := strconv.ParseFloat(node.Value, 64)
+	if err != nil {
+		return false
+	}
+	if c.Min != nil {
+		return num >= *c.Min
+	}
+	return num <= *c.Max
+}
+
+// describe returns a sentence that explains the condition applied to the path.
+func (c *Condition) describe(path string) string {
+	switch {
+	case c.Exists != nil && *c.Exists:
+		return fmt.Sprintf("%s must be set", path)
+	case c.Exists != nil:
+		return fmt.Sprintf("%s must not be set", path)
+	case c.Equals != nil:
+		return fmt.Sprintf("%s must be %q", path, *c.Equals)
+	case c.NotEquals != nil:
+		return fmt.Sprintf("%s must not be %q", path, *c.NotEquals)
+	case c.OneOf != nil:
+		return fmt.Sprintf("%s must be one of %q", path, c.OneOf)
+	case c.Matches != nil:
+		return fmt.Sprintf("%s must match %q", path, *c.Matches)
+	case c.Min != nil:
+		return fmt.Sprintf("%s must be at least %s", path, strconv.FormatFloat(*c.Min, 'f', -1, 64))
+	case c.Max != nil:
+		return fmt.Sprintf("%s must be at most %s", path, strconv.FormatFloat(*c.Max, 'f', -1, 64))
+	default:
+		return fmt.Sprintf("%s must include %q", path, *c.Includes)
+	}
+}
+
+// pathSegment selects the values under a map key, and optionally the elements of the sequence under it.
+type pathSegment struct {
+	key   string // Either a map key or "*" for every value of the map.
+	index string // Optional. Either a sequence index or "*" for every element of the sequence.
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	if path == "" {
+		return nil, errors.New("path is empty")
+	}
+	var segments []pathSegment
+	for _, segment := range strings.Split(path, pathSegmentSeparator) {
+		match := pathSegmentRegexp.FindStringSubmatch(segment)
+		if match == nil {
+			return nil, fmt.Errorf(`invalid path segment %q: segments must be of the form "key", "*", "array[0]" or "array[*]"`, segment)
+		}
+		segments = append(segments, pathSegment{
+			key:   match[1],
+			index: match[3],
+		})
+	}
+	return segments, nil
+}
+
+// find returns the nodes under root selected by the path segments, skipping the ones that are unset.
+func find(root *yaml.Node, segments []pathSegment) []*yaml.Node {
+	nodes := []*yaml.Node{root}
+	for _, segment := range segments {
+		var next []*yaml.Node
+		for _, node := range nodes {
+			for _, child := range mapValues(node, segment.key) {
+				next = append(next, seqElements(child, segment.index)...)
+			}
+		}
+		nodes = next
+	}
+	var set []*yaml.Node
+	for _, node := range nodes {
+		if !isUnset(node) {
+			set = append(set, node)
+		}
+	}
+	return set
+}
+
+// isUnset returns true if the node is null, an empty sequence, or a map of unset values.
+// This is how the fields that are not specified in a manifest are marshaled.
+func isUnset(node *yaml.Node) bool {
+	node = resolveAlias(node)
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.ShortTag() == nullTag
+	case yaml.SequenceNode:
+		return len(node.Content) == 0
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			if !isUnset(node.Content[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func mapValues(node *yaml.Node, key string) []*yaml.Node {
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	var values []*yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if key == wildcardSymbol || node.Content[i].Value == key {
+			values = append(values, resolveAlias(node.Content[i+1]))
+		}
+	}
+	return values
+}
+
+func seqElements(node *yaml.Node, index string) []*yaml.Node {
+	if index == "" {
+		return []*yaml.Node{node}
+	}
+	if node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	if index == wildcardSymbol {
+		elements := make([]*yaml.Node, len(node.Content))
+		for i, element := range node.Content {
+			elements[i] = resolveAlias(element)
+		}
+		return elements
+	}
+	i, _ := strconv.Atoi(index)
+	if i >= len(node.Content) {
+		return nil
+	}
+	return []*yaml.Node{resolveAlias(node.Content[i])}
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return node.Alias
+	}
+	return node
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
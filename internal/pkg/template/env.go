@@ -25,6 +25,7 @@ var (
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"ec2-capacity-provider",
 	}
 )
 
@@ -45,6 +46,13 @@ type EnvOpts struct {
 	VPCConfig *config.AdjustVPC
 	Telemetry *config.Telemetry
 
+	EC2Capacity *config.EC2Capacity
+
+	ServiceConnect bool
+
+	PermissionsBoundary string // Name of the IAM policy set as the permissions boundary of every role in the stack.
+	RolePath            string // IAM path of every role in the stack.
+
 	LatestVersion string
 }
 
@@ -22,6 +22,7 @@ func TestTemplate_ParseEnv(t *testing.T) {
 				"templates/environment/partials/lambdas.yml":                  []byte("lambdas"),
 				"templates/environment/partials/vpc-resources.yml":            []byte("vpc-resources"),
 				"templates/environment/partials/nat-gateways.yml":             []byte("nat-gateways"),
+				"templates/environment/partials/ec2-capacity-provider.yml":    []byte("ec2-capacity-provider"),
 			},
 		},
 	}
@@ -0,0 +1,135 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// WorkloadPartialsVersion is the version of the workload partials embedded in the binary.
+	// Bump the minor version when a partial receives new data fields, and the major version when
+	// existing fields are removed or renamed so that older overrides can't render anymore.
+	WorkloadPartialsVersion = "v1.2.0"
+
+	// PartialOverridesConfigFileName is the name of the file that describes a directory of partial overrides.
+	PartialOverridesConfigFileName = "partials.yml"
+
+	workloadPartialsDir = "templates/workloads/partials/cf"
+)
+
+// ErrIncompatiblePartialOverrides occurs when the partial overrides target a version of the workload partials
+// that can't be rendered by the embedded templates.
+type ErrIncompatiblePartialOverrides struct {
+	Version string
+}
+
+func (e *ErrIncompatiblePartialOverrides) Error() string {
+	return fmt.Sprintf("partial overrides target version %s of the workload partials which is incompatible with the embedded version %s",
+		e.Version, WorkloadPartialsVersion)
+}
+
+// WithPartialOverrides returns a Template where the workload partials under "/templates/workloads/partials/cf/"
+// are replaced by the files with the same name at the root of overrides.
+// The overrides must contain a "partials.yml" file declaring the version of the workload partials they were written against.
+func (t *Template) WithPartialOverrides(overrides fs.FS) (*Template, error) {
+	if err := validatePartialOverrides(overrides); err != nil {
+		return nil, err
+	}
+	return &Template{
+		fs: &partialOverridesFS{
+			base:      t.fs,
+			overrides: overrides,
+		},
+	}, nil
+}
+
+func validatePartialOverrides(overrides fs.FS) error {
+	dat, err := fs.ReadFile(overrides, PartialOverridesConfigFileName)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", PartialOverridesConfigFileName, err)
+	}
+	var config struct {
+		Version string `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(dat, &config); err != nil {
+		return fmt.Errorf("unmarshal %s: %w", PartialOverridesConfigFileName, err)
+	}
+	if !semver.IsValid(config.Version) {
+		return fmt.Errorf(`"version" in %s must be a semantic version such as %q`, PartialOverridesConfigFileName, WorkloadPartialsVersion)
+	}
+	if semver.Major(config.Version) != semver.Major(WorkloadPartialsVersion) || semver.Compare(config.Version, WorkloadPartialsVersion) > 0 {
+		return &ErrIncompatiblePartialOverrides{
+			Version: config.Version,
+		}
+	}
+
+	entries, err := fs.ReadDir(overrides, ".")
+	if err != nil {
+		return fmt.Errorf("read partial overrides directory: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == PartialOverridesConfigFileName || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if !isWorkloadPartial(name) {
+			return fmt.Errorf("file %s does not override any of the workload partials", name)
+		}
+	}
+	return nil
+}
+
+func isWorkloadPartial(fileName string) bool {
+	for _, name := range partialsWorkloadCFTemplateNames {
+		if fileName == name+".yml" {
+			return true
+		}
+	}
+	return false
+}
+
+// partialOverridesFS is a file system where the files in overrides take precedence over the workload partials in base.
+type partialOverridesFS struct {
+	base      fs.ReadFileFS
+	overrides fs.FS
+}
+
+// Open opens the named file from the overrides if it replaces a workload partial, otherwise from the base file system.
+func (o *partialOverridesFS) Open(name string) (fs.File, error) {
+	if file, ok := o.overriddenPartial(name); ok {
+		f, err := o.overrides.Open(file)
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return f, err
+		}
+	}
+	return o.base.Open(name)
+}
+
+// ReadFile reads the named file from the overrides if it replaces a workload partial, otherwise from the base file system.
+func (o *partialOverridesFS) ReadFile(name string) ([]byte, error) {
+	if file, ok := o.overriddenPartial(name); ok {
+		dat, err := fs.ReadFile(o.overrides, file)
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return dat, err
+		}
+	}
+	return o.base.ReadFile(name)
+}
+
+// overriddenPartial returns the name of the file in the overrides that can replace the named file.
+func (o *partialOverridesFS) overriddenPartial(name string) (string, bool) {
+	dir, file := path.Split(name)
+	if path.Clean(dir) != workloadPartialsDir {
+		return "", false
+	}
+	return file, true
+}
@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplate_WithPartialOverrides(t *testing.T) {
+	testCases := map[string]struct {
+		inOverrides fstest.MapFS
+
+		wantedErr      string
+		wantedPartials map[string]string
+	}{
+		"error if partials.yml is missing": {
+			inOverrides: fstest.MapFS{
+				"sidecars.yml": {Data: []byte("override")},
+			},
+			wantedErr: "read partials.yml: open partials.yml: file does not exist",
+		},
+		"error if the version is not a semantic version": {
+			inOverrides: fstest.MapFS{
+				"partials.yml": {Data: []byte("version: latest")},
+			},
+			wantedErr: `"version" in partials.yml must be a semantic version such as "v1.2.0"`,
+		},
+		"error if the major version does not match": {
+			inOverrides: fstest.MapFS{
+				"partials.yml": {Data: []byte("version: v0.9.0")},
+			},
+			wantedErr: "partial overrides target version v0.9.0 of the workload partials which is incompatible with the embedded version v1.2.0",
+		},
+		"error if the version is newer than the embedded partials": {
+			inOverrides: fstest.MapFS{
+				"partials.yml": {Data: []byte("version: v1.3.0")},
+			},
+			wantedErr: "partial overrides target version v1.3.0 of the workload partials which is incompatible with the embedded version v1.2.0",
+		},
+		"error if a file does not match any partial": {
+			inOverrides: fstest.MapFS{
+				"partials.yml": {Data: []byte("version: v1.0.0")},
+				"tags.yml":     {Data: []byte("override")},
+			},
+			wantedErr: "file tags.yml does not override any of the workload partials",
+		},
+		"layers the overrides over the embedded partials": {
+			inOverrides: fstest.MapFS{
+				"partials.yml":   {Data: []byte("version: v1.0.0")},
+				"sidecars.yml":   {Data: []byte("sidecars override")},
+				"logconfig.yml":  {Data: []byte("logconfig override")},
+				".DS_Store":      {Data: []byte("ignored")},
+				"docs/README.md": {Data: []byte("ignored")},
+			},
+			wantedPartials: map[string]string{
+				"sidecars":  "sidecars override",
+				"logconfig": "logconfig override",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			tpl, err := New().WithPartialOverrides(tc.inOverrides)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, name := range partialsWorkloadCFTemplateNames {
+				actual, err := tpl.Read("workloads/partials/cf/" + name + ".yml")
+				require.NoError(t, err)
+				if wanted, ok := tc.wantedPartials[name]; ok {
+					require.Equal(t, wanted, actual.String())
+					continue
+				}
+				embedded, err := New().Read("workloads/partials/cf/" + name + ".yml")
+				require.NoError(t, err)
+				require.Equal(t, embedded.String(), actual.String())
+			}
+			_, err = tpl.Read("workloads/services/lb-web/cf.yml")
+			require.NoError(t, err, "non-partial templates should still be read from the embedded files")
+		})
+	}
+}
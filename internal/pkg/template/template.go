@@ -196,7 +196,7 @@ func (c *Content) MarshalBinary() ([]byte, error) {
 	return c.Bytes(), nil
 }
 
-// newTextTemplate returns a named text/template with the "indent" and "include" functions.
+// newTextTemplate returns a named text/template with the "indent", "include", "permissionsBoundaryARN" and "rolePath" functions.
 func newTextTemplate(name string) *template.Template {
 	t := template.New(name)
 	t.Funcs(map[string]interface{}{
@@ -213,6 +213,8 @@ func newTextTemplate(name string) *template.Template {
 			pad := strings.Repeat(" ", spaces)
 			return pad + strings.Replace(s, "\n", "\n"+pad, -1)
 		},
+		"permissionsBoundaryARN": PermissionsBoundaryARNFunc,
+		"rolePath":               RolePathFunc,
 	})
 	return t
 }
@@ -36,6 +36,20 @@ func TrimSlashPrefix(value string) string {
 	return strings.TrimPrefix(value, "/")
 }
 
+// PermissionsBoundaryARNFunc takes the name of an IAM policy, optionally prefixed with its path,
+// and returns a CloudFormation expression for the ARN of the policy in the stack's account.
+func PermissionsBoundaryARNFunc(policy string) string {
+	return fmt.Sprintf("!Sub 'arn:${AWS::Partition}:iam::${AWS::AccountId}:policy/%s'", policy)
+}
+
+// RolePathFunc returns the IAM path of the roles in an application, which defaults to "/".
+func RolePathFunc(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
 // DashReplacedLogicalIDToOriginal takes a "sanitized" logical ID
 // and converts it back to its original form, with dashes.
 func DashReplacedLogicalIDToOriginal(safeLogicalID string) string {
@@ -179,6 +193,43 @@ func generateQueueURIJSON(ts []*TopicSubscription) string {
 	return string(out)
 }
 
+// generateEventQueueURIJSON turns a list of Event Subscription objects into a JSON string of their corresponding queues:
+// `{"orderPlaced": "${orderPlacedURL}"}`
+// This function must be called on an array of correctly constructed EventSubscription objects.
+func generateEventQueueURIJSON(es []*EventSubscription) string {
+	if es == nil {
+		return ""
+	}
+	urlMap := make(map[string]string)
+	for _, sub := range es {
+		// EventSubscriptions with no name or queue will not be included in the json
+		if sub.Name == nil || sub.Queue == nil {
+			continue
+		}
+		urlMap[aws.StringValue(sub.Name)] = fmt.Sprintf("${%sURL}", StripNonAlphaNumFunc(aws.StringValue(sub.Name)))
+	}
+
+	out, ok := getJSONMap(urlMap)
+	if !ok {
+		return "{}"
+	}
+
+	return string(out)
+}
+
+// generatePermissionsJSON turns a list of Permission objects into a JSON string:
+// `[{"actions": ["s3:GetObject"], "resources": ["arn:${AWS::Partition}:s3:::${AppName}-assets/*"]}]`
+func generatePermissionsJSON(perms []*PermissionOpts) string {
+	if len(perms) == 0 {
+		return "[]"
+	}
+	out, err := json.Marshal(perms)
+	if err != nil {
+		return "[]"
+	}
+	return string(out)
+}
+
 func getJSONMap(inMap map[string]string) ([]byte, bool) {
 	// Check for empty maps
 	if len(inMap) == 0 {
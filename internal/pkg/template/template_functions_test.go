@@ -250,6 +250,21 @@ func TestGenerateSNSJSON(t *testing.T) {
 			},
 			wanted: `{"tests":"arn:aws:sns:us-west-2:123456789012:appName-envName-svcName-tests"}`,
 		},
+		"FIFO topics have the .fifo suffix": {
+			in: []*Topic{
+				{
+					Name:      aws.String("orders"),
+					FIFO:      true,
+					AccountID: "123456789012",
+					Region:    "us-west-2",
+					Partition: "aws",
+					App:       "appName",
+					Env:       "envName",
+					Svc:       "svcName",
+				},
+			},
+			wanted: `{"orders":"arn:aws:sns:us-west-2:123456789012:appName-envName-svcName-orders.fifo"}`,
+		},
 		"Topics with no names show empty": {
 			in: []*Topic{
 				{
@@ -314,3 +329,81 @@ func TestGenerateQueueURIJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateEventQueueURIJSON(t *testing.T) {
+	testCases := map[string]struct {
+		in     []*EventSubscription
+		wanted string
+	}{
+		"JSON should render correctly": {
+			in: []*EventSubscription{
+				{
+					Name:    aws.String("order-placed"),
+					Pattern: aws.String(`{"source":["orders"]}`),
+					Queue:   &SQSQueue{},
+				},
+				{
+					Name:    aws.String("payments"),
+					Pattern: aws.String(`{"source":["payments"]}`),
+				},
+			},
+			wanted: `{"order-placed":"${orderplacedURL}"}`,
+		},
+		"Events with no names are not included": {
+			in: []*EventSubscription{
+				{
+					Queue: &SQSQueue{},
+				},
+			},
+			wanted: `{}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, generateEventQueueURIJSON(tc.in))
+		})
+	}
+}
+
+func TestPermissionsBoundaryARNFunc(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"policy name": {
+			in:     "MyBoundary",
+			wanted: "!Sub 'arn:${AWS::Partition}:iam::${AWS::AccountId}:policy/MyBoundary'",
+		},
+		"policy under a path": {
+			in:     "boundaries/MyBoundary",
+			wanted: "!Sub 'arn:${AWS::Partition}:iam::${AWS::AccountId}:policy/boundaries/MyBoundary'",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, PermissionsBoundaryARNFunc(tc.in))
+		})
+	}
+}
+
+func TestRolePathFunc(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"default path": {
+			in:     "",
+			wanted: "/",
+		},
+		"custom path": {
+			in:     "/copilot/",
+			wanted: "/copilot/",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, RolePathFunc(tc.in))
+		})
+	}
+}
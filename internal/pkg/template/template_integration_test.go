@@ -137,6 +137,22 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				ALBEnabled: true,
 			},
 		},
+		"renders a valid template with a global alias": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Aliases: []string{"example.com"},
+				GlobalAlias: &template.GlobalAliasOpts{
+					Name:            "api.example.com",
+					HealthAlarmName: "app-test-svc-global-health",
+				},
+				ALBEnabled: true,
+			},
+		},
 		"renders a valid template with addons with no outputs": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
@@ -179,6 +195,142 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				ALBEnabled:               true,
 			},
 		},
+		"renders a valid template with the EC2 capacity provider": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.DisablePublicIP,
+					SubnetsType:    template.PrivateSubnetsPlacement,
+				},
+				CapacityProviders: []*template.CapacityProviderStrategy{
+					{
+						Weight:           aws.Int(1),
+						CapacityProvider: "EC2",
+					},
+				},
+				LaunchOnEC2:              true,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+			},
+		},
+		"renders a valid template with service connect": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ServiceConnect: &template.ServiceConnectOpts{
+					Alias: aws.String("frontend"),
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+			},
+		},
+		"renders a valid template with log group encryption and filters": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				LogGroup: &template.LogGroupOpts{
+					Encrypted: true,
+					SubscriptionFilters: []*template.LogSubscriptionFilterOpts{
+						{
+							DestinationARN: "arn:aws:kinesis:us-west-2:123456789012:stream/siem",
+						},
+						{
+							DestinationARN: "arn:aws:lambda:us-west-2:123456789012:function:alert",
+							Pattern:        `{ $.level = "ERROR" }`,
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+			},
+		},
+		"renders a valid template with a CloudWatch Logs destination": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				LogGroup: &template.LogGroupOpts{
+					SubscriptionFilters: []*template.LogSubscriptionFilterOpts{
+						{
+							DestinationARN: "arn:aws:logs:us-west-2:123456789012:destination:central-logging",
+						},
+					},
+					MetricFilters: []*template.LogMetricFilterOpts{
+						{
+							MetricName: "Errors",
+							Namespace:  "${AppName}-${EnvName}-${WorkloadName}",
+							Pattern:    `"ERROR"`,
+							Value:      "1",
+							Unit:       aws.String("Count"),
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+			},
+		},
+		"renders a valid template with scheduled and step scaling": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Autoscaling: &template.AutoscalingOpts{
+					MinCapacity: aws.Int(1),
+					MaxCapacity: aws.Int(10),
+					CPU:         aws.Float64(70),
+					Schedules: []*template.ScheduledScalingOpts{
+						{
+							Schedule:    "cron(0 8 ? * 2-6 *)",
+							MinCapacity: aws.Int(10),
+						},
+						{
+							Schedule:    "cron(0 20 ? * 2-6 *)",
+							Timezone:    aws.String("America/New_York"),
+							MinCapacity: aws.Int(1),
+							MaxCapacity: aws.Int(1),
+						},
+					},
+					StepScaling: []*template.StepScalingOpts{
+						{
+							Namespace:  "AWS/SQS",
+							MetricName: "ApproximateAgeOfOldestMessage",
+							Statistic:  "Maximum",
+							Dimensions: map[string]string{
+								"QueueName": "jobs",
+							},
+							ComparisonOperator: "GreaterThanOrEqualToThreshold",
+							Threshold:          300,
+							Period:             60,
+							EvaluationPeriods:  2,
+							Cooldown:           120,
+							Steps: []template.StepAdjustmentOpts{
+								{
+									LowerBound: aws.Float64(0),
+									UpperBound: aws.Float64(300),
+									Adjustment: 2,
+								},
+								{
+									LowerBound: aws.Float64(300),
+									Adjustment: 5,
+								},
+							},
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+			},
+		},
 		"renders a valid template with all storage options": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck:          defaultHttpHealthCheck,
@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 // Constants for template paths.
@@ -55,6 +56,8 @@ const (
 // Constants for ARN options.
 const (
 	snsARNPattern = "arn:%s:sns:%s:%s:%s-%s-%s-%s"
+	// The name of a FIFO topic must end with this suffix.
+	fifoTopicSuffix = ".fifo"
 )
 
 var (
@@ -88,6 +91,7 @@ var (
 		"subscribe",
 		"nlb",
 		"vpc-connector",
+		"private-ingress",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -106,6 +110,15 @@ type WorkloadNestedStackOpts struct {
 	SecurityGroupOutputs []string
 }
 
+// WorkloadEnvAddonsOpts holds the outputs of the environment addons that are granted to the workload.
+// The outputs are imported from the environment addons stack.
+type WorkloadEnvAddonsOpts struct {
+	VariableOutputs      []string
+	SecretOutputs        []string
+	PolicyOutputs        []string
+	SecurityGroupOutputs []string
+}
+
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
 	Name         *string
@@ -193,6 +206,62 @@ type LogConfigOpts struct {
 	ConfigFile     *string
 	Variables      map[string]string
 	Secrets        map[string]Secret
+	Permissions    []*PermissionOpts // Permissions needed by the log router to write to the destination of a logging preset.
+}
+
+// LogGroupOpts holds configuration for the CloudWatch log group of the workload.
+type LogGroupOpts struct {
+	Encrypted           bool // Whether the log group is encrypted with the KMS key of the environment.
+	SubscriptionFilters []*LogSubscriptionFilterOpts
+	MetricFilters       []*LogMetricFilterOpts
+}
+
+// StreamDestinations returns the ARNs of the Kinesis data streams and Kinesis Data Firehose delivery streams
+// that the log group is subscribed to.
+func (o LogGroupOpts) StreamDestinations() []string {
+	var arns []string
+	for _, filter := range o.SubscriptionFilters {
+		if filter.IsStream() {
+			arns = append(arns, filter.DestinationARN)
+		}
+	}
+	return arns
+}
+
+// LogSubscriptionFilterOpts holds configuration for a subscription filter of the log group.
+type LogSubscriptionFilterOpts struct {
+	DestinationARN string
+	Pattern        string
+}
+
+// IsLambda returns true if the logs are forwarded to a Lambda function.
+func (o LogSubscriptionFilterOpts) IsLambda() bool {
+	return o.destinationService() == "lambda"
+}
+
+// IsStream returns true if the logs are forwarded to a Kinesis data stream or a Kinesis Data Firehose delivery stream,
+// in which case CloudWatch Logs needs a role to put records in the stream.
+func (o LogSubscriptionFilterOpts) IsStream() bool {
+	service := o.destinationService()
+	return service == "kinesis" || service == "firehose"
+}
+
+func (o LogSubscriptionFilterOpts) destinationService() string {
+	parsed, err := arn.Parse(o.DestinationARN)
+	if err != nil {
+		return ""
+	}
+	return parsed.Service
+}
+
+// LogMetricFilterOpts holds configuration for a metric filter of the log group.
+type LogMetricFilterOpts struct {
+	MetricName   string
+	Namespace    string // Namespace can refer to pseudo parameters and template parameters, like ${AppName}.
+	Pattern      string
+	Value        string
+	DefaultValue *float64
+	Unit         *string
 }
 
 // HTTPHealthCheckOpts holds configuration that's needed for HTTP Health Check.
@@ -238,6 +307,7 @@ func SecretFromSSMOrARN(value string) ssmOrSecretARN {
 // secretsManagerName is a Secret that can be referred by a SecretsManager secret name.
 type secretsManagerName struct {
 	value string
+	key   string // Optional. The key of the value to select from a JSON secret.
 }
 
 // RequiresSub returns true if the secret should be populated in CloudFormation with !Sub.
@@ -247,6 +317,10 @@ func (s secretsManagerName) RequiresSub() bool {
 
 // ValueFrom returns the resource ID of the SecretsManager secret for populating the ARN.
 func (s secretsManagerName) ValueFrom() string {
+	if s.key != "" {
+		// The version stage and version ID are left empty to use the current version of the secret.
+		return fmt.Sprintf("secret:%s:%s::", s.value, s.key)
+	}
 	return fmt.Sprintf("secret:%s", s.value)
 }
 
@@ -262,6 +336,14 @@ func SecretFromSecretsManager(value string) secretsManagerName {
 	}
 }
 
+// SecretFromSecretsManagerJSONKey returns a Secret that refers to the value of a key in a SecretsManager JSON secret.
+func SecretFromSecretsManagerJSONKey(value, key string) secretsManagerName {
+	return secretsManagerName{
+		value: value,
+		key:   key,
+	}
+}
+
 // NetworkLoadBalancerListener holds configuration that's need for a Network Load Balancer listener.
 type NetworkLoadBalancerListener struct {
 	// The port and protocol that the Network Load Balancer listens to.
@@ -329,6 +411,8 @@ type AutoscalingOpts struct {
 	Requests     *float64
 	ResponseTime *float64
 	QueueDelay   *AutoscalingQueueDelayOpts
+	Schedules    []*ScheduledScalingOpts
+	StepScaling  []*StepScalingOpts
 }
 
 // AutoscalingQueueDelayOpts holds configuration to scale SQS queues.
@@ -336,28 +420,88 @@ type AutoscalingQueueDelayOpts struct {
 	AcceptableBacklogPerTask int
 }
 
+// ScheduledScalingOpts holds configuration for a scheduled action on the scalable target.
+type ScheduledScalingOpts struct {
+	Schedule    string // AWS schedule expression.
+	Timezone    *string
+	MinCapacity *int
+	MaxCapacity *int
+}
+
+// StepScalingOpts holds configuration for a step scaling policy and the alarm that triggers it.
+type StepScalingOpts struct {
+	Namespace          string
+	MetricName         string
+	Statistic          string
+	Dimensions         map[string]string
+	ComparisonOperator string
+	Threshold          float64
+	Period             int64 // In seconds.
+	EvaluationPeriods  int
+	Cooldown           int64 // In seconds.
+	Steps              []StepAdjustmentOpts
+}
+
+// StepAdjustmentOpts holds the bounds, relative to the alarm threshold, of a step and its change in capacity.
+type StepAdjustmentOpts struct {
+	LowerBound *float64
+	UpperBound *float64
+	Adjustment int
+}
+
 // ObservabilityOpts holds configurations for observability.
 type ObservabilityOpts struct {
 	Tracing string // The name of the vendor used for tracing.
 }
 
+// AppRunnerAutoscalingOpts holds configuration needed for an App Runner auto scaling configuration.
+type AppRunnerAutoscalingOpts struct {
+	MaxConcurrency *int
+	MinSize        *int
+	MaxSize        *int
+}
+
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// ServiceConnectOpts holds configuration that's needed for ECS Service Connect.
+type ServiceConnectOpts struct {
+	Alias *string // The DNS name that clients use to reach the service. Nil if the workload is a client only.
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
 	Retries *int
 }
 
+// PermissionOpts holds an IAM policy statement that is attached to the task role.
+type PermissionOpts struct {
+	Actions   []string `json:"actions"`
+	Resources []string `json:"resources"` // Resources can refer to pseudo parameters and template parameters, like ${AWS::Region} or ${AppName}.
+}
+
 // PublishOpts holds configuration needed if the service has publishers.
 type PublishOpts struct {
-	Topics []*Topic
+	Topics   []*Topic
+	EventBus bool
+}
+
+// HasKMSKeys returns true if any topic is encrypted with a customer managed KMS key.
+func (p *PublishOpts) HasKMSKeys() bool {
+	for _, t := range p.Topics {
+		if t.KMSKey != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // Topic holds information needed to render a SNSTopic in a container definition.
 type Topic struct {
-	Name *string
+	Name   *string
+	FIFO   bool
+	KMSKey *string
 
 	Region    string
 	Partition string
@@ -370,6 +514,7 @@ type Topic struct {
 // SubscribeOpts holds configuration needed if the service has subscriptions.
 type SubscribeOpts struct {
 	Topics []*TopicSubscription
+	Events []*EventSubscription
 	Queue  *SQSQueue
 }
 
@@ -383,10 +528,41 @@ func (s *SubscribeOpts) HasTopicQueues() bool {
 	return false
 }
 
+// HasEventQueues returns true if any event subscription has a dedicated queue.
+func (s *SubscribeOpts) HasEventQueues() bool {
+	for _, e := range s.Events {
+		if e.Queue != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEventsQueueFIFO returns true if the subscriptions without a dedicated queue deliver messages from FIFO topics,
+// in which case the default events queue is a FIFO queue.
+func (s *SubscribeOpts) IsEventsQueueFIFO() bool {
+	for _, t := range s.Topics {
+		if t.Queue == nil && t.FIFO {
+			return true
+		}
+	}
+	return false
+}
+
 // TopicSubscription holds information needed to render a SNS Topic Subscription in a container definition.
 type TopicSubscription struct {
+	Name         *string
+	Service      *string
+	Queue        *SQSQueue
+	FIFO         bool
+	FilterPolicy *string
+	RawDelivery  bool
+}
+
+// EventSubscription holds information needed to render an EventBridge rule on the environment's event bus.
+type EventSubscription struct {
 	Name    *string
-	Service *string
+	Pattern *string
 	Queue   *SQSQueue
 }
 
@@ -441,23 +617,34 @@ func (p RuntimePlatformOpts) isEmpty() bool {
 	return p.OS == "" && p.Arch == ""
 }
 
+// GlobalAliasOpts holds configuration for the alias that a service shares with its deployments in other regions.
+type GlobalAliasOpts struct {
+	Name            string // DNS name of the alias, whose records are managed by the global service stacks.
+	HealthAlarmName string // Name of the alarm that Route 53 uses to route the alias away from the environment.
+}
+
 // WorkloadOpts holds optional data that can be provided to enable features in a workload stack template.
 type WorkloadOpts struct {
 	// Additional options that are common between **all** workload templates.
 	Variables                map[string]string
 	Secrets                  map[string]Secret
 	Aliases                  []string
+	GlobalAlias              *GlobalAliasOpts         // Alias shared with the service in other regions.
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	AddonsExtraParams        string                   // Additional user defined Parameters for the addons stack.
+	EnvAddons                *WorkloadEnvAddonsOpts   // Outputs of the environment addons granted to the workload.
 	Sidecars                 []*SidecarOpts
 	LogConfig                *LogConfigOpts
+	LogGroup                 *LogGroupOpts
 	Autoscaling              *AutoscalingOpts
 	CapacityProviders        []*CapacityProviderStrategy
+	LaunchOnEC2              bool // Whether tasks are placed on the environment's EC2 capacity provider instead of Fargate.
 	DesiredCountOnSpot       *int
 	Storage                  *StorageOpts
 	Network                  NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	ServiceConnect           *ServiceConnectOpts
 	Platform                 RuntimePlatformOpts
 	EntryPoint               []string
 	Command                  []string
@@ -465,6 +652,9 @@ type WorkloadOpts struct {
 	DockerLabels             map[string]string
 	DependsOn                map[string]string
 	Publish                  *PublishOpts
+	Permissions              []*PermissionOpts
+	PermissionsBoundary      string // Name of the IAM policy set as the permissions boundary of every role in the stack.
+	RolePath                 string // IAM path of every role in the stack.
 	ServiceDiscoveryEndpoint string
 	HTTPVersion              *string
 	ALBEnabled               bool
@@ -491,9 +681,11 @@ type WorkloadOpts struct {
 	StateMachine       *StateMachineOpts
 
 	// Additional options for request driven web service templates.
-	StartCommand      *string
-	EnableHealthCheck bool
-	Observability     ObservabilityOpts
+	StartCommand         *string
+	EnableHealthCheck    bool
+	Observability        ObservabilityOpts
+	AppRunnerAutoscaling *AppRunnerAutoscalingOpts
+	Private              bool
 
 	// Input needed for the custom resource that adds a custom domain to the service.
 	Alias                *string
@@ -509,6 +701,14 @@ type WorkloadOpts struct {
 	FeatureFlags []string
 }
 
+// ListenerRuleAliases returns the aliases that the listener rules of the service match, including its global alias.
+func (o WorkloadOpts) ListenerRuleAliases() []string {
+	if o.GlobalAlias == nil {
+		return o.Aliases
+	}
+	return append(append([]string{}, o.Aliases...), o.GlobalAlias.Name)
+}
+
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
 // with the specified data object and returns its content.
 func (t *Template) ParseLoadBalancedWebService(data WorkloadOpts) (*Content, error) {
@@ -573,12 +773,15 @@ func withSvcParsingFuncs() ParseOption {
 		return t.Funcs(map[string]interface{}{
 			"toSnakeCase":         ToSnakeCaseFunc,
 			"hasSecrets":          hasSecrets,
+			"hasManagedPolicies":  hasManagedPolicies,
 			"fmtSlice":            FmtSliceFunc,
 			"quoteSlice":          QuoteSliceFunc,
 			"randomUUID":          randomUUIDFunc,
 			"jsonMountPoints":     generateMountPointJSON,
 			"jsonSNSTopics":       generateSNSJSON,
 			"jsonQueueURIs":       generateQueueURIJSON,
+			"jsonEventQueueURIs":  generateEventQueueURIJSON,
+			"jsonPermissions":     generatePermissionsJSON,
 			"envControllerParams": envControllerParameters,
 			"logicalIDSafe":       StripNonAlphaNumFunc,
 			"wordSeries":          english.WordSeries,
@@ -595,6 +798,19 @@ func hasSecrets(opts WorkloadOpts) bool {
 	if opts.NestedStack != nil && (len(opts.NestedStack.SecretOutputs) > 0) {
 		return true
 	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.SecretOutputs) > 0) {
+		return true
+	}
+	return false
+}
+
+func hasManagedPolicies(opts WorkloadOpts) bool {
+	if opts.NestedStack != nil && (len(opts.NestedStack.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.PolicyOutputs) > 0) {
+		return true
+	}
 	return false
 }
 
@@ -635,5 +851,9 @@ func contains(list []string, s string) bool {
 
 // ARN determines the arn for a topic using the SNSTopic name and account information
 func (t Topic) ARN() string {
-	return fmt.Sprintf(snsARNPattern, t.Partition, t.Region, t.AccountID, t.App, t.Env, t.Svc, aws.StringValue(t.Name))
+	arn := fmt.Sprintf(snsARNPattern, t.Partition, t.Region, t.AccountID, t.App, t.Env, t.Svc, aws.StringValue(t.Name))
+	if t.FIFO {
+		return arn + fifoTopicSuffix
+	}
+	return arn
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -57,6 +58,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/subscribe.yml":                       []byte("subscribe"),
 					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
 					"templates/workloads/partials/cf/vpc-connector.yml":                   []byte("vpc-connector"),
+					"templates/workloads/partials/cf/private-ingress.yml":                 []byte("private-ingress"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -87,6 +89,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
   subscribe
   nlb
   vpc-connector
+  private-ingress
 `,
 		},
 	}
@@ -142,6 +145,14 @@ func TestHasSecrets(t *testing.T) {
 			},
 			wanted: true,
 		},
+		"environment addons have secrets": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					SecretOutputs: []string{"MySecretArn"},
+				},
+			},
+			wanted: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -151,6 +162,85 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestHasManagedPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted bool
+	}{
+		"no addons": {
+			in:     WorkloadOpts{},
+			wanted: false,
+		},
+		"nested stack without policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					SecretOutputs: []string{"MySecretArn"},
+				},
+			},
+			wanted: false,
+		},
+		"nested stack has policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					PolicyOutputs: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"environment addons have policies": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					PolicyOutputs: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasManagedPolicies(tc.in))
+		})
+	}
+}
+
+func TestWorkloadOpts_ListenerRuleAliases(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted []string
+	}{
+		"no global alias": {
+			in: WorkloadOpts{
+				Aliases: []string{"example.com"},
+			},
+			wanted: []string{"example.com"},
+		},
+		"global alias without aliases": {
+			in: WorkloadOpts{
+				GlobalAlias: &GlobalAliasOpts{
+					Name: "api.example.com",
+				},
+			},
+			wanted: []string{"api.example.com"},
+		},
+		"aliases and global alias": {
+			in: WorkloadOpts{
+				Aliases: []string{"example.com", "v1.example.com"},
+				GlobalAlias: &GlobalAliasOpts{
+					Name: "api.example.com",
+				},
+			},
+			wanted: []string{"example.com", "v1.example.com", "api.example.com"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.ListenerRuleAliases())
+		})
+	}
+}
+
 func TestTemplate_ParseNetwork(t *testing.T) {
 	type cfn struct {
 		Resources struct {
@@ -309,4 +399,76 @@ func TestSecretsManagerName_Service(t *testing.T) {
 
 func TestSecretsManagerName_ValueFrom(t *testing.T) {
 	require.Equal(t, "secret:aes128-1a2b3c", SecretFromSecretsManager("aes128-1a2b3c").ValueFrom())
+	require.Equal(t, "secret:aes128-1a2b3c:password::", SecretFromSecretsManagerJSONKey("aes128-1a2b3c", "password").ValueFrom())
+}
+
+func TestSubscribeOpts_IsEventsQueueFIFO(t *testing.T) {
+	testCases := map[string]struct {
+		in     SubscribeOpts
+		wanted bool
+	}{
+		"standard topics": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{
+					{Name: aws.String("events")},
+				},
+			},
+		},
+		"fifo topic with a dedicated queue": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{
+					{Name: aws.String("orders"), FIFO: true, Queue: &SQSQueue{}},
+				},
+			},
+		},
+		"fifo topic without a dedicated queue": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{
+					{Name: aws.String("orders"), FIFO: true},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsEventsQueueFIFO())
+		})
+	}
+}
+
+func TestSubscribeOpts_HasEventQueues(t *testing.T) {
+	testCases := map[string]struct {
+		in     SubscribeOpts
+		wanted bool
+	}{
+		"no event subscriptions": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{
+					{Name: aws.String("orders"), Queue: &SQSQueue{}},
+				},
+			},
+		},
+		"event subscriptions delivered to the default queue": {
+			in: SubscribeOpts{
+				Events: []*EventSubscription{
+					{Name: aws.String("orderPlaced")},
+				},
+			},
+		},
+		"event subscription with a dedicated queue": {
+			in: SubscribeOpts{
+				Events: []*EventSubscription{
+					{Name: aws.String("orderPlaced")},
+					{Name: aws.String("paymentFailed"), Queue: &SQSQueue{}},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HasEventQueues())
+		})
+	}
 }
@@ -36,11 +36,13 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	environmentsDirName       = "environments"
 	pipelinesDirName          = "pipelines"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	policiesFileName          = "policies.yml"
 
 	ymlFileExtension = ".yml"
 
@@ -386,6 +388,28 @@ func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name stri
 	return ws.write(data, svc, addonsDirName, fname)
 }
 
+// ReadEnvAddonsDir returns a list of file names under the "environments/addons/" directory.
+func (ws *Workspace) ReadEnvAddonsDir() ([]string, error) {
+	return ws.ReadAddonsDir(environmentsDirName)
+}
+
+// ReadEnvAddon returns the contents of a file under the "environments/addons/" directory.
+func (ws *Workspace) ReadEnvAddon(fname string) ([]byte, error) {
+	return ws.read(environmentsDirName, addonsDirName, fname)
+}
+
+// WriteEnvAddon writes the content of an addon file under "environments/addons/{name}.yml".
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) WriteEnvAddon(content encoding.BinaryMarshaler, name string) (string, error) {
+	return ws.WriteAddon(content, environmentsDirName, name)
+}
+
+// ReadPolicies returns the contents of the policy rules under "copilot/policies.yml".
+// If the file does not exist, it returns an ErrFileNotExists error.
+func (ws *Workspace) ReadPolicies() ([]byte, error) {
+	return ws.read(policiesFileName)
+}
+
 // FileStat wraps the os.Stat function.
 type FileStat interface {
 	Stat(name string) (os.FileInfo, error)
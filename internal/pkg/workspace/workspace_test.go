@@ -690,6 +690,52 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"file not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+			wantedErr: &ErrFileNotExists{FileName: "/copilot/policies.yml"},
+		},
+		"reads the policies": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				afero.WriteFile(fs, "/copilot/policies.yml", []byte("rules: []"), 0644)
+				return fs
+			},
+			wantedContent: "rules: []",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			actualContent, actualErr := ws.ReadPolicies()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, actualErr)
+			require.Equal(t, tc.wantedContent, string(actualContent))
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -749,6 +795,33 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_EnvAddons(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	utils := &afero.Afero{
+		Fs: fs,
+	}
+	utils.MkdirAll(filepath.Join("/", "copilot"), 0755)
+	ws := &Workspace{
+		workingDir: "/",
+		copilotDir: "/copilot",
+		fsUtils:    utils,
+	}
+
+	// WHEN
+	path, err := ws.WriteEnvAddon(mockBinaryMarshaler{content: []byte("hello")}, "shared-bucket")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "/copilot/environments/addons/shared-bucket.yml", path)
+	fnames, err := ws.ReadEnvAddonsDir()
+	require.NoError(t, err)
+	require.Equal(t, []string{"shared-bucket.yml"}, fnames)
+	content, err := ws.ReadEnvAddon("shared-bucket.yml")
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), content)
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {